@@ -0,0 +1,169 @@
+package admin
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
+)
+
+// Client manages organization members and their roles.
+type Client struct {
+	http *client.HTTPClient
+}
+
+// NewClient creates a new admin client.
+func NewClient(http *client.HTTPClient) *Client {
+	return &Client{http: http}
+}
+
+// ListMembers lists organization members.
+func (c *Client) ListMembers(ctx context.Context, req *ListMembersRequest) (*ListMembersResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req != nil {
+		if req.Email != nil {
+			params.Set("email", *req.Email)
+		}
+		if req.Role != nil {
+			params.Set("role", string(*req.Role))
+		}
+		if req.ProjectSlug != nil {
+			params.Set("projectSlug", *req.ProjectSlug)
+		}
+		if req.Status != nil {
+			params.Set("status", string(*req.Status))
+		}
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+	}
+
+	path := "/admin/members"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListMembersResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetMember retrieves a member by ID.
+func (c *Client) GetMember(ctx context.Context, id string) (*Member, error) {
+	var resp Member
+	if err := c.http.Get(ctx, "/admin/members/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateMember changes a member's organization role or suspends them.
+func (c *Client) UpdateMember(ctx context.Context, req *UpdateMemberRequest) (*Member, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Member
+	if err := c.http.Patch(ctx, "/admin/members/"+req.ID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetProjectRole assigns a member a role in a project.
+func (c *Client) SetProjectRole(ctx context.Context, memberID, projectSlug string, role Role) (*Member, error) {
+	var resp Member
+	body := map[string]interface{}{"role": role}
+	if err := c.http.Put(ctx, "/admin/members/"+memberID+"/projects/"+projectSlug, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RemoveProjectRole removes a member's project role, so their organization
+// role applies to the project again.
+func (c *Client) RemoveProjectRole(ctx context.Context, memberID, projectSlug string) (*Member, error) {
+	var resp Member
+	if err := c.http.Delete(ctx, "/admin/members/"+memberID+"/projects/"+projectSlug, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RemoveMember removes a member from the organization, revoking their access
+// immediately.
+func (c *Client) RemoveMember(ctx context.Context, req *RemoveMemberRequest) (*RemoveMemberResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp RemoveMemberResponse
+	if err := c.http.DeleteWithBody(ctx, "/admin/members/"+req.ID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Invite invites a user to the organization by email.
+func (c *Client) Invite(ctx context.Context, req *InviteMemberRequest) (*Invitation, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Invitation
+	if err := c.http.Post(ctx, "/admin/invitations", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListInvitations lists invitations to the organization.
+func (c *Client) ListInvitations(ctx context.Context, req *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req != nil {
+		if req.Status != nil {
+			params.Set("status", string(*req.Status))
+		}
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+	}
+
+	path := "/admin/invitations"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListInvitationsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RevokeInvitation revokes a pending invitation.
+func (c *Client) RevokeInvitation(ctx context.Context, id string) (*types.SuccessResponse, error) {
+	var resp types.SuccessResponse
+	if err := c.http.Delete(ctx, "/admin/invitations/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
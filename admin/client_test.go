@@ -0,0 +1,221 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAdminTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewClient(httpClient), server
+}
+
+func TestClient_ListMembers(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/admin/members", r.URL.Path)
+		assert.Equal(t, "jane@example.com", r.URL.Query().Get("email"))
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListMembersResponse{
+			Members: []Member{
+				{
+					ID:     "mem-123",
+					UserID: "user-123",
+					Email:  "jane@example.com",
+					Role:   RoleDeveloper,
+					ProjectRoles: []ProjectRole{
+						{ProjectSlug: "billing", Role: RoleViewer},
+					},
+					Status:   MemberStatusActive,
+					JoinedAt: time.Now(),
+				},
+			},
+			Total: 1,
+		})
+	})
+	defer server.Close()
+
+	email := "jane@example.com"
+	status := MemberStatusActive
+	resp, err := adminClient.ListMembers(context.Background(), &ListMembersRequest{
+		Email:  &email,
+		Status: &status,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Members, 1)
+	assert.Equal(t, RoleDeveloper, resp.Members[0].Role)
+	assert.Equal(t, RoleViewer, resp.Members[0].ProjectRoles[0].Role)
+}
+
+func TestClient_UpdateMember(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/admin/members/mem-123", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "suspended", body["status"])
+		assert.NotContains(t, body, "id")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Member{ID: "mem-123", Role: RoleDeveloper, Status: MemberStatusSuspended})
+	})
+	defer server.Close()
+
+	status := MemberStatusSuspended
+	resp, err := adminClient.UpdateMember(context.Background(), &UpdateMemberRequest{
+		ID:     "mem-123",
+		Status: &status,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, MemberStatusSuspended, resp.Status)
+}
+
+func TestClient_SetProjectRole(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/admin/members/mem-123/projects/billing", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "admin", body["role"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Member{
+			ID:           "mem-123",
+			ProjectRoles: []ProjectRole{{ProjectSlug: "billing", Role: RoleAdmin}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := adminClient.SetProjectRole(context.Background(), "mem-123", "billing", RoleAdmin)
+
+	require.NoError(t, err)
+	assert.Equal(t, RoleAdmin, resp.ProjectRoles[0].Role)
+}
+
+func TestClient_RemoveProjectRole(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/admin/members/mem-123/projects/billing", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Member{ID: "mem-123", ProjectRoles: []ProjectRole{}})
+	})
+	defer server.Close()
+
+	resp, err := adminClient.RemoveProjectRole(context.Background(), "mem-123", "billing")
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.ProjectRoles)
+}
+
+func TestClient_RemoveMember(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/admin/members/mem-123", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, true, body["revokeApiKeys"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(RemoveMemberResponse{Success: true, RevokedAPIKeys: 2})
+	})
+	defer server.Close()
+
+	revoke := true
+	resp, err := adminClient.RemoveMember(context.Background(), &RemoveMemberRequest{
+		ID:            "mem-123",
+		RevokeAPIKeys: &revoke,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, 2, resp.RevokedAPIKeys)
+}
+
+func TestClient_Invite(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/admin/invitations", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", body["email"])
+		assert.Equal(t, "viewer", body["role"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Invitation{
+			ID:     "inv-123",
+			Email:  "new@example.com",
+			Role:   RoleViewer,
+			Status: InvitationStatusPending,
+		})
+	})
+	defer server.Close()
+
+	resp, err := adminClient.Invite(context.Background(), &InviteMemberRequest{
+		Email: "new@example.com",
+		Role:  RoleViewer,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "inv-123", resp.ID)
+	assert.Equal(t, InvitationStatusPending, resp.Status)
+}
+
+func TestClient_ListInvitations(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/admin/invitations", r.URL.Path)
+		assert.Equal(t, "pending", r.URL.Query().Get("status"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListInvitationsResponse{
+			Invitations: []Invitation{{ID: "inv-123", Status: InvitationStatusPending}},
+			Total:       1,
+		})
+	})
+	defer server.Close()
+
+	status := InvitationStatusPending
+	resp, err := adminClient.ListInvitations(context.Background(), &ListInvitationsRequest{Status: &status})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Invitations, 1)
+}
+
+func TestClient_RevokeInvitation(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/admin/invitations/inv-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(types.SuccessResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := adminClient.RevokeInvitation(context.Background(), "inv-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
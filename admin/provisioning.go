@@ -0,0 +1,117 @@
+package admin
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// GetGroupMappings retrieves the identity provider group to role mappings
+// used by provisioning.
+func (c *Client) GetGroupMappings(ctx context.Context) (*GroupMappingsResponse, error) {
+	var resp GroupMappingsResponse
+	if err := c.http.Get(ctx, "/admin/provisioning/group-mappings", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetGroupMappings replaces the identity provider group to role mappings.
+func (c *Client) SetGroupMappings(ctx context.Context, req *SetGroupMappingsRequest) (*GroupMappingsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp GroupMappingsResponse
+	if err := c.http.Put(ctx, "/admin/provisioning/group-mappings", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Sync reconciles organization members against a snapshot of users from the
+// identity provider. Set DryRun to get the report without applying it.
+func (c *Client) Sync(ctx context.Context, req *SyncRequest) (*SyncReport, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SyncReport
+	if err := c.http.Post(ctx, "/admin/provisioning/sync", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSyncReport retrieves a past sync report by ID.
+func (c *Client) GetSyncReport(ctx context.Context, id string) (*SyncReport, error) {
+	var resp SyncReport
+	if err := c.http.Get(ctx, "/admin/provisioning/reports/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListSyncReports lists past sync reports, most recent first. Reports from
+// SCIM pushes are included.
+func (c *Client) ListSyncReports(ctx context.Context, req *ListSyncReportsRequest) (*ListSyncReportsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req != nil {
+		if req.DryRun != nil {
+			params.Set("dryRun", strconv.FormatBool(*req.DryRun))
+		}
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+	}
+
+	path := "/admin/provisioning/reports"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListSyncReportsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSCIMConfig retrieves the organization's SCIM endpoint configuration.
+func (c *Client) GetSCIMConfig(ctx context.Context) (*SCIMConfig, error) {
+	var resp SCIMConfig
+	if err := c.http.Get(ctx, "/admin/provisioning/scim", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateSCIMConfig enables or disables the SCIM endpoint.
+func (c *Client) UpdateSCIMConfig(ctx context.Context, req *UpdateSCIMConfigRequest) (*SCIMConfig, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SCIMConfig
+	if err := c.http.Patch(ctx, "/admin/provisioning/scim", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RotateSCIMToken issues a new bearer token for the SCIM endpoint and
+// invalidates the previous one. The token is only returned by this call.
+func (c *Client) RotateSCIMToken(ctx context.Context) (*SCIMConfig, error) {
+	var resp SCIMConfig
+	if err := c.http.Post(ctx, "/admin/provisioning/scim/rotate-token", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetGroupMappings(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/admin/provisioning/group-mappings", r.URL.Path)
+
+		var body SetGroupMappingsRequest
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Len(t, body.Mappings, 2)
+		assert.Equal(t, "engineering", body.Mappings[0].Group)
+		assert.Equal(t, RoleAdmin, body.Mappings[1].ProjectRoles[0].Role)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(GroupMappingsResponse{Mappings: body.Mappings})
+	})
+	defer server.Close()
+
+	resp, err := adminClient.SetGroupMappings(context.Background(), &SetGroupMappingsRequest{
+		Mappings: []GroupMapping{
+			{Group: "engineering", Role: RoleDeveloper},
+			{Group: "web-team", Role: RoleViewer, ProjectRoles: []ProjectRole{{ProjectSlug: "website", Role: RoleAdmin}}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Mappings, 2)
+}
+
+func TestClient_Sync_DryRun(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/admin/provisioning/sync", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, true, body["dryRun"])
+		assert.Equal(t, "suspend", body["deprovision"])
+		users := body["users"].([]interface{})
+		require.Len(t, users, 2)
+		assert.Equal(t, "00u1", users[0].(map[string]interface{})["externalId"])
+		assert.Equal(t, false, users[1].(map[string]interface{})["active"])
+
+		memberID := "mem-2"
+		previous := RoleDeveloper
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SyncReport{
+			ID:     "sync-123",
+			DryRun: true,
+			Changes: []ProvisioningChange{
+				{Action: ProvisioningActionInvite, Email: "new@example.com", Reason: "not a member"},
+				{Action: ProvisioningActionSuspend, Email: "gone@example.com", MemberID: &memberID, PreviousRole: &previous, Reason: "inactive in identity provider"},
+			},
+			Unchanged: 12,
+			CreatedAt: time.Now(),
+		})
+	})
+	defer server.Close()
+
+	dryRun := true
+	mode := DeprovisionSuspend
+	resp, err := adminClient.Sync(context.Background(), &SyncRequest{
+		Users: []ProvisionedUser{
+			{ExternalID: "00u1", Email: "new@example.com", Active: true, Groups: []string{"engineering"}},
+			{ExternalID: "00u2", Email: "gone@example.com", Active: false},
+		},
+		DryRun:      &dryRun,
+		Deprovision: &mode,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.DryRun)
+	require.Len(t, resp.Changes, 2)
+	assert.Equal(t, ProvisioningActionSuspend, resp.Changes[1].Action)
+	assert.Equal(t, RoleDeveloper, *resp.Changes[1].PreviousRole)
+	assert.Equal(t, 12, resp.Unchanged)
+}
+
+func TestClient_ListSyncReports(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/admin/provisioning/reports", r.URL.Path)
+		assert.Equal(t, "false", r.URL.Query().Get("dryRun"))
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListSyncReportsResponse{
+			Reports: []SyncReport{{ID: "sync-123"}},
+			Total:   1,
+		})
+	})
+	defer server.Close()
+
+	dryRun := false
+	limit := 5
+	resp, err := adminClient.ListSyncReports(context.Background(), &ListSyncReportsRequest{DryRun: &dryRun, Limit: &limit})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Reports, 1)
+}
+
+func TestClient_RotateSCIMToken(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/admin/provisioning/scim/rotate-token", r.URL.Path)
+
+		token := "scim_abc"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SCIMConfig{
+			Enabled:     true,
+			BaseURL:     "https://api.stack0.dev/scim/v2/org-123",
+			Token:       &token,
+			Deprovision: DeprovisionSuspend,
+		})
+	})
+	defer server.Close()
+
+	resp, err := adminClient.RotateSCIMToken(context.Background())
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Token)
+	assert.Equal(t, "scim_abc", *resp.Token)
+}
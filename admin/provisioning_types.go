@@ -0,0 +1,128 @@
+package admin
+
+import "time"
+
+// ProvisioningAction is a change a provisioning sync makes, or would make in
+// a dry run, to an organization member.
+type ProvisioningAction string
+
+const (
+	ProvisioningActionInvite     ProvisioningAction = "invite"
+	ProvisioningActionUpdate     ProvisioningAction = "update"
+	ProvisioningActionSuspend    ProvisioningAction = "suspend"
+	ProvisioningActionReactivate ProvisioningAction = "reactivate"
+	ProvisioningActionRemove     ProvisioningAction = "remove"
+)
+
+// DeprovisionMode controls what happens to members who are missing from the
+// identity provider, or marked inactive there.
+type DeprovisionMode string
+
+const (
+	DeprovisionSuspend DeprovisionMode = "suspend"
+	DeprovisionRemove  DeprovisionMode = "remove"
+	DeprovisionIgnore  DeprovisionMode = "ignore"
+)
+
+// GroupMapping maps an identity provider group to Stack0 roles. A user in
+// several mapped groups receives the most privileged role from each.
+type GroupMapping struct {
+	Group        string        `json:"group"`
+	Role         Role          `json:"role"`
+	ProjectRoles []ProjectRole `json:"projectRoles,omitempty"`
+}
+
+// SetGroupMappingsRequest replaces the organization's group mappings.
+type SetGroupMappingsRequest struct {
+	Mappings []GroupMapping `json:"mappings"`
+}
+
+// GroupMappingsResponse is the organization's current group mappings.
+type GroupMappingsResponse struct {
+	Mappings  []GroupMapping `json:"mappings"`
+	UpdatedAt *time.Time     `json:"updatedAt,omitempty"`
+}
+
+// ProvisionedUser is a user as known to the identity provider. ExternalID is
+// the provider's stable identifier and is used to match existing members
+// even when their email changes.
+type ProvisionedUser struct {
+	ExternalID string   `json:"externalId"`
+	Email      string   `json:"email"`
+	Name       *string  `json:"name,omitempty"`
+	Active     bool     `json:"active"`
+	Groups     []string `json:"groups"`
+}
+
+// SyncRequest is a full snapshot of users from the identity provider.
+// Members are matched by ExternalID, then by email; roles come from the
+// organization's group mappings. With DryRun set, the report is computed
+// but nothing is changed. Owners are never modified by a sync.
+type SyncRequest struct {
+	Users       []ProvisionedUser `json:"users"`
+	DryRun      *bool             `json:"dryRun,omitempty"`
+	Deprovision *DeprovisionMode  `json:"deprovision,omitempty"`
+	DefaultRole *Role             `json:"defaultRole,omitempty"`
+	SendInvites *bool             `json:"sendInvites,omitempty"`
+}
+
+// ProvisioningChange is a single change in a sync report.
+type ProvisioningChange struct {
+	Action       ProvisioningAction `json:"action"`
+	ExternalID   *string            `json:"externalId,omitempty"`
+	Email        string             `json:"email"`
+	MemberID     *string            `json:"memberId,omitempty"`
+	Role         *Role              `json:"role,omitempty"`
+	PreviousRole *Role              `json:"previousRole,omitempty"`
+	ProjectRoles []ProjectRole      `json:"projectRoles,omitempty"`
+	Reason       string             `json:"reason"`
+}
+
+// ProvisioningError is a user the sync could not process.
+type ProvisioningError struct {
+	ExternalID *string `json:"externalId,omitempty"`
+	Email      string  `json:"email"`
+	Code       string  `json:"code"`
+	Message    string  `json:"message"`
+}
+
+// SyncReport is the result of a provisioning sync. For a dry run it lists
+// the changes a real sync would make.
+type SyncReport struct {
+	ID        string               `json:"id"`
+	DryRun    bool                 `json:"dryRun"`
+	Changes   []ProvisioningChange `json:"changes"`
+	Unchanged int                  `json:"unchanged"`
+	Errors    []ProvisioningError  `json:"errors"`
+	CreatedAt time.Time            `json:"createdAt"`
+}
+
+// ListSyncReportsRequest is the request to list past sync reports.
+type ListSyncReportsRequest struct {
+	DryRun *bool
+	Limit  *int
+	Offset *int
+}
+
+// ListSyncReportsResponse is the response from listing sync reports.
+type ListSyncReportsResponse struct {
+	Reports []SyncReport `json:"reports"`
+	Total   int          `json:"total"`
+}
+
+// SCIMConfig describes the organization's SCIM 2.0 endpoint, which identity
+// providers can push users and groups to directly instead of calling Sync.
+// Token is only returned when the token is rotated.
+type SCIMConfig struct {
+	Enabled     bool            `json:"enabled"`
+	BaseURL     string          `json:"baseUrl"`
+	Token       *string         `json:"token,omitempty"`
+	Deprovision DeprovisionMode `json:"deprovision"`
+	LastSyncAt  *time.Time      `json:"lastSyncAt,omitempty"`
+}
+
+// UpdateSCIMConfigRequest enables or disables the SCIM endpoint.
+type UpdateSCIMConfigRequest struct {
+	Enabled     *bool            `json:"enabled,omitempty"`
+	Deprovision *DeprovisionMode `json:"deprovision,omitempty"`
+}
@@ -0,0 +1,65 @@
+package admin
+
+import "context"
+
+// GetSecuritySettings retrieves the organization's API security settings.
+func (c *Client) GetSecuritySettings(ctx context.Context) (*SecuritySettings, error) {
+	var resp SecuritySettings
+	if err := c.http.Get(ctx, "/admin/security", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateSecuritySettings updates the organization's API security settings.
+// Enforcing an allowlist that excludes the caller's address locks the
+// calling key out.
+func (c *Client) UpdateSecuritySettings(ctx context.Context, req *UpdateSecuritySettingsRequest) (*SecuritySettings, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SecuritySettings
+	if err := c.http.Patch(ctx, "/admin/security", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetIPAllowlist replaces the organization IP allowlist. An empty list clears
+// it.
+func (c *Client) SetIPAllowlist(ctx context.Context, entries []IPAllowlistEntry) (*SecuritySettings, error) {
+	if entries == nil {
+		entries = []IPAllowlistEntry{}
+	}
+	var resp SecuritySettings
+	body := map[string]interface{}{"entries": entries}
+	if err := c.http.Put(ctx, "/admin/security/ip-allowlist", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListAPIKeys lists the organization's API keys with their allowlists.
+func (c *Client) ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error) {
+	var resp ListAPIKeysResponse
+	if err := c.http.Get(ctx, "/admin/api-keys", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetAPIKeyIPAllowlist replaces an API key's own IP allowlist, which takes
+// precedence over the organization allowlist. An empty list removes it, so
+// the organization allowlist applies to the key again.
+func (c *Client) SetAPIKeyIPAllowlist(ctx context.Context, keyID string, entries []IPAllowlistEntry) (*APIKey, error) {
+	if entries == nil {
+		entries = []IPAllowlistEntry{}
+	}
+	var resp APIKey
+	body := map[string]interface{}{"entries": entries}
+	if err := c.http.Put(ctx, "/admin/api-keys/"+keyID+"/ip-allowlist", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_UpdateSecuritySettings(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/admin/security", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "hmac-sha512", body["webhookSignatureAlgorithm"])
+		assert.Equal(t, "1.3", body["minTlsVersion"])
+		assert.NotContains(t, body, "enforceIpAllowlist")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SecuritySettings{
+			WebhookSignatureAlgorithm: SignatureHMACSHA512,
+			MinTLSVersion:             TLSVersion13,
+		})
+	})
+	defer server.Close()
+
+	algorithm := SignatureHMACSHA512
+	tls := TLSVersion13
+	resp, err := adminClient.UpdateSecuritySettings(context.Background(), &UpdateSecuritySettingsRequest{
+		WebhookSignatureAlgorithm: &algorithm,
+		MinTLSVersion:             &tls,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, TLSVersion13, resp.MinTLSVersion)
+}
+
+func TestClient_SetIPAllowlist(t *testing.T) {
+	t.Run("replaces entries", func(t *testing.T) {
+		adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/admin/security/ip-allowlist", r.URL.Path)
+
+			var body map[string][]IPAllowlistEntry
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			require.Len(t, body["entries"], 2)
+			assert.Equal(t, "203.0.113.0/24", body["entries"][0].CIDR)
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SecuritySettings{IPAllowlist: body["entries"]})
+		})
+		defer server.Close()
+
+		resp, err := adminClient.SetIPAllowlist(context.Background(), []IPAllowlistEntry{
+			{CIDR: "203.0.113.0/24"},
+			{CIDR: "198.51.100.7/32"},
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, resp.IPAllowlist, 2)
+	})
+
+	t.Run("nil clears the list", func(t *testing.T) {
+		adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, []interface{}{}, body["entries"])
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SecuritySettings{})
+		})
+		defer server.Close()
+
+		_, err := adminClient.SetIPAllowlist(context.Background(), nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_SetAPIKeyIPAllowlist(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/admin/api-keys/key-123/ip-allowlist", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(APIKey{
+			ID:          "key-123",
+			Prefix:      "stack0_ab12",
+			IPAllowlist: []IPAllowlistEntry{{CIDR: "10.0.0.0/8"}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := adminClient.SetAPIKeyIPAllowlist(context.Background(), "key-123", []IPAllowlistEntry{{CIDR: "10.0.0.0/8"}})
+
+	require.NoError(t, err)
+	assert.Equal(t, "10.0.0.0/8", resp.IPAllowlist[0].CIDR)
+}
+
+func TestClient_IPNotAllowed(t *testing.T) {
+	adminClient, server := setupAdminTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{
+			"code":    ErrCodeIPNotAllowed,
+			"message": "Address 192.0.2.1 is not in the allowlist",
+		})
+	})
+	defer server.Close()
+
+	_, err := adminClient.ListAPIKeys(context.Background())
+
+	var apiErr *types.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, ErrCodeIPNotAllowed, apiErr.Code)
+}
@@ -0,0 +1,68 @@
+package admin
+
+import "time"
+
+// ErrCodeIPNotAllowed is the APIError code returned when a request comes from
+// an address outside the API key's or organization's IP allowlist.
+const ErrCodeIPNotAllowed = "IP_NOT_ALLOWED"
+
+// SignatureAlgorithm is the algorithm used to sign outgoing webhooks.
+type SignatureAlgorithm string
+
+const (
+	SignatureHMACSHA256 SignatureAlgorithm = "hmac-sha256"
+	SignatureHMACSHA512 SignatureAlgorithm = "hmac-sha512"
+)
+
+// TLSVersion is a minimum TLS version accepted by the API and used for
+// webhook deliveries.
+type TLSVersion string
+
+const (
+	TLSVersion12 TLSVersion = "1.2"
+	TLSVersion13 TLSVersion = "1.3"
+)
+
+// IPAllowlistEntry is an address range allowed to use the API, in CIDR
+// notation. A single address is written as a /32 (or /128 for IPv6).
+type IPAllowlistEntry struct {
+	CIDR        string  `json:"cidr"`
+	Description *string `json:"description,omitempty"`
+}
+
+// SecuritySettings are the organization-wide API security settings. The
+// organization allowlist applies to every API key without its own allowlist,
+// and is only enforced when EnforceIPAllowlist is true.
+type SecuritySettings struct {
+	IPAllowlist               []IPAllowlistEntry `json:"ipAllowlist"`
+	EnforceIPAllowlist        bool               `json:"enforceIpAllowlist"`
+	WebhookSignatureAlgorithm SignatureAlgorithm `json:"webhookSignatureAlgorithm"`
+	MinTLSVersion             TLSVersion         `json:"minTlsVersion"`
+	UpdatedAt                 *time.Time         `json:"updatedAt,omitempty"`
+}
+
+// UpdateSecuritySettingsRequest updates the organization's security
+// settings. The allowlist itself is replaced with SetIPAllowlist.
+type UpdateSecuritySettingsRequest struct {
+	EnforceIPAllowlist        *bool               `json:"enforceIpAllowlist,omitempty"`
+	WebhookSignatureAlgorithm *SignatureAlgorithm `json:"webhookSignatureAlgorithm,omitempty"`
+	MinTLSVersion             *TLSVersion         `json:"minTlsVersion,omitempty"`
+}
+
+// APIKey is an organization API key. The secret itself is never returned;
+// Prefix identifies the key.
+type APIKey struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Prefix      string             `json:"prefix"`
+	IPAllowlist []IPAllowlistEntry `json:"ipAllowlist"`
+	CreatedBy   *string            `json:"createdBy,omitempty"`
+	LastUsedAt  *time.Time         `json:"lastUsedAt,omitempty"`
+	ExpiresAt   *time.Time         `json:"expiresAt,omitempty"`
+	CreatedAt   time.Time          `json:"createdAt"`
+}
+
+// ListAPIKeysResponse is the response from listing API keys.
+type ListAPIKeysResponse struct {
+	Keys []APIKey `json:"keys"`
+}
@@ -0,0 +1,125 @@
+package admin
+
+import "time"
+
+// Role is a member's role in the organization or in a project.
+type Role string
+
+const (
+	RoleOwner     Role = "owner"
+	RoleAdmin     Role = "admin"
+	RoleDeveloper Role = "developer"
+	RoleViewer    Role = "viewer"
+	RoleBilling   Role = "billing"
+)
+
+// MemberStatus is the status of an organization member.
+type MemberStatus string
+
+const (
+	MemberStatusActive    MemberStatus = "active"
+	MemberStatusSuspended MemberStatus = "suspended"
+)
+
+// InvitationStatus is the status of an invitation.
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusExpired  InvitationStatus = "expired"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+)
+
+// ProjectRole is a member's role in a single project. It overrides the
+// member's organization role for that project.
+type ProjectRole struct {
+	ProjectID   string `json:"projectId,omitempty"`
+	ProjectSlug string `json:"projectSlug"`
+	Role        Role   `json:"role"`
+}
+
+// Member is a user with access to the organization.
+type Member struct {
+	ID           string        `json:"id"`
+	UserID       string        `json:"userId"`
+	Email        string        `json:"email"`
+	Name         *string       `json:"name,omitempty"`
+	Role         Role          `json:"role"`
+	ProjectRoles []ProjectRole `json:"projectRoles"`
+	Status       MemberStatus  `json:"status"`
+	LastActiveAt *time.Time    `json:"lastActiveAt,omitempty"`
+	JoinedAt     time.Time     `json:"joinedAt"`
+}
+
+// ListMembersRequest is the request to list organization members.
+type ListMembersRequest struct {
+	Email       *string
+	Role        *Role
+	ProjectSlug *string
+	Status      *MemberStatus
+	Limit       *int
+	Offset      *int
+}
+
+// ListMembersResponse is the response from listing organization members.
+type ListMembersResponse struct {
+	Members []Member `json:"members"`
+	Total   int      `json:"total"`
+	Limit   int      `json:"limit"`
+	Offset  int      `json:"offset"`
+}
+
+// UpdateMemberRequest is the request to change a member's organization role
+// or status.
+type UpdateMemberRequest struct {
+	ID     string        `json:"-" validate:"required"`
+	Role   *Role         `json:"role,omitempty"`
+	Status *MemberStatus `json:"status,omitempty"`
+}
+
+// RemoveMemberRequest is the request to remove a member from the
+// organization. When RevokeAPIKeys is set, API keys created by the member
+// are revoked as well.
+type RemoveMemberRequest struct {
+	ID            string `json:"-" validate:"required"`
+	RevokeAPIKeys *bool  `json:"revokeApiKeys,omitempty"`
+}
+
+// RemoveMemberResponse is the response from removing a member.
+type RemoveMemberResponse struct {
+	Success        bool `json:"success"`
+	RevokedAPIKeys int  `json:"revokedApiKeys"`
+}
+
+// Invitation is a pending invitation to join the organization.
+type Invitation struct {
+	ID           string           `json:"id"`
+	Email        string           `json:"email"`
+	Role         Role             `json:"role"`
+	ProjectRoles []ProjectRole    `json:"projectRoles"`
+	Status       InvitationStatus `json:"status"`
+	InvitedBy    *string          `json:"invitedBy,omitempty"`
+	ExpiresAt    time.Time        `json:"expiresAt"`
+	CreatedAt    time.Time        `json:"createdAt"`
+}
+
+// InviteMemberRequest is the request to invite a user to the organization.
+type InviteMemberRequest struct {
+	Email        string        `json:"email" validate:"required"`
+	Role         Role          `json:"role" validate:"required"`
+	ProjectRoles []ProjectRole `json:"projectRoles,omitempty"`
+}
+
+// ListInvitationsRequest is the request to list invitations.
+type ListInvitationsRequest struct {
+	Status *InvitationStatus
+	Limit  *int
+	Offset *int
+}
+
+// ListInvitationsResponse is the response from listing invitations.
+type ListInvitationsResponse struct {
+	Invitations []Invitation `json:"invitations"`
+	Total       int          `json:"total"`
+}
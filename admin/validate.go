@@ -0,0 +1,17 @@
+package admin
+
+import "github.com/stack0/sdk-go/types"
+
+func init() {
+	types.RegisterEnum(DeprovisionSuspend, DeprovisionRemove, DeprovisionIgnore)
+	types.RegisterEnum(RoleOwner, RoleAdmin, RoleDeveloper, RoleViewer, RoleBilling)
+	types.RegisterEnum(SignatureHMACSHA256, SignatureHMACSHA512)
+	types.RegisterEnum(TLSVersion12, TLSVersion13)
+	types.RegisterEnum(MemberStatusActive, MemberStatusSuspended)
+	types.RegisterEnum(
+		InvitationStatusPending,
+		InvitationStatusAccepted,
+		InvitationStatusExpired,
+		InvitationStatusRevoked,
+	)
+}
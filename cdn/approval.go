@@ -0,0 +1,49 @@
+package cdn
+
+import "context"
+
+// SubmitForReview moves a draft asset to pending review.
+func (c *Client) SubmitForReview(ctx context.Context, assetID string) (*Asset, error) {
+	var resp Asset
+	if err := c.http.Post(ctx, "/cdn/assets/"+assetID+"/review/submit", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ApproveAsset approves an asset pending review, allowing the CDN to deliver
+// it.
+func (c *Client) ApproveAsset(ctx context.Context, assetID string, comment *string) (*Asset, error) {
+	return c.reviewAsset(ctx, assetID, "approve", comment)
+}
+
+// RejectAsset returns an asset pending review to draft. comment tells the
+// submitter what to change.
+func (c *Client) RejectAsset(ctx context.Context, assetID string, comment *string) (*Asset, error) {
+	return c.reviewAsset(ctx, assetID, "reject", comment)
+}
+
+func (c *Client) reviewAsset(ctx context.Context, assetID, action string, comment *string) (*Asset, error) {
+	body := map[string]interface{}{}
+	if comment != nil {
+		body["comment"] = *comment
+	}
+
+	var resp Asset
+	if err := c.http.Post(ctx, "/cdn/assets/"+assetID+"/review/"+action, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetFolderApproval sets whether assets in a folder and its subfolders must
+// be approved before the CDN delivers them. New uploads to such folders start
+// as drafts.
+func (c *Client) SetFolderApproval(ctx context.Context, folderID string, requireApproval bool) (*Folder, error) {
+	var resp Folder
+	body := map[string]interface{}{"requireApproval": requireApproval}
+	if err := c.http.Put(ctx, "/cdn/folders/"+folderID+"/approval", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
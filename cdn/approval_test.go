@@ -0,0 +1,124 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SubmitForReview(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/assets/asset-1/review/submit", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Asset{ID: "asset-1", Review: &AssetReview{State: ReviewStatePendingReview}})
+	})
+	defer server.Close()
+
+	asset, err := cdnClient.SubmitForReview(context.Background(), "asset-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, ReviewStatePendingReview, asset.Review.State)
+}
+
+func TestClient_ApproveAsset(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/assets/asset-1/review/approve", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Empty(t, body)
+
+		reviewer := "user-1"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Asset{ID: "asset-1", Review: &AssetReview{State: ReviewStateApproved, ReviewedBy: &reviewer}})
+	})
+	defer server.Close()
+
+	asset, err := cdnClient.ApproveAsset(context.Background(), "asset-1", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, ReviewStateApproved, asset.Review.State)
+	assert.Equal(t, "user-1", *asset.Review.ReviewedBy)
+}
+
+func TestClient_RejectAsset(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cdn/assets/asset-1/review/reject", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "Logo uses the old palette", body["comment"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Asset{ID: "asset-1", Review: &AssetReview{State: ReviewStateDraft}})
+	})
+	defer server.Close()
+
+	comment := "Logo uses the old palette"
+	asset, err := cdnClient.RejectAsset(context.Background(), "asset-1", &comment)
+
+	require.NoError(t, err)
+	assert.Equal(t, ReviewStateDraft, asset.Review.State)
+}
+
+func TestClient_ApproveAsset_InvalidTransition(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(types.ErrorResponse{
+			Message: "asset is not pending review",
+			Code:    ErrCodeInvalidReviewTransition,
+		})
+	})
+	defer server.Close()
+
+	_, err := cdnClient.ApproveAsset(context.Background(), "asset-1", nil)
+
+	var apiErr *types.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, ErrCodeInvalidReviewTransition, apiErr.Code)
+}
+
+func TestClient_SetFolderApproval(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/cdn/folders/folder-1/approval", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, true, body["requireApproval"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Folder{ID: "folder-1", RequireApproval: true})
+	})
+	defer server.Close()
+
+	folder, err := cdnClient.SetFolderApproval(context.Background(), "folder-1", true)
+
+	require.NoError(t, err)
+	assert.True(t, folder.RequireApproval)
+}
+
+func TestClient_List_ReviewState(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "pending_review", r.URL.Query().Get("reviewState"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListAssetsResponse{Assets: []Asset{{ID: "asset-1"}}, Total: 1})
+	})
+	defer server.Close()
+
+	state := ReviewStatePendingReview
+	resp, err := cdnClient.List(context.Background(), &ListAssetsRequest{ProjectSlug: "my-project", ReviewState: &state})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Total)
+}
@@ -0,0 +1,29 @@
+package cdn
+
+import "time"
+
+// ErrCodeInvalidReviewTransition is the APIError code returned when an asset
+// is not in the right review state for the transition, e.g. when approving a
+// draft that was never submitted.
+const ErrCodeInvalidReviewTransition = "INVALID_REVIEW_TRANSITION"
+
+// ReviewState is the review state of an asset. In folders that require
+// approval, only approved assets are delivered by the CDN.
+type ReviewState string
+
+const (
+	ReviewStateDraft         ReviewState = "draft"
+	ReviewStatePendingReview ReviewState = "pending_review"
+	ReviewStateApproved      ReviewState = "approved"
+)
+
+// AssetReview is the review state of an asset. Comment is the reviewer's
+// comment on the last approval or rejection.
+type AssetReview struct {
+	State       ReviewState `json:"state"`
+	SubmittedBy *string     `json:"submittedBy,omitempty"`
+	SubmittedAt *time.Time  `json:"submittedAt,omitempty"`
+	ReviewedBy  *string     `json:"reviewedBy,omitempty"`
+	ReviewedAt  *time.Time  `json:"reviewedAt,omitempty"`
+	Comment     *string     `json:"comment,omitempty"`
+}
@@ -0,0 +1,119 @@
+package cdn
+
+import (
+	"context"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// AssetScope is a handle to a single asset, returned by Client.ForAsset. Its
+// methods fill in the asset ID, so request structs passed to them can leave
+// it empty; an ID that is set is overwritten. The caller's request is copied,
+// never modified.
+type AssetScope struct {
+	c  *Client
+	id string
+}
+
+// ForAsset returns a handle to the asset with the given ID.
+//
+//	asset := client.CDN.ForAsset("asset-123")
+//	job, err := asset.Transcode(ctx, &cdn.TranscodeVideoRequest{ProjectSlug: "my-project", OutputFormat: cdn.VideoOutputHLS})
+func (c *Client) ForAsset(id string) *AssetScope {
+	return &AssetScope{c: c, id: id}
+}
+
+// ID returns the ID of the asset.
+func (s *AssetScope) ID() string {
+	return s.id
+}
+
+// Get gets the asset, including any requested related data.
+func (s *AssetScope) Get(ctx context.Context, expand ...AssetExpand) (*Asset, error) {
+	return s.c.Get(ctx, s.id, expand...)
+}
+
+// Update updates the asset's metadata.
+func (s *AssetScope) Update(ctx context.Context, req *UpdateAssetRequest) (*Asset, error) {
+	return s.c.Update(ctx, types.WithID(req, func(r *UpdateAssetRequest) { r.ID = s.id }))
+}
+
+// Delete deletes the asset.
+func (s *AssetScope) Delete(ctx context.Context) (*SuccessResponse, error) {
+	return s.c.Delete(ctx, s.id)
+}
+
+// ConfirmUpload confirms that the asset's upload is complete.
+func (s *AssetScope) ConfirmUpload(ctx context.Context) (*Asset, error) {
+	return s.c.ConfirmUpload(ctx, s.id)
+}
+
+// SubmitForReview submits the asset for approval.
+func (s *AssetScope) SubmitForReview(ctx context.Context) (*Asset, error) {
+	return s.c.SubmitForReview(ctx, s.id)
+}
+
+// Approve approves the asset.
+func (s *AssetScope) Approve(ctx context.Context, comment *string) (*Asset, error) {
+	return s.c.ApproveAsset(ctx, s.id, comment)
+}
+
+// Reject rejects the asset.
+func (s *AssetScope) Reject(ctx context.Context, comment *string) (*Asset, error) {
+	return s.c.RejectAsset(ctx, s.id, comment)
+}
+
+// SetReplication sets the asset's replication policy.
+func (s *AssetScope) SetReplication(ctx context.Context, policy *ReplicationPolicy) (*Asset, error) {
+	return s.c.SetAssetReplication(ctx, s.id, policy)
+}
+
+// GetReplication gets the asset's replication status.
+func (s *AssetScope) GetReplication(ctx context.Context) (*AssetReplication, error) {
+	return s.c.GetAssetReplication(ctx, s.id)
+}
+
+// Transcode starts a transcoding job for the asset.
+func (s *AssetScope) Transcode(ctx context.Context, req *TranscodeVideoRequest) (*TranscodeJob, error) {
+	return s.c.Transcode(ctx, types.WithID(req, func(r *TranscodeVideoRequest) { r.AssetID = s.id }))
+}
+
+// GetStreamingURLs gets the asset's streaming URLs.
+func (s *AssetScope) GetStreamingURLs(ctx context.Context) (*StreamingURLs, error) {
+	return s.c.GetStreamingURLs(ctx, s.id)
+}
+
+// GetThumbnail generates a thumbnail from the asset.
+func (s *AssetScope) GetThumbnail(ctx context.Context, req *ThumbnailRequest) (*ThumbnailResponse, error) {
+	return s.c.GetThumbnail(ctx, types.WithID(req, func(r *ThumbnailRequest) { r.AssetID = s.id }))
+}
+
+// RegenerateThumbnail regenerates the asset's thumbnail.
+func (s *AssetScope) RegenerateThumbnail(ctx context.Context, req *RegenerateThumbnailRequest) (*RegenerateThumbnailResponse, error) {
+	return s.c.RegenerateThumbnail(ctx, types.WithID(req, func(r *RegenerateThumbnailRequest) { r.AssetID = s.id }))
+}
+
+// ListThumbnails lists the asset's thumbnails.
+func (s *AssetScope) ListThumbnails(ctx context.Context) (*ListThumbnailsResponse, error) {
+	return s.c.ListThumbnails(ctx, s.id)
+}
+
+// ExtractAudio extracts the asset's audio track.
+func (s *AssetScope) ExtractAudio(ctx context.Context, req *ExtractAudioRequest) (*ExtractAudioResponse, error) {
+	return s.c.ExtractAudio(ctx, types.WithID(req, func(r *ExtractAudioRequest) { r.AssetID = s.id }))
+}
+
+// GenerateGif generates a GIF from the asset.
+func (s *AssetScope) GenerateGif(ctx context.Context, req *GenerateGifRequest) (*VideoGif, error) {
+	return s.c.GenerateGif(ctx, types.WithID(req, func(r *GenerateGifRequest) { r.AssetID = s.id }))
+}
+
+// ListGifs lists the GIFs generated from the asset.
+func (s *AssetScope) ListGifs(ctx context.Context) ([]VideoGif, error) {
+	return s.c.ListGifs(ctx, s.id)
+}
+
+// GetArtifactUsage gets the storage used by the asset's video artifacts.
+func (s *AssetScope) GetArtifactUsage(ctx context.Context) (*VideoArtifactUsage, error) {
+	return s.c.GetArtifactUsage(ctx, s.id)
+}
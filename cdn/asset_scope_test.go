@@ -0,0 +1,65 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetScope_Transcode(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/video/transcode", r.URL.Path)
+
+		var req TranscodeVideoRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "asset-123", req.AssetID)
+		assert.Equal(t, VideoOutputHLS, req.OutputFormat)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(TranscodeJob{ID: "job-1", AssetID: "asset-123"})
+	})
+	defer server.Close()
+
+	asset := cdnClient.ForAsset("asset-123")
+	req := &TranscodeVideoRequest{ProjectSlug: "my-project", OutputFormat: VideoOutputHLS}
+	job, err := asset.Transcode(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", job.ID)
+	assert.Empty(t, req.AssetID, "caller's request should not be modified")
+}
+
+func TestAssetScope_Operations(t *testing.T) {
+	var paths []string
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	asset := cdnClient.ForAsset("asset-123")
+	assert.Equal(t, "asset-123", asset.ID())
+
+	_, err := asset.Get(ctx)
+	require.NoError(t, err)
+	_, err = asset.Update(ctx, &UpdateAssetRequest{Alt: ptr("A sunset")})
+	require.NoError(t, err)
+	_, err = asset.GetStreamingURLs(ctx)
+	require.NoError(t, err)
+	_, err = asset.Approve(ctx, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"GET /cdn/assets/asset-123",
+		"PATCH /cdn/assets/asset-123",
+		"GET /cdn/video/stream/asset-123",
+		"POST /cdn/assets/asset-123/review/approve",
+	}, paths)
+}
@@ -0,0 +1,97 @@
+package cdn
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// VerifyBundle checks a downloaded bundle zip against its manifest.json: every
+// listed file must be present with the listed size and SHA-256 hash, and the
+// zip must not contain unlisted files. It returns the manifest, and an error
+// describing every mismatch if the bundle is not intact.
+func VerifyBundle(r io.ReaderAt, size int64) (*BundleManifest, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bundle: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		if !f.FileInfo().IsDir() {
+			files[f.Name] = f
+		}
+	}
+
+	mf, ok := files[BundleManifestName]
+	if !ok {
+		return nil, fmt.Errorf("bundle has no %s", BundleManifestName)
+	}
+	var manifest BundleManifest
+	if err := readZipJSON(mf, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", BundleManifestName, err)
+	}
+	delete(files, BundleManifestName)
+
+	var problems []string
+	for _, entry := range manifest.Files {
+		f, ok := files[entry.Path]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: missing", entry.Path))
+			continue
+		}
+		delete(files, entry.Path)
+
+		n, sum, err := hashZipFile(f)
+		switch {
+		case err != nil:
+			problems = append(problems, fmt.Sprintf("%s: %v", entry.Path, err))
+		case n != entry.Size:
+			problems = append(problems, fmt.Sprintf("%s: size is %d, not %d", entry.Path, n, entry.Size))
+		case !strings.EqualFold(sum, entry.SHA256):
+			problems = append(problems, fmt.Sprintf("%s: SHA-256 is %s, not %s", entry.Path, sum, entry.SHA256))
+		}
+	}
+	extra := make([]string, 0, len(files))
+	for name := range files {
+		extra = append(extra, name)
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		problems = append(problems, fmt.Sprintf("%s: not in manifest", name))
+	}
+
+	if len(problems) > 0 {
+		return &manifest, fmt.Errorf("bundle does not match manifest: %s", strings.Join(problems, "; "))
+	}
+	return &manifest, nil
+}
+
+func readZipJSON(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return json.NewDecoder(rc).Decode(v)
+}
+
+func hashZipFile(f *zip.File) (int64, string, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return 0, "", err
+	}
+	defer rc.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, rc)
+	if err != nil {
+		return 0, "", err
+	}
+	return n, hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,82 @@
+package cdn
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func buildBundle(t *testing.T, manifest *BundleManifest, files map[string][]byte) *bytes.Reader {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	if manifest != nil {
+		w, err := zw.Create(BundleManifestName)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(manifest))
+	}
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestVerifyBundle(t *testing.T) {
+	logo := []byte("logo")
+	report := []byte("quarterly report")
+	manifest := &BundleManifest{
+		BundleID: "bundle-123",
+		Files: []BundleManifestFile{
+			{Path: "brand/logo.png", Size: int64(len(logo)), SHA256: sha256Hex(logo), AssetID: ptr("asset-1")},
+			{Path: "reports/q1.pdf", Size: int64(len(report)), SHA256: sha256Hex(report), PrivateFileID: ptr("file-1")},
+		},
+	}
+
+	t.Run("intact", func(t *testing.T) {
+		r := buildBundle(t, manifest, map[string][]byte{"brand/logo.png": logo, "reports/q1.pdf": report})
+
+		got, err := VerifyBundle(r, r.Size())
+
+		require.NoError(t, err)
+		assert.Equal(t, "bundle-123", got.BundleID)
+		assert.Len(t, got.Files, 2)
+	})
+
+	t.Run("mismatches", func(t *testing.T) {
+		r := buildBundle(t, manifest, map[string][]byte{
+			"brand/logo.png": []byte("LOGO"),
+			"extra.txt":      []byte("x"),
+		})
+
+		got, err := VerifyBundle(r, r.Size())
+
+		require.Error(t, err)
+		assert.NotNil(t, got)
+		assert.Contains(t, err.Error(), "brand/logo.png: SHA-256 is")
+		assert.Contains(t, err.Error(), "reports/q1.pdf: missing")
+		assert.Contains(t, err.Error(), "extra.txt: not in manifest")
+	})
+
+	t.Run("no manifest", func(t *testing.T) {
+		r := buildBundle(t, nil, map[string][]byte{"brand/logo.png": logo})
+
+		_, err := VerifyBundle(r, r.Size())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no manifest.json")
+	})
+}
@@ -15,8 +15,9 @@ var AllowedWidths = []int{256, 384, 640, 750, 828, 1080, 1200, 1920, 2048, 3840}
 
 // Client handles CDN operations.
 type Client struct {
-	http   *client.HTTPClient
-	cdnURL string
+	http         *client.HTTPClient
+	cdnURL       string
+	customDomain string
 }
 
 // NewClient creates a new CDN client.
@@ -24,8 +25,21 @@ func NewClient(http *client.HTTPClient, cdnURL string) *Client {
 	return &Client{http: http, cdnURL: cdnURL}
 }
 
+// SetCustomDomain makes GetTransformURL build URLs on an active custom
+// domain such as "assets.example.com". S3 keys are resolved against the
+// domain instead of the CDN URL, and full URLs on the CDN URL's host are
+// rewritten to it. Pass "" to go back to the CDN URL. It is not safe to call
+// concurrently with GetTransformURL.
+func (c *Client) SetCustomDomain(domain string) {
+	c.customDomain = domain
+}
+
 // GetUploadURL generates a presigned URL for uploading a file.
 func (c *Client) GetUploadURL(ctx context.Context, req *UploadURLRequest) (*UploadURLResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp UploadURLResponse
 	if err := c.http.Post(ctx, "/cdn/upload", req, &resp); err != nil {
 		return nil, err
@@ -42,10 +56,24 @@ func (c *Client) ConfirmUpload(ctx context.Context, assetID string) (*Asset, err
 	return &resp, nil
 }
 
-// Get retrieves an asset by ID.
-func (c *Client) Get(ctx context.Context, id string) (*Asset, error) {
+// Get retrieves an asset by ID, including any expanded relations.
+func (c *Client) Get(ctx context.Context, id string, expand ...AssetExpand) (*Asset, error) {
 	var resp Asset
-	if err := c.http.Get(ctx, "/cdn/assets/"+id, &resp); err != nil {
+	if err := c.http.Get(ctx, client.WithExpand("/cdn/assets/"+id, expand), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetMany retrieves multiple assets by ID in one request. Assets are returned
+// in the order of ids.
+func (c *Client) GetMany(ctx context.Context, ids []string, expand ...AssetExpand) (*GetManyAssetsResponse, error) {
+	body := map[string]interface{}{"ids": ids}
+	if len(expand) > 0 {
+		body["expand"] = expand
+	}
+	var resp GetManyAssetsResponse
+	if err := c.http.Post(ctx, "/cdn/assets/get-many", body, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -53,6 +81,10 @@ func (c *Client) Get(ctx context.Context, id string) (*Asset, error) {
 
 // Update updates asset metadata.
 func (c *Client) Update(ctx context.Context, req *UpdateAssetRequest) (*Asset, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Asset
 	if err := c.http.Patch(ctx, "/cdn/assets/"+req.ID, req, &resp); err != nil {
 		return nil, err
@@ -80,6 +112,10 @@ func (c *Client) DeleteMany(ctx context.Context, ids []string) (*DeleteAssetsRes
 
 // List lists assets with filters and pagination.
 func (c *Client) List(ctx context.Context, req *ListAssetsRequest) (*ListAssetsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Set("projectSlug", req.ProjectSlug)
 	if req.Folder != nil {
@@ -91,12 +127,16 @@ func (c *Client) List(ctx context.Context, req *ListAssetsRequest) (*ListAssetsR
 	if req.Status != nil {
 		params.Set("status", string(*req.Status))
 	}
+	if req.ReviewState != nil {
+		params.Set("reviewState", string(*req.ReviewState))
+	}
 	if req.Search != nil {
 		params.Set("search", *req.Search)
 	}
 	if len(req.Tags) > 0 {
 		params.Set("tags", strings.Join(req.Tags, ","))
 	}
+	client.SetMetadataFilter(params, req.Metadata)
 	if req.SortBy != nil {
 		params.Set("sortBy", *req.SortBy)
 	}
@@ -120,6 +160,10 @@ func (c *Client) List(ctx context.Context, req *ListAssetsRequest) (*ListAssetsR
 
 // Move moves assets to a different folder.
 func (c *Client) Move(ctx context.Context, req *MoveAssetsRequest) (*MoveAssetsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp MoveAssetsResponse
 	if err := c.http.Post(ctx, "/cdn/assets/move", req, &resp); err != nil {
 		return nil, err
@@ -127,15 +171,41 @@ func (c *Client) Move(ctx context.Context, req *MoveAssetsRequest) (*MoveAssetsR
 	return &resp, nil
 }
 
-// GetTransformURL generates a transformed image URL client-side.
+// GenerateQRCode renders a QR code for req.Data and stores it as a CDN asset.
+func (c *Client) GenerateQRCode(ctx context.Context, req *GenerateQRCodeRequest) (*Asset, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Asset
+	if err := c.http.Post(ctx, "/cdn/qr", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetTransformURL generates a transformed image URL client-side. It returns
+// an error wrapping ErrInvalidTransform if options fail Validate.
 func (c *Client) GetTransformURL(assetURLOrS3Key string, options *TransformOptions) (string, error) {
+	if err := options.Validate(); err != nil {
+		return "", err
+	}
+
 	var baseURL string
 	if strings.HasPrefix(assetURLOrS3Key, "http://") || strings.HasPrefix(assetURLOrS3Key, "https://") {
 		parsed, err := url.Parse(assetURLOrS3Key)
 		if err != nil {
 			return "", err
 		}
+		if c.customDomain != "" && c.cdnURL != "" {
+			if cdn, err := url.Parse(c.cdnURL); err == nil && cdn.Host == parsed.Host {
+				parsed.Scheme = "https"
+				parsed.Host = c.customDomain
+			}
+		}
 		baseURL = fmt.Sprintf("%s://%s%s", parsed.Scheme, parsed.Host, parsed.Path)
+	} else if c.customDomain != "" {
+		baseURL = "https://" + c.customDomain + "/" + assetURLOrS3Key
 	} else if c.cdnURL != "" {
 		cdnBase := strings.TrimSuffix(c.cdnURL, "/")
 		baseURL = cdnBase + "/" + assetURLOrS3Key
@@ -232,6 +302,10 @@ func abs(x int) int {
 
 // GetFolderTree gets the folder tree for navigation.
 func (c *Client) GetFolderTree(ctx context.Context, req *GetFolderTreeRequest) ([]FolderTreeNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Set("projectSlug", req.ProjectSlug)
 	if req.MaxDepth != nil {
@@ -249,6 +323,10 @@ func (c *Client) GetFolderTree(ctx context.Context, req *GetFolderTreeRequest) (
 
 // CreateFolder creates a new folder.
 func (c *Client) CreateFolder(ctx context.Context, req *CreateFolderRequest) (*Folder, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Folder
 	if err := c.http.Post(ctx, "/cdn/folders", req, &resp); err != nil {
 		return nil, err
@@ -276,6 +354,10 @@ func (c *Client) GetFolderByPath(ctx context.Context, path string) (*Folder, err
 
 // UpdateFolder updates a folder's name.
 func (c *Client) UpdateFolder(ctx context.Context, req *UpdateFolderRequest) (*Folder, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Folder
 	if err := c.http.Patch(ctx, "/cdn/folders/"+req.ID, req, &resp); err != nil {
 		return nil, err
@@ -285,6 +367,10 @@ func (c *Client) UpdateFolder(ctx context.Context, req *UpdateFolderRequest) (*F
 
 // ListFolders lists folders with optional filters.
 func (c *Client) ListFolders(ctx context.Context, req *ListFoldersRequest) (*ListFoldersResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	if req != nil {
 		if req.ParentID != nil {
@@ -315,6 +401,10 @@ func (c *Client) ListFolders(ctx context.Context, req *ListFoldersRequest) (*Lis
 
 // MoveFolder moves a folder to a new parent.
 func (c *Client) MoveFolder(ctx context.Context, req *MoveFolderRequest) (*MoveFolderResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp MoveFolderResponse
 	if err := c.http.Post(ctx, "/cdn/folders/move", req, &resp); err != nil {
 		return nil, err
@@ -114,6 +114,54 @@ func TestClient_Get(t *testing.T) {
 	assert.Equal(t, 1920, *resp.Width)
 }
 
+func TestClient_Get_Expand(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cdn/assets/asset-123", r.URL.Path)
+		assert.Equal(t, "transcodeJobs,thumbnails", r.URL.Query().Get("expand"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Asset{
+			ID:            "asset-123",
+			Type:          AssetTypeVideo,
+			TranscodeJobs: []TranscodeJob{{ID: "job-1", Status: TranscodeJobStatusCompleted}},
+			Thumbnails:    []VideoThumbnail{{ID: "thumb-1"}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.Get(context.Background(), "asset-123", AssetExpandTranscodeJobs, AssetExpandThumbnails)
+
+	require.NoError(t, err)
+	require.Len(t, resp.TranscodeJobs, 1)
+	assert.Equal(t, "job-1", resp.TranscodeJobs[0].ID)
+	assert.Len(t, resp.Thumbnails, 1)
+}
+
+func TestClient_GetMany(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/assets/get-many", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, []interface{}{"asset-1", "asset-2"}, body["ids"])
+		assert.NotContains(t, body, "expand")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(GetManyAssetsResponse{
+			Assets:   []Asset{{ID: "asset-1"}},
+			NotFound: []string{"asset-2"},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.GetMany(context.Background(), []string{"asset-1", "asset-2"})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Assets, 1)
+	assert.Equal(t, []string{"asset-2"}, resp.NotFound)
+}
+
 func TestClient_Update(t *testing.T) {
 	assetID := "asset-123"
 	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -486,7 +534,8 @@ func TestClient_GetNearestWidth(t *testing.T) {
 		{1500, 1200},
 		{1800, 1920},
 		{2000, 2048},
-		{3000, 2048},
+		{2900, 2048},
+		{3000, 3840},
 		{4000, 3840},
 	}
 
@@ -495,3 +544,86 @@ func TestClient_GetNearestWidth(t *testing.T) {
 		assert.Equal(t, tc.expected, result, "getNearestWidth(%d) should be %d", tc.input, tc.expected)
 	}
 }
+
+func TestClient_GenerateQRCode(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/qr", r.URL.Path)
+
+		var req GenerateQRCodeRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/tickets/T-1001", req.Data)
+		assert.Equal(t, 512, *req.Size)
+		assert.Equal(t, QRErrorCorrectionHigh, *req.ErrorCorrection)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Asset{
+			ID:       "asset-qr",
+			MimeType: "image/png",
+			CDNURL:   "https://cdn.example.com/qr/asset-qr.png",
+		})
+	})
+	defer server.Close()
+
+	level := QRErrorCorrectionHigh
+	asset, err := cdnClient.GenerateQRCode(context.Background(), &GenerateQRCodeRequest{
+		ProjectSlug:     "my-project",
+		Data:            "https://example.com/tickets/T-1001",
+		Size:            ptr(512),
+		ErrorCorrection: &level,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "asset-qr", asset.ID)
+	assert.Equal(t, "image/png", asset.MimeType)
+}
+
+func TestClient_List_WithMetadata(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cdn/assets", r.URL.Path)
+		assert.Equal(t, "ord_123", r.URL.Query().Get("metadata[orderId]"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListAssetsResponse{})
+	})
+	defer server.Close()
+
+	_, err := cdnClient.List(context.Background(), &ListAssetsRequest{
+		ProjectSlug: "my-project",
+		Metadata:    map[string]string{"orderId": "ord_123"},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestClient_MetadataIndex(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cdn/metadata-index", r.URL.Path)
+		if r.Method == http.MethodGet {
+			assert.Equal(t, "my-project", r.URL.Query().Get("projectSlug"))
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []interface{}{"orderId", "customerId"}, body["keys"])
+		case http.MethodGet:
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(types.MetadataIndex{Keys: []string{"orderId", "customerId"}, MaxKeys: 10})
+	})
+	defer server.Close()
+
+	index, err := cdnClient.SetMetadataIndex(context.Background(), "my-project", []string{"orderId", "customerId"})
+	require.NoError(t, err)
+	assert.Equal(t, 10, index.MaxKeys)
+
+	index, err = cdnClient.GetMetadataIndex(context.Background(), "my-project")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orderId", "customerId"}, index.Keys)
+}
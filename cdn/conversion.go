@@ -0,0 +1,67 @@
+package cdn
+
+import (
+	"context"
+	"strconv"
+)
+
+// CreateConversion starts a job that generates renditions of existing image
+// assets in the requested formats, e.g. WebP and AVIF copies of a folder.
+func (c *Client) CreateConversion(ctx context.Context, req *CreateConversionRequest) (*ConversionJob, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp ConversionJob
+	if err := c.http.Post(ctx, "/cdn/conversions", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetConversion retrieves a conversion job by ID.
+func (c *Client) GetConversion(ctx context.Context, conversionID string) (*ConversionJob, error) {
+	var resp ConversionJob
+	if err := c.http.Get(ctx, "/cdn/conversions/"+conversionID, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListConversions lists conversion jobs with pagination and filters.
+func (c *Client) ListConversions(ctx context.Context, req *ListConversionsRequest) (*ListConversionsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("projectSlug", req.ProjectSlug)
+	if req.Environment != nil {
+		params.Set("environment", string(*req.Environment))
+	}
+	if req.Status != nil {
+		params.Set("status", string(*req.Status))
+	}
+	if req.Limit != nil {
+		params.Set("limit", strconv.Itoa(*req.Limit))
+	}
+	if req.Offset != nil {
+		params.Set("offset", strconv.Itoa(*req.Offset))
+	}
+
+	var resp ListConversionsResponse
+	if err := c.http.Get(ctx, "/cdn/conversions?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CancelConversion cancels a running conversion job. Renditions that were
+// already generated are kept.
+func (c *Client) CancelConversion(ctx context.Context, conversionID string) (*CancelConversionResponse, error) {
+	var resp CancelConversionResponse
+	if err := c.http.Post(ctx, "/cdn/conversions/"+conversionID+"/cancel", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,119 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateConversion(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/conversions", r.URL.Path)
+
+		var req CreateConversionRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", req.ProjectSlug)
+		assert.Equal(t, "/legacy", *req.Folder)
+		assert.Equal(t, []ConversionFormat{ConversionFormatWebP, ConversionFormatAVIF}, req.Formats)
+		assert.True(t, *req.SkipExisting)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ConversionJob{
+			ID:      "conv-123",
+			Status:  ConversionJobStatusPending,
+			Formats: req.Formats,
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.CreateConversion(context.Background(), &CreateConversionRequest{
+		ProjectSlug:  "my-project",
+		Folder:       ptr("/legacy"),
+		Recursive:    ptr(true),
+		Formats:      []ConversionFormat{ConversionFormatWebP, ConversionFormatAVIF},
+		SkipExisting: ptr(true),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "conv-123", resp.ID)
+	assert.Equal(t, ConversionJobStatusPending, resp.Status)
+}
+
+func TestClient_GetConversion(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/cdn/conversions/conv-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ConversionJob{
+			ID:              "conv-123",
+			Status:          ConversionJobStatusProcessing,
+			TotalAssets:     200,
+			ProcessedAssets: 120,
+			FailedAssets:    1,
+			Errors: []ConversionError{
+				{AssetID: "asset-9", Format: ConversionFormatAVIF, Error: "unsupported color profile"},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.GetConversion(context.Background(), "conv-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 120, resp.ProcessedAssets)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "asset-9", resp.Errors[0].AssetID)
+}
+
+func TestClient_ListConversions(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cdn/conversions", r.URL.Path)
+		assert.Equal(t, "my-project", r.URL.Query().Get("projectSlug"))
+		assert.Equal(t, "completed", r.URL.Query().Get("status"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListConversionsResponse{
+			Conversions: []ConversionJob{{ID: "conv-1", Status: ConversionJobStatusCompleted}},
+			Total:       1,
+		})
+	})
+	defer server.Close()
+
+	status := ConversionJobStatusCompleted
+	resp, err := cdnClient.ListConversions(context.Background(), &ListConversionsRequest{
+		ProjectSlug: "my-project",
+		Status:      &status,
+		Limit:       ptr(10),
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Conversions, 1)
+}
+
+func TestClient_CancelConversion(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/conversions/conv-123/cancel", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CancelConversionResponse{
+			Success: true,
+			Status:  ConversionJobStatusCancelled,
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.CancelConversion(context.Background(), "conv-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, ConversionJobStatusCancelled, resp.Status)
+}
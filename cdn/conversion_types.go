@@ -0,0 +1,101 @@
+package cdn
+
+import "time"
+
+// ConversionFormat is a target image format for a conversion job.
+type ConversionFormat string
+
+const (
+	ConversionFormatWebP ConversionFormat = "webp"
+	ConversionFormatAVIF ConversionFormat = "avif"
+	ConversionFormatJPEG ConversionFormat = "jpeg"
+	ConversionFormatPNG  ConversionFormat = "png"
+)
+
+// ConversionJobStatus represents the status of a conversion job.
+type ConversionJobStatus string
+
+const (
+	ConversionJobStatusPending    ConversionJobStatus = "pending"
+	ConversionJobStatusProcessing ConversionJobStatus = "processing"
+	ConversionJobStatusCompleted  ConversionJobStatus = "completed"
+	ConversionJobStatusFailed     ConversionJobStatus = "failed"
+	ConversionJobStatusCancelled  ConversionJobStatus = "cancelled"
+)
+
+// AssetRendition is a stored copy of an image asset in another format.
+type AssetRendition struct {
+	Format    ConversionFormat `json:"format"`
+	S3Key     string           `json:"s3Key"`
+	CDNURL    string           `json:"cdnUrl"`
+	Size      int64            `json:"size"`
+	Width     *int             `json:"width,omitempty"`
+	Height    *int             `json:"height,omitempty"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+// ConversionError represents an asset that could not be converted.
+type ConversionError struct {
+	AssetID   string           `json:"assetId"`
+	Format    ConversionFormat `json:"format"`
+	Error     string           `json:"error"`
+	Timestamp string           `json:"timestamp"`
+}
+
+// CreateConversionRequest is the request for creating a conversion job.
+// Select assets either by Folder or by AssetIDs; when both are empty every
+// image in the project is converted.
+type CreateConversionRequest struct {
+	ProjectSlug  string             `json:"projectSlug" validate:"required"`
+	Environment  *CdnEnvironment    `json:"environment,omitempty"`
+	Folder       *string            `json:"folder,omitempty"`
+	Recursive    *bool              `json:"recursive,omitempty"`
+	AssetIDs     []string           `json:"assetIds,omitempty"`
+	MimeTypes    []string           `json:"mimeTypes,omitempty"`
+	Formats      []ConversionFormat `json:"formats" validate:"required"`
+	Quality      *int               `json:"quality,omitempty"`
+	SkipExisting *bool              `json:"skipExisting,omitempty"`
+	NotifyEmail  *string            `json:"notifyEmail,omitempty"`
+}
+
+// ConversionJob represents a batch image format conversion job.
+type ConversionJob struct {
+	ID              string              `json:"id"`
+	OrganizationID  string              `json:"organizationId"`
+	ProjectID       string              `json:"projectId"`
+	Environment     CdnEnvironment      `json:"environment"`
+	Folder          *string             `json:"folder,omitempty"`
+	Formats         []ConversionFormat  `json:"formats"`
+	Status          ConversionJobStatus `json:"status"`
+	TotalAssets     int                 `json:"totalAssets"`
+	ProcessedAssets int                 `json:"processedAssets"`
+	SkippedAssets   int                 `json:"skippedAssets"`
+	FailedAssets    int                 `json:"failedAssets"`
+	BytesSaved      int64               `json:"bytesSaved"`
+	Errors          []ConversionError   `json:"errors,omitempty"`
+	StartedAt       *time.Time          `json:"startedAt,omitempty"`
+	CompletedAt     *time.Time          `json:"completedAt,omitempty"`
+	CreatedAt       time.Time           `json:"createdAt"`
+}
+
+// ListConversionsRequest is the request for listing conversion jobs.
+type ListConversionsRequest struct {
+	ProjectSlug string               `json:"projectSlug" validate:"required"`
+	Environment *CdnEnvironment      `json:"environment,omitempty"`
+	Status      *ConversionJobStatus `json:"status,omitempty"`
+	Limit       *int                 `json:"limit,omitempty"`
+	Offset      *int                 `json:"offset,omitempty"`
+}
+
+// ListConversionsResponse is the response from listing conversion jobs.
+type ListConversionsResponse struct {
+	Conversions []ConversionJob `json:"conversions"`
+	Total       int             `json:"total"`
+	HasMore     bool            `json:"hasMore"`
+}
+
+// CancelConversionResponse is the response from cancelling a conversion job.
+type CancelConversionResponse struct {
+	Success bool                `json:"success"`
+	Status  ConversionJobStatus `json:"status"`
+}
@@ -0,0 +1,60 @@
+package cdn
+
+import "time"
+
+// CustomDomainStatus represents the status of a custom CDN domain.
+type CustomDomainStatus string
+
+const (
+	CustomDomainStatusPendingValidation CustomDomainStatus = "pending_validation"
+	CustomDomainStatusProvisioning      CustomDomainStatus = "provisioning"
+	CustomDomainStatusActive            CustomDomainStatus = "active"
+	CustomDomainStatusFailed            CustomDomainStatus = "failed"
+)
+
+// CertificateStatus represents the status of a custom domain's TLS certificate.
+type CertificateStatus string
+
+const (
+	CertificateStatusPending CertificateStatus = "pending"
+	CertificateStatusIssued  CertificateStatus = "issued"
+	CertificateStatusFailed  CertificateStatus = "failed"
+	CertificateStatusExpired CertificateStatus = "expired"
+)
+
+// DomainDNSRecord is a DNS record that must be created for a custom domain.
+// Purpose is "routing" for the CNAME that points the domain at the CDN, or
+// "validation" for certificate validation records.
+type DomainDNSRecord struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Purpose  string `json:"purpose"`
+	Verified bool   `json:"verified"`
+}
+
+// CustomDomain is a domain that serves CDN assets. Once the domain is active,
+// Asset.CDNURL values returned for the project use it.
+type CustomDomain struct {
+	ID                   string             `json:"id"`
+	ProjectSlug          string             `json:"projectSlug"`
+	Domain               string             `json:"domain"`
+	Status               CustomDomainStatus `json:"status"`
+	CertificateStatus    CertificateStatus  `json:"certificateStatus"`
+	CertificateExpiresAt *time.Time         `json:"certificateExpiresAt,omitempty"`
+	DNSRecords           []DomainDNSRecord  `json:"dnsRecords"`
+	Error                *string            `json:"error,omitempty"`
+	CreatedAt            time.Time          `json:"createdAt"`
+	ActivatedAt          *time.Time         `json:"activatedAt,omitempty"`
+}
+
+// AddCustomDomainRequest is the request for adding a custom domain.
+type AddCustomDomainRequest struct {
+	ProjectSlug string `json:"projectSlug" validate:"required"`
+	Domain      string `json:"domain" validate:"required"`
+}
+
+// ListCustomDomainsResponse is the response from listing custom domains.
+type ListCustomDomainsResponse struct {
+	Domains []CustomDomain `json:"domains"`
+}
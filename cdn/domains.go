@@ -0,0 +1,61 @@
+package cdn
+
+import (
+	"context"
+	"net/url"
+)
+
+// AddCustomDomain attaches a custom domain to CDN delivery. Create the
+// returned DNSRecords, then call VerifyCustomDomain to start validation and
+// certificate provisioning.
+func (c *Client) AddCustomDomain(ctx context.Context, req *AddCustomDomainRequest) (*CustomDomain, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp CustomDomain
+	if err := c.http.Post(ctx, "/cdn/domains", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCustomDomain retrieves a custom domain by ID.
+func (c *Client) GetCustomDomain(ctx context.Context, id string) (*CustomDomain, error) {
+	var resp CustomDomain
+	if err := c.http.Get(ctx, "/cdn/domains/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListCustomDomains lists the custom domains of a project.
+func (c *Client) ListCustomDomains(ctx context.Context, projectSlug string) (*ListCustomDomainsResponse, error) {
+	params := url.Values{}
+	params.Set("projectSlug", projectSlug)
+
+	var resp ListCustomDomainsResponse
+	if err := c.http.Get(ctx, "/cdn/domains?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// VerifyCustomDomain checks the domain's DNS records and, once they are in
+// place, provisions its TLS certificate.
+func (c *Client) VerifyCustomDomain(ctx context.Context, id string) (*CustomDomain, error) {
+	var resp CustomDomain
+	if err := c.http.Post(ctx, "/cdn/domains/"+id+"/verify", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RemoveCustomDomain detaches a custom domain from CDN delivery.
+func (c *Client) RemoveCustomDomain(ctx context.Context, id string) (*SuccessResponse, error) {
+	var resp SuccessResponse
+	if err := c.http.Delete(ctx, "/cdn/domains/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
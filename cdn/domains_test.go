@@ -0,0 +1,123 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_AddCustomDomain(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/domains", r.URL.Path)
+
+		var req AddCustomDomainRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "assets.example.com", req.Domain)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CustomDomain{
+			ID:                "dom-123",
+			Domain:            "assets.example.com",
+			Status:            CustomDomainStatusPendingValidation,
+			CertificateStatus: CertificateStatusPending,
+			DNSRecords: []DomainDNSRecord{
+				{Type: "CNAME", Name: "assets.example.com", Value: "edge.stack0.dev", Purpose: "routing"},
+				{Type: "CNAME", Name: "_acme-challenge.assets.example.com", Value: "dom-123.validation.stack0.dev", Purpose: "validation"},
+			},
+		})
+	})
+	defer server.Close()
+
+	domain, err := cdnClient.AddCustomDomain(context.Background(), &AddCustomDomainRequest{
+		ProjectSlug: "my-project",
+		Domain:      "assets.example.com",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, CustomDomainStatusPendingValidation, domain.Status)
+	assert.Len(t, domain.DNSRecords, 2)
+}
+
+func TestClient_ListCustomDomains(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/cdn/domains", r.URL.Path)
+		assert.Equal(t, "my-project", r.URL.Query().Get("projectSlug"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListCustomDomainsResponse{
+			Domains: []CustomDomain{{ID: "dom-123", Status: CustomDomainStatusActive}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.ListCustomDomains(context.Background(), "my-project")
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Domains, 1)
+}
+
+func TestClient_VerifyCustomDomain(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/domains/dom-123/verify", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CustomDomain{
+			ID:                "dom-123",
+			Status:            CustomDomainStatusProvisioning,
+			CertificateStatus: CertificateStatusPending,
+		})
+	})
+	defer server.Close()
+
+	domain, err := cdnClient.VerifyCustomDomain(context.Background(), "dom-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, CustomDomainStatusProvisioning, domain.Status)
+}
+
+func TestClient_RemoveCustomDomain(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/cdn/domains/dom-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SuccessResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.RemoveCustomDomain(context.Background(), "dom-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestClient_GetTransformURL_CustomDomain(t *testing.T) {
+	cdnClient, _ := setupCDNTestClientWithCDNURL(t, nil, "https://cdn.example.com")
+	cdnClient.SetCustomDomain("assets.example.com")
+
+	t.Run("S3 key", func(t *testing.T) {
+		url, err := cdnClient.GetTransformURL("uploads/image.jpg", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "https://assets.example.com/uploads/image.jpg", url)
+	})
+
+	t.Run("CDN URL is rewritten", func(t *testing.T) {
+		url, err := cdnClient.GetTransformURL("https://cdn.example.com/uploads/image.jpg", &TransformOptions{Width: ptr(640)})
+		require.NoError(t, err)
+		assert.Equal(t, "https://assets.example.com/uploads/image.jpg?w=640", url)
+	})
+
+	t.Run("other hosts are kept", func(t *testing.T) {
+		url, err := cdnClient.GetTransformURL("https://images.other.com/photo.jpg", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "https://images.other.com/photo.jpg", url)
+	})
+}
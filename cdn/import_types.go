@@ -50,9 +50,9 @@ type ImportError struct {
 
 // CreateImportRequest is the request for creating an import job.
 type CreateImportRequest struct {
-	ProjectSlug     string          `json:"projectSlug"`
+	ProjectSlug     string          `json:"projectSlug" validate:"required"`
 	Environment     *CdnEnvironment `json:"environment,omitempty"`
-	SourceBucket    string          `json:"sourceBucket"`
+	SourceBucket    string          `json:"sourceBucket" validate:"required"`
 	SourceRegion    string          `json:"sourceRegion"`
 	SourcePrefix    *string         `json:"sourcePrefix,omitempty"`
 	AuthType        ImportAuthType  `json:"authType"`
@@ -104,7 +104,7 @@ type ImportJob struct {
 
 // ListImportsRequest is the request for listing import jobs.
 type ListImportsRequest struct {
-	ProjectSlug string           `json:"projectSlug"`
+	ProjectSlug string           `json:"projectSlug" validate:"required"`
 	Environment *CdnEnvironment  `json:"environment,omitempty"`
 	Status      *ImportJobStatus `json:"status,omitempty"`
 	SortBy      *string          `json:"sortBy,omitempty"`
@@ -153,7 +153,7 @@ type RetryImportResponse struct {
 
 // ListImportFilesRequest is the request for listing import files.
 type ListImportFilesRequest struct {
-	ImportID  string            `json:"importId"`
+	ImportID  string            `json:"importId" validate:"required"`
 	Status    *ImportFileStatus `json:"status,omitempty"`
 	SortBy    *string           `json:"sortBy,omitempty"`
 	SortOrder *string           `json:"sortOrder,omitempty"`
@@ -8,6 +8,10 @@ import (
 
 // CreateImport creates an S3 import job to bulk import files.
 func (c *Client) CreateImport(ctx context.Context, req *CreateImportRequest) (*CreateImportResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp CreateImportResponse
 	if err := c.http.Post(ctx, "/cdn/imports", req, &resp); err != nil {
 		return nil, err
@@ -26,7 +30,11 @@ func (c *Client) GetImport(ctx context.Context, importID string) (*ImportJob, er
 
 // ListImports lists import jobs with pagination and filters.
 func (c *Client) ListImports(ctx context.Context, req *ListImportsRequest) (*ListImportsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	params.Set("projectSlug", req.ProjectSlug)
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
@@ -74,6 +82,10 @@ func (c *Client) RetryImport(ctx context.Context, importID string) (*RetryImport
 
 // ListImportFiles lists files in an import job.
 func (c *Client) ListImportFiles(ctx context.Context, req *ListImportFilesRequest) (*ListImportFilesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	if req.Status != nil {
 		params.Set("status", string(*req.Status))
@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/stack0/sdk-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -25,9 +24,9 @@ func TestClient_CreateImport(t *testing.T) {
 
 		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(CreateImportResponse{
-			ID:         "import-123",
-			Status:     ImportJobStatusPending,
-			TotalFiles: 100,
+			ImportID:     "import-123",
+			Status:       ImportJobStatusPending,
+			SourceBucket: req.SourceBucket,
 		})
 	})
 	defer server.Close()
@@ -35,13 +34,13 @@ func TestClient_CreateImport(t *testing.T) {
 	resp, err := cdnClient.CreateImport(context.Background(), &CreateImportRequest{
 		ProjectSlug:  "my-project",
 		SourceBucket: "my-bucket",
-		SourcePrefix: "uploads/",
+		SourcePrefix: ptr("uploads/"),
 	})
 
 	require.NoError(t, err)
-	assert.Equal(t, "import-123", resp.ID)
+	assert.Equal(t, "import-123", resp.ImportID)
 	assert.Equal(t, ImportJobStatusPending, resp.Status)
-	assert.Equal(t, 100, resp.TotalFiles)
+	assert.Equal(t, "my-bucket", resp.SourceBucket)
 }
 
 func TestClient_GetImport(t *testing.T) {
@@ -53,12 +52,10 @@ func TestClient_GetImport(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(ImportJob{
 			ID:             importID,
-			Status:         ImportJobStatusProcessing,
+			Status:         ImportJobStatusImporting,
 			TotalFiles:     100,
 			ProcessedFiles: 50,
-			SuccessfulFiles: 48,
 			FailedFiles:    2,
-			Progress:       50,
 			CreatedAt:      time.Now(),
 		})
 	})
@@ -68,9 +65,9 @@ func TestClient_GetImport(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Equal(t, importID, resp.ID)
-	assert.Equal(t, ImportJobStatusProcessing, resp.Status)
+	assert.Equal(t, ImportJobStatusImporting, resp.Status)
 	assert.Equal(t, 50, resp.ProcessedFiles)
-	assert.Equal(t, 50, resp.Progress)
+	assert.Equal(t, 2, resp.FailedFiles)
 }
 
 func TestClient_ListImports(t *testing.T) {
@@ -82,9 +79,9 @@ func TestClient_ListImports(t *testing.T) {
 
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(ListImportsResponse{
-				Imports: []ImportJob{
+				Imports: []ImportJobSummary{
 					{ID: "import-1", Status: ImportJobStatusCompleted},
-					{ID: "import-2", Status: ImportJobStatusProcessing},
+					{ID: "import-2", Status: ImportJobStatusImporting},
 				},
 				Total:   2,
 				HasMore: false,
@@ -107,12 +104,12 @@ func TestClient_ListImports(t *testing.T) {
 			assert.Contains(t, r.URL.RawQuery, "environment=production")
 
 			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(ListImportsResponse{Imports: []ImportJob{}})
+			json.NewEncoder(w).Encode(ListImportsResponse{Imports: []ImportJobSummary{}})
 		})
 		defer server.Close()
 
 		status := ImportJobStatusCompleted
-		env := types.EnvironmentProduction
+		env := CdnEnvironmentProduction
 		resp, err := cdnClient.ListImports(context.Background(), &ListImportsRequest{
 			ProjectSlug: "my-project",
 			Status:      &status,
@@ -152,7 +149,7 @@ func TestClient_RetryImport(t *testing.T) {
 		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(RetryImportResponse{
 			Success:      true,
-			RetryingFiles: 5,
+			RetriedCount: 5,
 		})
 	})
 	defer server.Close()
@@ -161,7 +158,7 @@ func TestClient_RetryImport(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.True(t, resp.Success)
-	assert.Equal(t, 5, resp.RetryingFiles)
+	assert.Equal(t, 5, resp.RetriedCount)
 }
 
 func TestClient_ListImportFiles(t *testing.T) {
@@ -198,7 +195,7 @@ func TestClient_ListImportFiles(t *testing.T) {
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(ListImportFilesResponse{
 				Files: []ImportFile{
-					{ID: "file-2", Status: ImportFileStatusFailed, Error: ptr("File too large")},
+					{ID: "file-2", Status: ImportFileStatusFailed, ErrorMessage: ptr("File too large")},
 				},
 			})
 		})
@@ -218,7 +215,8 @@ func TestClient_ListImportFiles(t *testing.T) {
 
 func TestImportJobStatus_Constants(t *testing.T) {
 	assert.Equal(t, ImportJobStatus("pending"), ImportJobStatusPending)
-	assert.Equal(t, ImportJobStatus("processing"), ImportJobStatusProcessing)
+	assert.Equal(t, ImportJobStatus("validating"), ImportJobStatusValidating)
+	assert.Equal(t, ImportJobStatus("importing"), ImportJobStatusImporting)
 	assert.Equal(t, ImportJobStatus("completed"), ImportJobStatusCompleted)
 	assert.Equal(t, ImportJobStatus("failed"), ImportJobStatusFailed)
 	assert.Equal(t, ImportJobStatus("cancelled"), ImportJobStatusCancelled)
@@ -226,7 +224,7 @@ func TestImportJobStatus_Constants(t *testing.T) {
 
 func TestImportFileStatus_Constants(t *testing.T) {
 	assert.Equal(t, ImportFileStatus("pending"), ImportFileStatusPending)
-	assert.Equal(t, ImportFileStatus("processing"), ImportFileStatusProcessing)
+	assert.Equal(t, ImportFileStatus("importing"), ImportFileStatusImporting)
 	assert.Equal(t, ImportFileStatus("completed"), ImportFileStatusCompleted)
 	assert.Equal(t, ImportFileStatus("failed"), ImportFileStatusFailed)
 	assert.Equal(t, ImportFileStatus("skipped"), ImportFileStatusSkipped)
@@ -0,0 +1,36 @@
+package cdn
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// GetMetadataIndex returns the asset metadata keys that List can filter on in
+// a project.
+func (c *Client) GetMetadataIndex(ctx context.Context, projectSlug string) (*types.MetadataIndex, error) {
+	params := url.Values{}
+	params.Set("projectSlug", projectSlug)
+
+	var resp types.MetadataIndex
+	if err := c.http.Get(ctx, "/cdn/metadata-index?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetMetadataIndex replaces the asset metadata keys that List can filter on
+// in a project, up to the index's MaxKeys.
+func (c *Client) SetMetadataIndex(ctx context.Context, projectSlug string, keys []string) (*types.MetadataIndex, error) {
+	body := map[string]interface{}{
+		"projectSlug": projectSlug,
+		"keys":        keys,
+	}
+
+	var resp types.MetadataIndex
+	if err := c.http.Put(ctx, "/cdn/metadata-index", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,80 @@
+package cdn
+
+import "time"
+
+// ErrCodeInvalidMetadata is the APIError code returned when an upload or
+// update carries metadata that doesn't match the applicable schema. Call
+// ValidateMetadata to get the individual violations.
+const ErrCodeInvalidMetadata = "INVALID_METADATA"
+
+// MetadataFieldType is the type of a metadata schema field.
+type MetadataFieldType string
+
+const (
+	MetadataFieldTypeString      MetadataFieldType = "string"
+	MetadataFieldTypeNumber      MetadataFieldType = "number"
+	MetadataFieldTypeInteger     MetadataFieldType = "integer"
+	MetadataFieldTypeBoolean     MetadataFieldType = "boolean"
+	MetadataFieldTypeDate        MetadataFieldType = "date"
+	MetadataFieldTypeStringArray MetadataFieldType = "string_array"
+)
+
+// MetadataField describes one allowed key of Asset.Metadata.
+type MetadataField struct {
+	Type        MetadataFieldType `json:"type"`
+	Required    bool              `json:"required,omitempty"`
+	Enum        []string          `json:"enum,omitempty"`
+	Pattern     *string           `json:"pattern,omitempty"`
+	Minimum     *float64          `json:"minimum,omitempty"`
+	Maximum     *float64          `json:"maximum,omitempty"`
+	Description *string           `json:"description,omitempty"`
+}
+
+// MetadataSchema constrains the metadata of assets in a project or, when
+// FolderID is set, in a folder and its subfolders. The most specific schema
+// applies. Keys not listed in Fields are rejected unless AllowAdditional is
+// true.
+type MetadataSchema struct {
+	ID              string                   `json:"id"`
+	ProjectID       string                   `json:"projectId"`
+	FolderID        *string                  `json:"folderId,omitempty"`
+	Fields          map[string]MetadataField `json:"fields"`
+	AllowAdditional bool                     `json:"allowAdditional"`
+	CreatedAt       time.Time                `json:"createdAt"`
+	UpdatedAt       *time.Time               `json:"updatedAt,omitempty"`
+}
+
+// SetMetadataSchemaRequest is the request for creating or replacing a
+// metadata schema.
+type SetMetadataSchemaRequest struct {
+	ProjectSlug     string                   `json:"projectSlug" validate:"required"`
+	FolderID        *string                  `json:"folderId,omitempty"`
+	Fields          map[string]MetadataField `json:"fields"`
+	AllowAdditional *bool                    `json:"allowAdditional,omitempty"`
+}
+
+// ListMetadataSchemasResponse is the response from listing metadata schemas.
+type ListMetadataSchemasResponse struct {
+	Schemas []MetadataSchema `json:"schemas"`
+}
+
+// ValidateMetadataRequest is the request for checking metadata against the
+// schema that applies to a folder without uploading anything.
+type ValidateMetadataRequest struct {
+	ProjectSlug string                 `json:"projectSlug" validate:"required"`
+	Folder      *string                `json:"folder,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata"`
+}
+
+// MetadataViolation describes a metadata key that failed validation.
+type MetadataViolation struct {
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+// ValidateMetadataResponse is the response from validating metadata.
+type ValidateMetadataResponse struct {
+	Valid      bool                `json:"valid"`
+	SchemaID   *string             `json:"schemaId,omitempty"`
+	Violations []MetadataViolation `json:"violations,omitempty"`
+}
@@ -0,0 +1,68 @@
+package cdn
+
+import (
+	"context"
+	"net/url"
+)
+
+// SetMetadataSchema creates or replaces the metadata schema of a project, or
+// of a folder when FolderID is set. Once set, uploads and updates whose
+// metadata doesn't match fail with an APIError with code
+// ErrCodeInvalidMetadata. Existing assets are not revalidated.
+func (c *Client) SetMetadataSchema(ctx context.Context, req *SetMetadataSchemaRequest) (*MetadataSchema, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp MetadataSchema
+	if err := c.http.Put(ctx, "/cdn/metadata-schemas", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetMetadataSchema retrieves a metadata schema by ID.
+func (c *Client) GetMetadataSchema(ctx context.Context, id string) (*MetadataSchema, error) {
+	var resp MetadataSchema
+	if err := c.http.Get(ctx, "/cdn/metadata-schemas/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListMetadataSchemas lists the project-level and folder-level metadata
+// schemas of a project.
+func (c *Client) ListMetadataSchemas(ctx context.Context, projectSlug string) (*ListMetadataSchemasResponse, error) {
+	params := url.Values{}
+	params.Set("projectSlug", projectSlug)
+
+	var resp ListMetadataSchemasResponse
+	if err := c.http.Get(ctx, "/cdn/metadata-schemas?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteMetadataSchema removes a metadata schema. Assets it covered fall back
+// to the parent folder's or project's schema, if any.
+func (c *Client) DeleteMetadataSchema(ctx context.Context, id string) (*SuccessResponse, error) {
+	var resp SuccessResponse
+	if err := c.http.Delete(ctx, "/cdn/metadata-schemas/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ValidateMetadata checks metadata against the schema that applies to a
+// folder and reports every violation.
+func (c *Client) ValidateMetadata(ctx context.Context, req *ValidateMetadataRequest) (*ValidateMetadataResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp ValidateMetadataResponse
+	if err := c.http.Post(ctx, "/cdn/metadata-schemas/validate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
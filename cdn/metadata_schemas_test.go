@@ -0,0 +1,137 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetMetadataSchema(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/cdn/metadata-schemas", r.URL.Path)
+
+		var req SetMetadataSchemaRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "folder-1", *req.FolderID)
+		assert.Equal(t, MetadataFieldTypeString, req.Fields["sku"].Type)
+		assert.True(t, req.Fields["sku"].Required)
+		assert.Equal(t, []string{"draft", "live"}, req.Fields["state"].Enum)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(MetadataSchema{
+			ID:       "schema-1",
+			FolderID: req.FolderID,
+			Fields:   req.Fields,
+		})
+	})
+	defer server.Close()
+
+	schema, err := cdnClient.SetMetadataSchema(context.Background(), &SetMetadataSchemaRequest{
+		ProjectSlug: "my-project",
+		FolderID:    ptr("folder-1"),
+		Fields: map[string]MetadataField{
+			"sku":   {Type: MetadataFieldTypeString, Required: true, Pattern: ptr("^[A-Z0-9-]+$")},
+			"state": {Type: MetadataFieldTypeString, Enum: []string{"draft", "live"}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "schema-1", schema.ID)
+	assert.Len(t, schema.Fields, 2)
+}
+
+func TestClient_ListMetadataSchemas(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/cdn/metadata-schemas", r.URL.Path)
+		assert.Equal(t, "my-project", r.URL.Query().Get("projectSlug"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListMetadataSchemasResponse{
+			Schemas: []MetadataSchema{{ID: "schema-1"}, {ID: "schema-2", FolderID: ptr("folder-1")}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.ListMetadataSchemas(context.Background(), "my-project")
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Schemas, 2)
+}
+
+func TestClient_DeleteMetadataSchema(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/cdn/metadata-schemas/schema-1", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SuccessResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.DeleteMetadataSchema(context.Background(), "schema-1")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestClient_ValidateMetadata(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/metadata-schemas/validate", r.URL.Path)
+
+		var req ValidateMetadataRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "/products", *req.Folder)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ValidateMetadataResponse{
+			Valid:    false,
+			SchemaID: ptr("schema-1"),
+			Violations: []MetadataViolation{
+				{Key: "sku", Message: "is required"},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.ValidateMetadata(context.Background(), &ValidateMetadataRequest{
+		ProjectSlug: "my-project",
+		Folder:      ptr("/products"),
+		Metadata:    map[string]interface{}{"state": "live"},
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Valid)
+	require.Len(t, resp.Violations, 1)
+	assert.Equal(t, "sku", resp.Violations[0].Key)
+}
+
+func TestClient_Update_InvalidMetadata(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		json.NewEncoder(w).Encode(types.ErrorResponse{
+			Message: "metadata does not match schema",
+			Code:    ErrCodeInvalidMetadata,
+		})
+	})
+	defer server.Close()
+
+	_, err := cdnClient.Update(context.Background(), &UpdateAssetRequest{
+		ID:       "asset-1",
+		Metadata: map[string]interface{}{"sku": 42},
+	})
+
+	var apiErr *types.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, ErrCodeInvalidMetadata, apiErr.Code)
+}
@@ -0,0 +1,45 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+)
+
+// PregenerateTransforms starts a job that renders each preset for each asset
+// and stores the results in the transform cache, so the first requests after
+// e.g. a campaign launch don't wait for cold transforms. Presets are checked
+// with Validate, and widths are snapped to AllowedWidths as GetTransformURL
+// does so that the warmed variants are the ones its URLs request.
+func (c *Client) PregenerateTransforms(ctx context.Context, req *PregenerateTransformsRequest) (*PregenerationJob, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	body := *req
+	body.Presets = make([]TransformPreset, len(req.Presets))
+	for i, preset := range req.Presets {
+		if err := preset.Options.Validate(); err != nil {
+			return nil, fmt.Errorf("preset %q: %w", preset.Name, err)
+		}
+		if preset.Options.Width != nil {
+			width := c.getNearestWidth(*preset.Options.Width)
+			preset.Options.Width = &width
+		}
+		body.Presets[i] = preset
+	}
+
+	var resp PregenerationJob
+	if err := c.http.Post(ctx, "/cdn/transforms/pregenerate", &body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPregeneration retrieves a transform pre-generation job by ID.
+func (c *Client) GetPregeneration(ctx context.Context, jobID string) (*PregenerationJob, error) {
+	var resp PregenerationJob
+	if err := c.http.Get(ctx, "/cdn/transforms/pregenerate/"+jobID, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
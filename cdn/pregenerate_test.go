@@ -0,0 +1,93 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_PregenerateTransforms(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/transforms/pregenerate", r.URL.Path)
+
+		var req PregenerateTransformsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "my-project", req.ProjectSlug)
+		assert.Equal(t, []string{"asset-1", "asset-2"}, req.AssetIDs)
+		require.Len(t, req.Presets, 2)
+		assert.Equal(t, "hero", req.Presets[0].Name)
+		assert.Equal(t, 1920, *req.Presets[0].Options.Width)
+		assert.Equal(t, "webp", *req.Presets[0].Options.Format)
+		assert.Equal(t, 384, *req.Presets[1].Options.Width)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PregenerationJob{
+			ID:     "pregen-123",
+			Status: PregenerationStatusPending,
+			Total:  4,
+		})
+	})
+	defer server.Close()
+
+	presets := []TransformPreset{
+		{Name: "hero", Options: TransformOptions{Width: ptr(1900), Format: ptr("webp")}},
+		{Name: "thumb", Options: TransformOptions{Width: ptr(400)}},
+	}
+	resp, err := cdnClient.PregenerateTransforms(context.Background(), &PregenerateTransformsRequest{
+		ProjectSlug: "my-project",
+		AssetIDs:    []string{"asset-1", "asset-2"},
+		Presets:     presets,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pregen-123", resp.ID)
+	assert.Equal(t, 4, resp.Total)
+	assert.Equal(t, 1900, *presets[0].Options.Width, "caller's presets are not modified")
+}
+
+func TestClient_PregenerateTransforms_InvalidPreset(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	})
+	defer server.Close()
+
+	_, err := cdnClient.PregenerateTransforms(context.Background(), &PregenerateTransformsRequest{
+		ProjectSlug: "my-project",
+		AssetIDs:    []string{"asset-1"},
+		Presets:     []TransformPreset{{Name: "bad", Options: TransformOptions{Quality: ptr(0)}}},
+	})
+
+	require.ErrorIs(t, err, ErrInvalidTransform)
+	assert.Contains(t, err.Error(), `preset "bad"`)
+}
+
+func TestClient_GetPregeneration(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/cdn/transforms/pregenerate/pregen-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PregenerationJob{
+			ID:        "pregen-123",
+			Status:    PregenerationStatusCompleted,
+			Total:     4,
+			Completed: 3,
+			Failed:    1,
+			Errors:    []PregenerationError{{AssetID: "asset-2", Preset: "hero", Error: "not an image"}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.GetPregeneration(context.Background(), "pregen-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, PregenerationStatusCompleted, resp.Status)
+	assert.Equal(t, 3, resp.Completed)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "hero", resp.Errors[0].Preset)
+}
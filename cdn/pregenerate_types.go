@@ -0,0 +1,53 @@
+package cdn
+
+import "time"
+
+// PregenerationStatus represents the status of a transform pre-generation
+// job.
+type PregenerationStatus string
+
+const (
+	PregenerationStatusPending    PregenerationStatus = "pending"
+	PregenerationStatusProcessing PregenerationStatus = "processing"
+	PregenerationStatusCompleted  PregenerationStatus = "completed"
+	PregenerationStatusFailed     PregenerationStatus = "failed"
+)
+
+// TransformPreset is a named set of transform options, such as the sizes a
+// page requests for each image.
+type TransformPreset struct {
+	Name    string           `json:"name"`
+	Options TransformOptions `json:"options"`
+}
+
+// PregenerateTransformsRequest is the request for warming the transform cache.
+// Every preset is rendered for every asset.
+type PregenerateTransformsRequest struct {
+	ProjectSlug string            `json:"projectSlug" validate:"required"`
+	Environment *CdnEnvironment   `json:"environment,omitempty"`
+	AssetIDs    []string          `json:"assetIds" validate:"required"`
+	Presets     []TransformPreset `json:"presets" validate:"required"`
+}
+
+// PregenerationError represents a transform that could not be rendered.
+type PregenerationError struct {
+	AssetID string `json:"assetId"`
+	Preset  string `json:"preset"`
+	Error   string `json:"error"`
+}
+
+// PregenerationJob is a job rendering transforms ahead of time. Total counts
+// asset and preset combinations.
+type PregenerationJob struct {
+	ID          string               `json:"id"`
+	ProjectID   string               `json:"projectId"`
+	Environment CdnEnvironment       `json:"environment"`
+	Status      PregenerationStatus  `json:"status"`
+	Total       int                  `json:"total"`
+	Completed   int                  `json:"completed"`
+	Failed      int                  `json:"failed"`
+	Errors      []PregenerationError `json:"errors,omitempty"`
+	StartedAt   *time.Time           `json:"startedAt,omitempty"`
+	CompletedAt *time.Time           `json:"completedAt,omitempty"`
+	CreatedAt   time.Time            `json:"createdAt"`
+}
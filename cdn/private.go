@@ -8,6 +8,10 @@ import (
 
 // GetPrivateUploadURL generates a presigned URL for uploading a private file.
 func (c *Client) GetPrivateUploadURL(ctx context.Context, req *PrivateUploadURLRequest) (*PrivateUploadURLResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp PrivateUploadURLResponse
 	if err := c.http.Post(ctx, "/cdn/private/upload", req, &resp); err != nil {
 		return nil, err
@@ -26,7 +30,14 @@ func (c *Client) ConfirmPrivateUpload(ctx context.Context, fileID string) (*Priv
 
 // GetPrivateDownloadURL generates a presigned download URL for a private file.
 func (c *Client) GetPrivateDownloadURL(ctx context.Context, req *PrivateDownloadURLRequest) (*PrivateDownloadURLResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	body := map[string]interface{}{}
+	if req.VersionID != nil {
+		body["versionId"] = *req.VersionID
+	}
 	if req.ExpiresIn != nil {
 		body["expiresIn"] = *req.ExpiresIn
 	}
@@ -48,6 +59,10 @@ func (c *Client) GetPrivateFile(ctx context.Context, fileID string) (*PrivateFil
 
 // UpdatePrivateFile updates a private file's metadata.
 func (c *Client) UpdatePrivateFile(ctx context.Context, req *UpdatePrivateFileRequest) (*PrivateFile, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp PrivateFile
 	if err := c.http.Patch(ctx, "/cdn/private/"+req.FileID, req, &resp); err != nil {
 		return nil, err
@@ -75,6 +90,10 @@ func (c *Client) DeletePrivateFiles(ctx context.Context, fileIDs []string) (*Del
 
 // ListPrivateFiles lists private files with filters and pagination.
 func (c *Client) ListPrivateFiles(ctx context.Context, req *ListPrivateFilesRequest) (*ListPrivateFilesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Set("projectSlug", req.ProjectSlug)
 	if req.Folder != nil {
@@ -108,6 +127,10 @@ func (c *Client) ListPrivateFiles(ctx context.Context, req *ListPrivateFilesRequ
 
 // MovePrivateFiles moves private files to a different folder.
 func (c *Client) MovePrivateFiles(ctx context.Context, req *MovePrivateFilesRequest) (*MovePrivateFilesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp MovePrivateFilesResponse
 	if err := c.http.Post(ctx, "/cdn/private/move", req, &resp); err != nil {
 		return nil, err
@@ -117,6 +140,10 @@ func (c *Client) MovePrivateFiles(ctx context.Context, req *MovePrivateFilesRequ
 
 // CreateBundle creates a download bundle from assets and/or private files.
 func (c *Client) CreateBundle(ctx context.Context, req *CreateBundleRequest) (*CreateBundleResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp CreateBundleResponse
 	if err := c.http.Post(ctx, "/cdn/bundles", req, &resp); err != nil {
 		return nil, err
@@ -135,6 +162,10 @@ func (c *Client) GetBundle(ctx context.Context, bundleID string) (*DownloadBundl
 
 // ListBundles lists download bundles with filters and pagination.
 func (c *Client) ListBundles(ctx context.Context, req *ListBundlesRequest) (*ListBundlesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Set("projectSlug", req.ProjectSlug)
 	if req.Status != nil {
@@ -159,6 +190,10 @@ func (c *Client) ListBundles(ctx context.Context, req *ListBundlesRequest) (*Lis
 
 // GetBundleDownloadURL generates a presigned download URL for a bundle.
 func (c *Client) GetBundleDownloadURL(ctx context.Context, req *BundleDownloadURLRequest) (*BundleDownloadURLResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	body := map[string]interface{}{}
 	if req.ExpiresIn != nil {
 		body["expiresIn"] = *req.ExpiresIn
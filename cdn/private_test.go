@@ -4,11 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"net/http/httptest"
 	"testing"
 	"time"
 
-	"github.com/stack0/sdk-go/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -147,24 +145,25 @@ func TestClient_UpdatePrivateFile(t *testing.T) {
 		var req UpdatePrivateFileRequest
 		err := json.NewDecoder(r.Body).Decode(&req)
 		require.NoError(t, err)
-		assert.Equal(t, "new-name.pdf", *req.Filename)
+		assert.Equal(t, "Quarterly report", *req.Description)
 
 		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(PrivateFile{
-			ID:       fileID,
-			Filename: "new-name.pdf",
+			ID:          fileID,
+			Filename:    "report.pdf",
+			Description: req.Description,
 		})
 	})
 	defer server.Close()
 
-	filename := "new-name.pdf"
+	description := "Quarterly report"
 	resp, err := cdnClient.UpdatePrivateFile(context.Background(), &UpdatePrivateFileRequest{
-		FileID:   fileID,
-		Filename: &filename,
+		FileID:      fileID,
+		Description: &description,
 	})
 
 	require.NoError(t, err)
-	assert.Equal(t, "new-name.pdf", resp.Filename)
+	assert.Equal(t, "Quarterly report", *resp.Description)
 }
 
 func TestClient_DeletePrivateFile(t *testing.T) {
@@ -278,8 +277,10 @@ func TestClient_CreateBundle(t *testing.T) {
 
 		w.WriteHeader(http.StatusOK)
 		json.NewEncoder(w).Encode(CreateBundleResponse{
-			ID:     "bundle-123",
-			Status: BundleStatusPending,
+			Bundle: DownloadBundle{
+				ID:     "bundle-123",
+				Status: BundleStatusPending,
+			},
 		})
 	})
 	defer server.Close()
@@ -291,8 +292,41 @@ func TestClient_CreateBundle(t *testing.T) {
 	})
 
 	require.NoError(t, err)
-	assert.Equal(t, "bundle-123", resp.ID)
-	assert.Equal(t, BundleStatusPending, resp.Status)
+	assert.Equal(t, "bundle-123", resp.Bundle.ID)
+	assert.Equal(t, BundleStatusPending, resp.Bundle.Status)
+}
+
+func TestClient_CreateBundle_Folders(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req CreateBundleRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, []string{"folder-1"}, req.FolderIDs)
+		assert.True(t, *req.Recursive)
+		assert.True(t, *req.IncludeManifest)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateBundleResponse{
+			Bundle: DownloadBundle{
+				ID:          "bundle-123",
+				FolderIDs:   req.FolderIDs,
+				HasManifest: true,
+				Status:      BundleStatusPending,
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.CreateBundle(context.Background(), &CreateBundleRequest{
+		ProjectSlug:     "my-project",
+		Name:            "Backup",
+		FolderIDs:       []string{"folder-1"},
+		Recursive:       ptr(true),
+		IncludeManifest: ptr(true),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Bundle.HasManifest)
+	assert.Equal(t, []string{"folder-1"}, resp.Bundle.FolderIDs)
 }
 
 func TestClient_GetBundle(t *testing.T) {
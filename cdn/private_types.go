@@ -25,15 +25,16 @@ type PrivateFile struct {
 	Tags             []string               `json:"tags,omitempty"`
 	Metadata         map[string]interface{} `json:"metadata,omitempty"`
 	Status           PrivateFileStatus      `json:"status"`
+	Version          int                    `json:"version"`
 	CreatedAt        time.Time              `json:"createdAt"`
 	UpdatedAt        *time.Time             `json:"updatedAt,omitempty"`
 }
 
 // PrivateUploadURLRequest is the request for getting a private upload URL.
 type PrivateUploadURLRequest struct {
-	ProjectSlug string                 `json:"projectSlug"`
-	Filename    string                 `json:"filename"`
-	MimeType    string                 `json:"mimeType"`
+	ProjectSlug string                 `json:"projectSlug" validate:"required"`
+	Filename    string                 `json:"filename" validate:"required"`
+	MimeType    string                 `json:"mimeType" validate:"required"`
 	Size        int64                  `json:"size"`
 	Folder      *string                `json:"folder,omitempty"`
 	Description *string                `json:"description,omitempty"`
@@ -48,9 +49,11 @@ type PrivateUploadURLResponse struct {
 }
 
 // PrivateDownloadURLRequest is the request for getting a private download URL.
+// Set VersionID to download a superseded version instead of the current one.
 type PrivateDownloadURLRequest struct {
-	FileID    string `json:"fileId"`
-	ExpiresIn *int   `json:"expiresIn,omitempty"`
+	FileID    string  `json:"fileId" validate:"required"`
+	VersionID *string `json:"versionId,omitempty"`
+	ExpiresIn *int    `json:"expiresIn,omitempty"`
 }
 
 // PrivateDownloadURLResponse is the response from getting a private download URL.
@@ -61,7 +64,7 @@ type PrivateDownloadURLResponse struct {
 
 // ListPrivateFilesRequest is the request for listing private files.
 type ListPrivateFilesRequest struct {
-	ProjectSlug string             `json:"projectSlug"`
+	ProjectSlug string             `json:"projectSlug" validate:"required"`
 	Folder      *string            `json:"folder,omitempty"`
 	Status      *PrivateFileStatus `json:"status,omitempty"`
 	Search      *string            `json:"search,omitempty"`
@@ -80,7 +83,7 @@ type ListPrivateFilesResponse struct {
 
 // UpdatePrivateFileRequest is the request for updating a private file.
 type UpdatePrivateFileRequest struct {
-	FileID      string                 `json:"fileId"`
+	FileID      string                 `json:"fileId" validate:"required"`
 	Description *string                `json:"description,omitempty"`
 	Folder      *string                `json:"folder,omitempty"`
 	Tags        []string               `json:"tags,omitempty"`
@@ -89,7 +92,7 @@ type UpdatePrivateFileRequest struct {
 
 // MovePrivateFilesRequest is the request for moving private files.
 type MovePrivateFilesRequest struct {
-	FileIDs []string `json:"fileIds"`
+	FileIDs []string `json:"fileIds" validate:"required"`
 	Folder  *string  `json:"folder"`
 }
 
@@ -117,6 +120,8 @@ type DownloadBundle struct {
 	Description    *string      `json:"description,omitempty"`
 	AssetIDs       []string     `json:"assetIds,omitempty"`
 	PrivateFileIDs []string     `json:"privateFileIds,omitempty"`
+	FolderIDs      []string     `json:"folderIds,omitempty"`
+	HasManifest    bool         `json:"hasManifest"`
 	S3Key          *string      `json:"s3Key,omitempty"`
 	Size           *int64       `json:"size,omitempty"`
 	FileCount      *int         `json:"fileCount,omitempty"`
@@ -127,14 +132,21 @@ type DownloadBundle struct {
 	CompletedAt    *time.Time   `json:"completedAt,omitempty"`
 }
 
-// CreateBundleRequest is the request for creating a bundle.
+// CreateBundleRequest is the request for creating a bundle. FolderIDs adds
+// every file in the folders, and in their subfolders when Recursive is set;
+// files from folders keep their folder paths inside the zip. With
+// IncludeManifest the zip contains a manifest.json listing each file's path,
+// size and SHA-256 hash, which VerifyBundle checks.
 type CreateBundleRequest struct {
-	ProjectSlug    string   `json:"projectSlug"`
-	Name           string   `json:"name"`
-	Description    *string  `json:"description,omitempty"`
-	AssetIDs       []string `json:"assetIds,omitempty"`
-	PrivateFileIDs []string `json:"privateFileIds,omitempty"`
-	ExpiresIn      *int     `json:"expiresIn,omitempty"`
+	ProjectSlug     string   `json:"projectSlug" validate:"required"`
+	Name            string   `json:"name" validate:"required"`
+	Description     *string  `json:"description,omitempty"`
+	AssetIDs        []string `json:"assetIds,omitempty"`
+	PrivateFileIDs  []string `json:"privateFileIds,omitempty"`
+	FolderIDs       []string `json:"folderIds,omitempty"`
+	Recursive       *bool    `json:"recursive,omitempty"`
+	IncludeManifest *bool    `json:"includeManifest,omitempty"`
+	ExpiresIn       *int     `json:"expiresIn,omitempty"`
 }
 
 // CreateBundleResponse is the response from creating a bundle.
@@ -144,7 +156,7 @@ type CreateBundleResponse struct {
 
 // ListBundlesRequest is the request for listing bundles.
 type ListBundlesRequest struct {
-	ProjectSlug string        `json:"projectSlug"`
+	ProjectSlug string        `json:"projectSlug" validate:"required"`
 	Status      *BundleStatus `json:"status,omitempty"`
 	Search      *string       `json:"search,omitempty"`
 	Limit       *int          `json:"limit,omitempty"`
@@ -160,7 +172,7 @@ type ListBundlesResponse struct {
 
 // BundleDownloadURLRequest is the request for getting a bundle download URL.
 type BundleDownloadURLRequest struct {
-	BundleID  string `json:"bundleId"`
+	BundleID  string `json:"bundleId" validate:"required"`
 	ExpiresIn *int   `json:"expiresIn,omitempty"`
 }
 
@@ -169,3 +181,24 @@ type BundleDownloadURLResponse struct {
 	DownloadURL string    `json:"downloadUrl"`
 	ExpiresAt   time.Time `json:"expiresAt"`
 }
+
+// BundleManifestName is the name of the manifest file at the root of a bundle
+// zip created with IncludeManifest.
+const BundleManifestName = "manifest.json"
+
+// BundleManifestFile describes one file in a bundle. Path is the file's path
+// inside the zip, and SHA256 the hex-encoded hash of its content.
+type BundleManifestFile struct {
+	Path          string  `json:"path"`
+	Size          int64   `json:"size"`
+	SHA256        string  `json:"sha256"`
+	AssetID       *string `json:"assetId,omitempty"`
+	PrivateFileID *string `json:"privateFileId,omitempty"`
+}
+
+// BundleManifest is the content of a bundle's manifest.json.
+type BundleManifest struct {
+	BundleID  string               `json:"bundleId"`
+	CreatedAt time.Time            `json:"createdAt"`
+	Files     []BundleManifestFile `json:"files"`
+}
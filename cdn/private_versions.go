@@ -0,0 +1,86 @@
+package cdn
+
+import (
+	"context"
+	"net/url"
+)
+
+// GetPrivateVersionUploadURL generates a presigned URL for uploading a new
+// version of a private file. The new version becomes current once confirmed
+// with ConfirmPrivateVersionUpload.
+func (c *Client) GetPrivateVersionUploadURL(ctx context.Context, req *PrivateVersionUploadURLRequest) (*PrivateVersionUploadURLResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp PrivateVersionUploadURLResponse
+	if err := c.http.Post(ctx, "/cdn/private/"+req.FileID+"/versions/upload", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ConfirmPrivateVersionUpload confirms that a version upload has completed.
+func (c *Client) ConfirmPrivateVersionUpload(ctx context.Context, fileID, versionID string) (*PrivateFileVersion, error) {
+	var resp PrivateFileVersion
+	if err := c.http.Post(ctx, "/cdn/private/"+fileID+"/versions/"+versionID+"/confirm", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListPrivateFileVersions lists the versions of a private file, newest first.
+func (c *Client) ListPrivateFileVersions(ctx context.Context, fileID string) (*ListPrivateFileVersionsResponse, error) {
+	var resp ListPrivateFileVersionsResponse
+	if err := c.http.Get(ctx, "/cdn/private/"+fileID+"/versions", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RestorePrivateFileVersion makes a copy of a previous version the current
+// version. The version it replaces is kept like any superseded version.
+func (c *Client) RestorePrivateFileVersion(ctx context.Context, fileID, versionID string) (*PrivateFile, error) {
+	var resp PrivateFile
+	if err := c.http.Post(ctx, "/cdn/private/"+fileID+"/versions/"+versionID+"/restore", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetPrivateRetention sets how long superseded versions in a folder are kept.
+func (c *Client) SetPrivateRetention(ctx context.Context, req *SetPrivateRetentionRequest) (*PrivateRetentionRule, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp PrivateRetentionRule
+	if err := c.http.Put(ctx, "/cdn/private/retention", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListPrivateRetentionRules lists the retention rules of a project.
+func (c *Client) ListPrivateRetentionRules(ctx context.Context, projectSlug string) (*ListPrivateRetentionRulesResponse, error) {
+	params := url.Values{}
+	params.Set("projectSlug", projectSlug)
+
+	var resp ListPrivateRetentionRulesResponse
+	if err := c.http.Get(ctx, "/cdn/private/retention?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RemovePrivateRetention removes the retention rule of a folder. Locked rules
+// cannot be removed.
+func (c *Client) RemovePrivateRetention(ctx context.Context, projectSlug, folder string) (*SuccessResponse, error) {
+	body := map[string]string{"projectSlug": projectSlug, "folder": folder}
+
+	var resp SuccessResponse
+	if err := c.http.DeleteWithBody(ctx, "/cdn/private/retention", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,168 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetPrivateVersionUploadURL(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/private/file-123/versions/upload", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "application/pdf", body["mimeType"])
+		assert.Equal(t, "Signed by both parties", body["comment"])
+		assert.NotContains(t, body, "FileID")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PrivateVersionUploadURLResponse{
+			UploadURL: "https://s3.example.com/upload",
+			FileID:    "file-123",
+			VersionID: "ver-2",
+			Version:   2,
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.GetPrivateVersionUploadURL(context.Background(), &PrivateVersionUploadURLRequest{
+		FileID:   "file-123",
+		MimeType: "application/pdf",
+		Size:     1024,
+		Comment:  ptr("Signed by both parties"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ver-2", resp.VersionID)
+	assert.Equal(t, 2, resp.Version)
+}
+
+func TestClient_ConfirmPrivateVersionUpload(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/private/file-123/versions/ver-2/confirm", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PrivateFileVersion{ID: "ver-2", FileID: "file-123", Version: 2, IsCurrent: true})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.ConfirmPrivateVersionUpload(context.Background(), "file-123", "ver-2")
+
+	require.NoError(t, err)
+	assert.True(t, resp.IsCurrent)
+}
+
+func TestClient_ListPrivateFileVersions(t *testing.T) {
+	retainUntil := time.Date(2033, 3, 1, 0, 0, 0, 0, time.UTC)
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/cdn/private/file-123/versions", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListPrivateFileVersionsResponse{
+			Versions: []PrivateFileVersion{
+				{ID: "ver-2", Version: 2, IsCurrent: true},
+				{ID: "ver-1", Version: 1, RetainUntil: &retainUntil},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.ListPrivateFileVersions(context.Background(), "file-123")
+
+	require.NoError(t, err)
+	require.Len(t, resp.Versions, 2)
+	assert.False(t, resp.Versions[1].IsCurrent)
+	assert.Equal(t, retainUntil, *resp.Versions[1].RetainUntil)
+}
+
+func TestClient_RestorePrivateFileVersion(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/private/file-123/versions/ver-1/restore", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PrivateFile{ID: "file-123", Version: 3})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.RestorePrivateFileVersion(context.Background(), "file-123", "ver-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Version)
+}
+
+func TestClient_GetPrivateDownloadURL_Version(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cdn/private/file-123/download", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "ver-1", body["versionId"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PrivateDownloadURLResponse{DownloadURL: "https://s3.example.com/v1"})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.GetPrivateDownloadURL(context.Background(), &PrivateDownloadURLRequest{
+		FileID:    "file-123",
+		VersionID: ptr("ver-1"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://s3.example.com/v1", resp.DownloadURL)
+}
+
+func TestClient_PrivateRetention(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cdn/private/retention", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPut:
+			var req SetPrivateRetentionRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "/contracts", req.Folder)
+			assert.Equal(t, 2557, req.RetentionDays)
+			assert.True(t, *req.Locked)
+			json.NewEncoder(w).Encode(PrivateRetentionRule{Folder: req.Folder, RetentionDays: req.RetentionDays, Locked: true})
+		case http.MethodGet:
+			assert.Equal(t, "my-project", r.URL.Query().Get("projectSlug"))
+			json.NewEncoder(w).Encode(ListPrivateRetentionRulesResponse{
+				Rules: []PrivateRetentionRule{{Folder: "/contracts", RetentionDays: 2557, Locked: true}},
+			})
+		case http.MethodDelete:
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "/drafts", body["folder"])
+			json.NewEncoder(w).Encode(SuccessResponse{Success: true})
+		}
+	})
+	defer server.Close()
+	ctx := context.Background()
+
+	rule, err := cdnClient.SetPrivateRetention(ctx, &SetPrivateRetentionRequest{
+		ProjectSlug:   "my-project",
+		Folder:        "/contracts",
+		RetentionDays: 2557,
+		Locked:        ptr(true),
+	})
+	require.NoError(t, err)
+	assert.True(t, rule.Locked)
+
+	rules, err := cdnClient.ListPrivateRetentionRules(ctx, "my-project")
+	require.NoError(t, err)
+	require.Len(t, rules.Rules, 1)
+
+	removed, err := cdnClient.RemovePrivateRetention(ctx, "my-project", "/drafts")
+	require.NoError(t, err)
+	assert.True(t, removed.Success)
+}
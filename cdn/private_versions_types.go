@@ -0,0 +1,76 @@
+package cdn
+
+import "time"
+
+// ErrCodeRetentionLocked is the APIError code returned when deleting a
+// private file, or a version of it, that a retention rule still protects, or
+// when shortening a locked retention rule.
+const ErrCodeRetentionLocked = "RETENTION_LOCKED"
+
+// PrivateFileVersion is a version of a private file. Uploading a new version
+// supersedes the current one, which stays retrievable until RetainUntil.
+type PrivateFileVersion struct {
+	ID           string     `json:"id"`
+	FileID       string     `json:"fileId"`
+	Version      int        `json:"version"`
+	Filename     string     `json:"filename"`
+	MimeType     string     `json:"mimeType"`
+	Size         int64      `json:"size"`
+	Comment      *string    `json:"comment,omitempty"`
+	IsCurrent    bool       `json:"isCurrent"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	SupersededAt *time.Time `json:"supersededAt,omitempty"`
+	RetainUntil  *time.Time `json:"retainUntil,omitempty"`
+}
+
+// PrivateVersionUploadURLRequest is the request for uploading a new version
+// of a private file. Filename defaults to the current version's.
+type PrivateVersionUploadURLRequest struct {
+	FileID   string  `json:"-" validate:"required"`
+	Filename *string `json:"filename,omitempty"`
+	MimeType string  `json:"mimeType" validate:"required"`
+	Size     int64   `json:"size"`
+	Comment  *string `json:"comment,omitempty"`
+}
+
+// PrivateVersionUploadURLResponse is the response from getting a version
+// upload URL.
+type PrivateVersionUploadURLResponse struct {
+	UploadURL string    `json:"uploadUrl"`
+	FileID    string    `json:"fileId"`
+	VersionID string    `json:"versionId"`
+	Version   int       `json:"version"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// ListPrivateFileVersionsResponse lists the versions of a private file,
+// newest first.
+type ListPrivateFileVersionsResponse struct {
+	Versions []PrivateFileVersion `json:"versions"`
+}
+
+// PrivateRetentionRule keeps superseded versions of the private files in a
+// folder, and its subfolders, for RetentionDays after they are superseded. A
+// locked rule cannot be shortened or removed.
+type PrivateRetentionRule struct {
+	Folder        string     `json:"folder"`
+	RetentionDays int        `json:"retentionDays"`
+	Locked        bool       `json:"locked"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
+
+// SetPrivateRetentionRequest is the request for setting the retention rule of
+// a folder, replacing any existing rule.
+type SetPrivateRetentionRequest struct {
+	ProjectSlug   string `json:"projectSlug" validate:"required"`
+	Folder        string `json:"folder"`
+	RetentionDays int    `json:"retentionDays"`
+	Locked        *bool  `json:"locked,omitempty"`
+}
+
+// ListPrivateRetentionRulesResponse is the response from listing retention
+// rules.
+type ListPrivateRetentionRulesResponse struct {
+	Rules []PrivateRetentionRule `json:"rules"`
+}
@@ -0,0 +1,35 @@
+package cdn
+
+import "context"
+
+// SetAssetReplication sets the replication policy of an asset, overriding its
+// folder's policy. Pass nil to remove the override.
+func (c *Client) SetAssetReplication(ctx context.Context, assetID string, policy *ReplicationPolicy) (*Asset, error) {
+	var resp Asset
+	body := map[string]interface{}{"policy": policy}
+	if err := c.http.Put(ctx, "/cdn/assets/"+assetID+"/replication", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAssetReplication retrieves the effective replication policy of an asset
+// and its per-region status.
+func (c *Client) GetAssetReplication(ctx context.Context, assetID string) (*AssetReplication, error) {
+	var resp AssetReplication
+	if err := c.http.Get(ctx, "/cdn/assets/"+assetID+"/replication", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetFolderReplication sets the replication policy for all assets in a folder
+// and its subfolders that don't have their own. Pass nil to remove it.
+func (c *Client) SetFolderReplication(ctx context.Context, folderID string, policy *ReplicationPolicy) (*Folder, error) {
+	var resp Folder
+	body := map[string]interface{}{"policy": policy}
+	if err := c.http.Put(ctx, "/cdn/folders/"+folderID+"/replication", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,114 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetAssetReplication(t *testing.T) {
+	t.Run("set policy", func(t *testing.T) {
+		cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "/cdn/assets/asset-123/replication", r.URL.Path)
+
+			var body struct {
+				Policy *ReplicationPolicy `json:"policy"`
+			}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			require.NotNil(t, body.Policy)
+			assert.Equal(t, "us-east-1", body.Policy.PrimaryRegion)
+			assert.Equal(t, []string{"ap-southeast-1", "ap-northeast-1"}, body.Policy.Regions)
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(Asset{
+				ID: "asset-123",
+				Replication: &AssetReplication{
+					Policy: *body.Policy,
+					Status: ReplicationStatusPending,
+				},
+			})
+		})
+		defer server.Close()
+
+		asset, err := cdnClient.SetAssetReplication(context.Background(), "asset-123", &ReplicationPolicy{
+			PrimaryRegion: "us-east-1",
+			Regions:       []string{"ap-southeast-1", "ap-northeast-1"},
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, asset.Replication)
+		assert.Equal(t, ReplicationStatusPending, asset.Replication.Status)
+	})
+
+	t.Run("clear policy", func(t *testing.T) {
+		cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Contains(t, body, "policy")
+			assert.Nil(t, body["policy"])
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(Asset{ID: "asset-123"})
+		})
+		defer server.Close()
+
+		_, err := cdnClient.SetAssetReplication(context.Background(), "asset-123", nil)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_GetAssetReplication(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/cdn/assets/asset-123/replication", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(AssetReplication{
+			Policy:    ReplicationPolicy{PrimaryRegion: "us-east-1", Regions: []string{"ap-southeast-1"}},
+			Inherited: true,
+			Status:    ReplicationStatusReplicating,
+			Regions: []RegionReplication{
+				{Region: "us-east-1", Status: ReplicationStatusReplicated},
+				{Region: "ap-southeast-1", Status: ReplicationStatusReplicating},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.GetAssetReplication(context.Background(), "asset-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Inherited)
+	require.Len(t, resp.Regions, 2)
+	assert.Equal(t, ReplicationStatusReplicating, resp.Regions[1].Status)
+}
+
+func TestClient_SetFolderReplication(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/cdn/folders/folder-123/replication", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Folder{
+			ID:                "folder-123",
+			ReplicationPolicy: &ReplicationPolicy{PrimaryRegion: "us-east-1", Regions: []string{"ap-southeast-1"}},
+		})
+	})
+	defer server.Close()
+
+	folder, err := cdnClient.SetFolderReplication(context.Background(), "folder-123", &ReplicationPolicy{
+		PrimaryRegion: "us-east-1",
+		Regions:       []string{"ap-southeast-1"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ap-southeast-1"}, folder.ReplicationPolicy.Regions)
+}
@@ -0,0 +1,38 @@
+package cdn
+
+import "time"
+
+// ReplicationPolicy controls which regions an asset is served from.
+// PrimaryRegion holds the origin copy; Regions lists additional regions the
+// asset is replicated to.
+type ReplicationPolicy struct {
+	PrimaryRegion string   `json:"primaryRegion"`
+	Regions       []string `json:"regions"`
+}
+
+// ReplicationStatus represents the replication state of an asset or region.
+type ReplicationStatus string
+
+const (
+	ReplicationStatusPending     ReplicationStatus = "pending"
+	ReplicationStatusReplicating ReplicationStatus = "replicating"
+	ReplicationStatusReplicated  ReplicationStatus = "replicated"
+	ReplicationStatusFailed      ReplicationStatus = "failed"
+)
+
+// RegionReplication is the replication state of an asset in one region.
+type RegionReplication struct {
+	Region       string            `json:"region"`
+	Status       ReplicationStatus `json:"status"`
+	ReplicatedAt *time.Time        `json:"replicatedAt,omitempty"`
+	Error        *string           `json:"error,omitempty"`
+}
+
+// AssetReplication is the effective replication policy of an asset and its
+// progress. Inherited is true when the policy comes from the asset's folder.
+type AssetReplication struct {
+	Policy    ReplicationPolicy   `json:"policy"`
+	Inherited bool                `json:"inherited"`
+	Status    ReplicationStatus   `json:"status"`
+	Regions   []RegionReplication `json:"regions"`
+}
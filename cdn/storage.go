@@ -0,0 +1,57 @@
+package cdn
+
+import (
+	"context"
+	"net/url"
+)
+
+// GetStorageBucket retrieves the custom storage bucket of a project.
+func (c *Client) GetStorageBucket(ctx context.Context, projectSlug string) (*StorageBucket, error) {
+	params := url.Values{}
+	params.Set("projectSlug", projectSlug)
+
+	var resp StorageBucket
+	if err := c.http.Get(ctx, "/cdn/storage?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetStorageBucket stores the project's original uploads in a bucket in your
+// own cloud account, replacing any previous bucket. New uploads use the
+// bucket once it has been verified; existing assets stay where they are.
+func (c *Client) SetStorageBucket(ctx context.Context, req *SetStorageBucketRequest) (*StorageBucket, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp StorageBucket
+	if err := c.http.Put(ctx, "/cdn/storage", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// VerifyStorageBucket checks that Stack0 can read and write the project's
+// storage bucket with the granted access.
+func (c *Client) VerifyStorageBucket(ctx context.Context, projectSlug string) (*StorageBucket, error) {
+	var resp StorageBucket
+	body := map[string]interface{}{"projectSlug": projectSlug}
+	if err := c.http.Post(ctx, "/cdn/storage/verify", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RemoveStorageBucket stops storing new uploads in the project's storage
+// bucket. Assets already stored there continue to be served from it.
+func (c *Client) RemoveStorageBucket(ctx context.Context, projectSlug string) (*SuccessResponse, error) {
+	params := url.Values{}
+	params.Set("projectSlug", projectSlug)
+
+	var resp SuccessResponse
+	if err := c.http.Delete(ctx, "/cdn/storage?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
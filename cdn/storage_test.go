@@ -0,0 +1,141 @@
+package cdn
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetStorageBucket(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/cdn/storage", r.URL.Path)
+
+		var req SetStorageBucketRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "my-project", req.ProjectSlug)
+		assert.Equal(t, StorageProviderS3, req.Provider)
+		assert.Equal(t, "acme-originals", req.Bucket)
+		assert.Equal(t, "arn:aws:iam::123456789012:role/stack0-cdn", *req.RoleARN)
+
+		externalID := "ext-abc"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(StorageBucket{
+			ProjectSlug: "my-project",
+			Provider:    StorageProviderS3,
+			Bucket:      "acme-originals",
+			RoleARN:     req.RoleARN,
+			ExternalID:  &externalID,
+			Status:      StorageBucketStatusPending,
+		})
+	})
+	defer server.Close()
+
+	roleARN := "arn:aws:iam::123456789012:role/stack0-cdn"
+	region := "eu-west-1"
+	bucket, err := cdnClient.SetStorageBucket(context.Background(), &SetStorageBucketRequest{
+		ProjectSlug: "my-project",
+		Provider:    StorageProviderS3,
+		Bucket:      "acme-originals",
+		Region:      &region,
+		RoleARN:     &roleARN,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, StorageBucketStatusPending, bucket.Status)
+	assert.Equal(t, "ext-abc", *bucket.ExternalID)
+}
+
+func TestClient_GetStorageBucket(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/cdn/storage", r.URL.Path)
+		assert.Equal(t, "my-project", r.URL.Query().Get("projectSlug"))
+
+		serviceAccount := "cdn@stack0.iam.gserviceaccount.com"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(StorageBucket{
+			Provider:       StorageProviderGCS,
+			Bucket:         "acme-originals",
+			ServiceAccount: &serviceAccount,
+			Status:         StorageBucketStatusVerified,
+		})
+	})
+	defer server.Close()
+
+	bucket, err := cdnClient.GetStorageBucket(context.Background(), "my-project")
+
+	require.NoError(t, err)
+	assert.Equal(t, StorageProviderGCS, bucket.Provider)
+	assert.Equal(t, "cdn@stack0.iam.gserviceaccount.com", *bucket.ServiceAccount)
+}
+
+func TestClient_VerifyStorageBucket(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/cdn/storage/verify", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "my-project", body["projectSlug"])
+
+		errMsg := "AccessDenied: s3:PutObject"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(StorageBucket{Status: StorageBucketStatusFailed, Error: &errMsg})
+	})
+	defer server.Close()
+
+	bucket, err := cdnClient.VerifyStorageBucket(context.Background(), "my-project")
+
+	require.NoError(t, err)
+	assert.Equal(t, StorageBucketStatusFailed, bucket.Status)
+	assert.Contains(t, *bucket.Error, "s3:PutObject")
+}
+
+func TestClient_RemoveStorageBucket(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/cdn/storage", r.URL.Path)
+		assert.Equal(t, "my-project", r.URL.Query().Get("projectSlug"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SuccessResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.RemoveStorageBucket(context.Background(), "my-project")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestClient_GetUploadURL_CustomOrigin(t *testing.T) {
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"uploadUrl": "https://acme-originals.s3.eu-west-1.amazonaws.com/uploads/hero.png?X-Amz-Signature=abc",
+			"assetId": "asset-123",
+			"cdnUrl": "https://cdn.example.com/hero.png",
+			"headers": {"x-amz-server-side-encryption": "aws:kms"},
+			"origin": {"provider": "s3", "bucket": "acme-originals", "key": "uploads/hero.png"}
+		}`))
+	})
+	defer server.Close()
+
+	upload, err := cdnClient.GetUploadURL(context.Background(), &UploadURLRequest{
+		ProjectSlug: "my-project",
+		Filename:    "hero.png",
+		MimeType:    "image/png",
+		Size:        1024,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "aws:kms", upload.Headers["x-amz-server-side-encryption"])
+	require.NotNil(t, upload.Origin)
+	assert.Equal(t, AssetOrigin{Provider: StorageProviderS3, Bucket: "acme-originals", Key: "uploads/hero.png"}, *upload.Origin)
+}
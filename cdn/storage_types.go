@@ -0,0 +1,62 @@
+package cdn
+
+import "time"
+
+// StorageProvider identifies the cloud provider of a custom storage bucket.
+type StorageProvider string
+
+const (
+	StorageProviderS3  StorageProvider = "s3"
+	StorageProviderGCS StorageProvider = "gcs"
+)
+
+// StorageBucketStatus represents the status of a custom storage bucket.
+type StorageBucketStatus string
+
+const (
+	StorageBucketStatusPending  StorageBucketStatus = "pending"
+	StorageBucketStatusVerified StorageBucketStatus = "verified"
+	StorageBucketStatusFailed   StorageBucketStatus = "failed"
+)
+
+// StorageBucket is a bucket in your own cloud account that holds a project's
+// original uploads. Stack0 still processes and delivers the assets, reading
+// originals from the bucket.
+//
+// For S3, grant access by letting RoleARN be assumed by Stack0 with
+// ExternalID as the sts:ExternalId condition. For GCS, grant ServiceAccount
+// access to the bucket.
+type StorageBucket struct {
+	ProjectSlug    string              `json:"projectSlug"`
+	Provider       StorageProvider     `json:"provider"`
+	Bucket         string              `json:"bucket"`
+	Region         *string             `json:"region,omitempty"`
+	Prefix         *string             `json:"prefix,omitempty"`
+	RoleARN        *string             `json:"roleArn,omitempty"`
+	ExternalID     *string             `json:"externalId,omitempty"`
+	ServiceAccount *string             `json:"serviceAccount,omitempty"`
+	Status         StorageBucketStatus `json:"status"`
+	Error          *string             `json:"error,omitempty"`
+	VerifiedAt     *time.Time          `json:"verifiedAt,omitempty"`
+	CreatedAt      time.Time           `json:"createdAt"`
+}
+
+// SetStorageBucketRequest is the request for setting a project's storage
+// bucket. RoleARN is required for S3 buckets. Prefix is prepended to the keys
+// of uploaded originals.
+type SetStorageBucketRequest struct {
+	ProjectSlug string          `json:"projectSlug" validate:"required"`
+	Provider    StorageProvider `json:"provider" validate:"required"`
+	Bucket      string          `json:"bucket" validate:"required"`
+	Region      *string         `json:"region,omitempty"`
+	Prefix      *string         `json:"prefix,omitempty"`
+	RoleARN     *string         `json:"roleArn,omitempty"`
+}
+
+// AssetOrigin is where an asset's original is stored when it lives in a
+// custom storage bucket.
+type AssetOrigin struct {
+	Provider StorageProvider `json:"provider"`
+	Bucket   string          `json:"bucket"`
+	Key      string          `json:"key"`
+}
@@ -0,0 +1,180 @@
+package cdn
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Validate checks that the options describe a transform the CDN can render:
+// positive dimensions, a quality between 1 and 100, a known fit and format,
+// and, with StrictWidth, a width from AllowedWidths. Errors wrap
+// ErrInvalidTransform. GetTransformURL calls it before building a URL.
+func (o *TransformOptions) Validate() error {
+	if o == nil {
+		return nil
+	}
+	if o.Width != nil {
+		if *o.Width <= 0 {
+			return fmt.Errorf("%w: width must be positive, got %d", ErrInvalidTransform, *o.Width)
+		}
+		if o.StrictWidth && !slices.Contains(AllowedWidths, *o.Width) {
+			return fmt.Errorf("%w: width %d is not one of %v", ErrInvalidTransform, *o.Width, AllowedWidths)
+		}
+	}
+	if o.Height != nil && *o.Height <= 0 {
+		return fmt.Errorf("%w: height must be positive, got %d", ErrInvalidTransform, *o.Height)
+	}
+	if o.Quality != nil && (*o.Quality < 1 || *o.Quality > 100) {
+		return fmt.Errorf("%w: quality must be between 1 and 100, got %d", ErrInvalidTransform, *o.Quality)
+	}
+	if o.Fit != nil {
+		switch TransformFit(*o.Fit) {
+		case FitCover, FitContain, FitFill, FitInside, FitOutside:
+		default:
+			return fmt.Errorf("%w: unknown fit %q", ErrInvalidTransform, *o.Fit)
+		}
+	}
+	if o.Format != nil {
+		switch TransformFormat(*o.Format) {
+		case FormatWebP, FormatJPEG, FormatPNG, FormatAVIF:
+		default:
+			return fmt.Errorf("%w: unknown format %q", ErrInvalidTransform, *o.Format)
+		}
+	}
+	return nil
+}
+
+// TransformBuilder builds a transform URL step by step. Options are checked
+// when URL is called, so an invalid combination is reported as an error
+// instead of producing a URL that serves a broken image.
+//
+//	url, err := client.CDN.Transform("uploads/hero.png").
+//		Width(1200).
+//		Fit(cdn.FitCover).
+//		Format(cdn.FormatWebP).
+//		Quality(80).
+//		URL()
+type TransformBuilder struct {
+	client *Client
+	source string
+	opts   TransformOptions
+}
+
+// Transform starts building a transform URL for an asset URL or S3 key.
+func (c *Client) Transform(assetURLOrS3Key string) *TransformBuilder {
+	return &TransformBuilder{client: c, source: assetURLOrS3Key}
+}
+
+// Width sets the target width, snapped to the nearest of AllowedWidths.
+func (b *TransformBuilder) Width(width int) *TransformBuilder {
+	b.opts.Width = &width
+	return b
+}
+
+// ExactWidth sets the target width and makes URL fail unless it is one of
+// AllowedWidths.
+func (b *TransformBuilder) ExactWidth(width int) *TransformBuilder {
+	b.opts.Width = &width
+	b.opts.StrictWidth = true
+	return b
+}
+
+// Height sets the target height.
+func (b *TransformBuilder) Height(height int) *TransformBuilder {
+	b.opts.Height = &height
+	return b
+}
+
+// Fit sets how the image is resized to the target dimensions.
+func (b *TransformBuilder) Fit(fit TransformFit) *TransformBuilder {
+	s := string(fit)
+	b.opts.Fit = &s
+	return b
+}
+
+// Format sets the output format.
+func (b *TransformBuilder) Format(format TransformFormat) *TransformBuilder {
+	s := string(format)
+	b.opts.Format = &s
+	return b
+}
+
+// Quality sets the compression quality, from 1 to 100.
+func (b *TransformBuilder) Quality(quality int) *TransformBuilder {
+	b.opts.Quality = &quality
+	return b
+}
+
+// Crop sets the crop mode.
+func (b *TransformBuilder) Crop(crop string) *TransformBuilder {
+	b.opts.Crop = &crop
+	return b
+}
+
+// CropRect crops to the given rectangle before resizing.
+func (b *TransformBuilder) CropRect(x, y, width, height int) *TransformBuilder {
+	b.opts.CropX = &x
+	b.opts.CropY = &y
+	b.opts.CropWidth = &width
+	b.opts.CropHeight = &height
+	return b
+}
+
+// Blur sets the blur radius.
+func (b *TransformBuilder) Blur(radius int) *TransformBuilder {
+	b.opts.Blur = &radius
+	return b
+}
+
+// Sharpen sets the sharpen amount.
+func (b *TransformBuilder) Sharpen(amount int) *TransformBuilder {
+	b.opts.Sharpen = &amount
+	return b
+}
+
+// Brightness sets the brightness adjustment.
+func (b *TransformBuilder) Brightness(adjustment int) *TransformBuilder {
+	b.opts.Brightness = &adjustment
+	return b
+}
+
+// Saturation sets the saturation adjustment.
+func (b *TransformBuilder) Saturation(adjustment int) *TransformBuilder {
+	b.opts.Saturation = &adjustment
+	return b
+}
+
+// Grayscale converts the image to grayscale.
+func (b *TransformBuilder) Grayscale() *TransformBuilder {
+	b.opts.Grayscale = true
+	return b
+}
+
+// Rotate rotates the image by degrees.
+func (b *TransformBuilder) Rotate(degrees int) *TransformBuilder {
+	b.opts.Rotate = &degrees
+	return b
+}
+
+// Flip flips the image vertically.
+func (b *TransformBuilder) Flip() *TransformBuilder {
+	b.opts.Flip = true
+	return b
+}
+
+// Flop flips the image horizontally.
+func (b *TransformBuilder) Flop() *TransformBuilder {
+	b.opts.Flop = true
+	return b
+}
+
+// Options returns a copy of the options built so far.
+func (b *TransformBuilder) Options() TransformOptions {
+	return b.opts
+}
+
+// URL validates the options and returns the transform URL.
+func (b *TransformBuilder) URL() (string, error) {
+	opts := b.opts
+	return b.client.GetTransformURL(b.source, &opts)
+}
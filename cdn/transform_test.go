@@ -0,0 +1,84 @@
+package cdn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransformOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *TransformOptions
+		err  string
+	}{
+		{name: "nil", opts: nil},
+		{name: "valid", opts: &TransformOptions{Width: ptr(800), Quality: ptr(100), Fit: ptr("cover"), Format: ptr("avif")}},
+		{name: "quality too low", opts: &TransformOptions{Quality: ptr(0)}, err: "quality must be between 1 and 100, got 0"},
+		{name: "quality too high", opts: &TransformOptions{Quality: ptr(101)}, err: "quality"},
+		{name: "unknown fit", opts: &TransformOptions{Fit: ptr("stretch")}, err: `unknown fit "stretch"`},
+		{name: "unknown format", opts: &TransformOptions{Format: ptr("gif")}, err: `unknown format "gif"`},
+		{name: "negative width", opts: &TransformOptions{Width: ptr(-1)}, err: "width must be positive"},
+		{name: "zero height", opts: &TransformOptions{Height: ptr(0)}, err: "height must be positive"},
+		{name: "snapped width", opts: &TransformOptions{Width: ptr(800)}},
+		{name: "strict allowed width", opts: &TransformOptions{Width: ptr(828), StrictWidth: true}},
+		{name: "strict width mismatch", opts: &TransformOptions{Width: ptr(800), StrictWidth: true}, err: "width 800 is not one of"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidTransform)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestClient_GetTransformURL_Invalid(t *testing.T) {
+	cdnClient, _ := setupCDNTestClientWithCDNURL(t, nil, "https://cdn.example.com")
+
+	_, err := cdnClient.GetTransformURL("uploads/image.jpg", &TransformOptions{Quality: ptr(150)})
+
+	assert.ErrorIs(t, err, ErrInvalidTransform)
+}
+
+func TestTransformBuilder(t *testing.T) {
+	cdnClient, _ := setupCDNTestClientWithCDNURL(t, nil, "https://cdn.example.com")
+
+	url, err := cdnClient.Transform("uploads/image.jpg").
+		Width(1000).
+		Height(500).
+		Fit(FitCover).
+		Format(FormatWebP).
+		Quality(80).
+		Grayscale().
+		URL()
+
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/uploads/image.jpg?f=webp&fit=cover&grayscale=true&h=500&q=80&w=1080", url)
+}
+
+func TestTransformBuilder_ExactWidth(t *testing.T) {
+	cdnClient, _ := setupCDNTestClientWithCDNURL(t, nil, "https://cdn.example.com")
+
+	url, err := cdnClient.Transform("uploads/image.jpg").ExactWidth(640).URL()
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/uploads/image.jpg?w=640", url)
+
+	_, err = cdnClient.Transform("uploads/image.jpg").ExactWidth(1000).URL()
+	assert.ErrorIs(t, err, ErrInvalidTransform)
+}
+
+func TestTransformBuilder_Options(t *testing.T) {
+	cdnClient, _ := setupCDNTestClient(t, nil)
+
+	opts := cdnClient.Transform("https://cdn.example.com/a.jpg").CropRect(1, 2, 30, 40).Flip().Options()
+
+	assert.Equal(t, 30, *opts.CropWidth)
+	assert.Equal(t, 40, *opts.CropHeight)
+	assert.True(t, opts.Flip)
+}
@@ -0,0 +1,28 @@
+package cdn
+
+import "errors"
+
+// ErrInvalidTransform is wrapped by the errors returned for transform options
+// that would produce a broken image URL.
+var ErrInvalidTransform = errors.New("invalid transform")
+
+// TransformFit is how an image is resized to fit Width and Height.
+type TransformFit string
+
+const (
+	FitCover   TransformFit = "cover"
+	FitContain TransformFit = "contain"
+	FitFill    TransformFit = "fill"
+	FitInside  TransformFit = "inside"
+	FitOutside TransformFit = "outside"
+)
+
+// TransformFormat is the output format of a transformed image.
+type TransformFormat string
+
+const (
+	FormatWebP TransformFormat = "webp"
+	FormatJPEG TransformFormat = "jpeg"
+	FormatPNG  TransformFormat = "png"
+	FormatAVIF TransformFormat = "avif"
+)
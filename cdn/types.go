@@ -24,7 +24,17 @@ const (
 	AssetTypeOther    AssetType = "other"
 )
 
-// Asset represents a CDN asset.
+// AssetExpand names related data that can be included when fetching an asset.
+type AssetExpand string
+
+const (
+	AssetExpandTranscodeJobs AssetExpand = "transcodeJobs"
+	AssetExpandThumbnails    AssetExpand = "thumbnails"
+	AssetExpandReplication   AssetExpand = "replication"
+)
+
+// Asset represents a CDN asset. TranscodeJobs and Thumbnails are only
+// populated when requested with AssetExpand.
 type Asset struct {
 	ID               string                 `json:"id"`
 	Filename         string                 `json:"filename"`
@@ -42,32 +52,43 @@ type Asset struct {
 	Tags             []string               `json:"tags,omitempty"`
 	Metadata         map[string]interface{} `json:"metadata,omitempty"`
 	Alt              *string                `json:"alt,omitempty"`
+	Replication      *AssetReplication      `json:"replication,omitempty"`
+	Origin           *AssetOrigin           `json:"origin,omitempty"`
+	Review           *AssetReview           `json:"review,omitempty"`
+	Renditions       []AssetRendition       `json:"renditions,omitempty"`
+	TranscodeJobs    []TranscodeJob         `json:"transcodeJobs,omitempty"`
+	Thumbnails       []VideoThumbnail       `json:"thumbnails,omitempty"`
 	CreatedAt        time.Time              `json:"createdAt"`
 	UpdatedAt        *time.Time             `json:"updatedAt,omitempty"`
 }
 
 // UploadURLRequest is the request for getting an upload URL.
 type UploadURLRequest struct {
-	ProjectSlug string                  `json:"projectSlug"`
-	Filename    string                  `json:"filename"`
-	MimeType    string                  `json:"mimeType"`
-	Size        int64                   `json:"size"`
-	Folder      *string                 `json:"folder,omitempty"`
-	Metadata    map[string]interface{}  `json:"metadata,omitempty"`
-	Watermark   *ImageWatermarkConfig   `json:"watermark,omitempty"`
+	ProjectSlug string                 `json:"projectSlug" validate:"required"`
+	Filename    string                 `json:"filename" validate:"required"`
+	MimeType    string                 `json:"mimeType" validate:"required"`
+	Size        int64                  `json:"size"`
+	Folder      *string                `json:"folder,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Watermark   *ImageWatermarkConfig  `json:"watermark,omitempty"`
 }
 
-// UploadURLResponse is the response from getting an upload URL.
+// UploadURLResponse is the response from getting an upload URL. When the
+// project has a custom storage bucket, UploadURL points at the bucket and
+// Origin says where the original will be stored. Headers must be sent with
+// the upload request as they are part of the URL's signature.
 type UploadURLResponse struct {
-	UploadURL string    `json:"uploadUrl"`
-	AssetID   string    `json:"assetId"`
-	CDNURL    string    `json:"cdnUrl"`
-	ExpiresAt time.Time `json:"expiresAt"`
+	UploadURL string            `json:"uploadUrl"`
+	AssetID   string            `json:"assetId"`
+	CDNURL    string            `json:"cdnUrl"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Origin    *AssetOrigin      `json:"origin,omitempty"`
 }
 
 // UpdateAssetRequest is the request for updating an asset.
 type UpdateAssetRequest struct {
-	ID       string                 `json:"id"`
+	ID       string                 `json:"id" validate:"required"`
 	Filename *string                `json:"filename,omitempty"`
 	Folder   *string                `json:"folder,omitempty"`
 	Tags     []string               `json:"tags,omitempty"`
@@ -75,6 +96,13 @@ type UpdateAssetRequest struct {
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// GetManyAssetsResponse is the response from getting assets by ID. NotFound
+// lists requested IDs that don't exist.
+type GetManyAssetsResponse struct {
+	Assets   []Asset  `json:"assets"`
+	NotFound []string `json:"notFound"`
+}
+
 // DeleteAssetsResponse is the response from deleting assets.
 type DeleteAssetsResponse struct {
 	Success      bool `json:"success"`
@@ -83,16 +111,18 @@ type DeleteAssetsResponse struct {
 
 // ListAssetsRequest is the request for listing assets.
 type ListAssetsRequest struct {
-	ProjectSlug string       `json:"projectSlug"`
-	Folder      *string      `json:"folder,omitempty"`
-	Type        *AssetType   `json:"type,omitempty"`
-	Status      *AssetStatus `json:"status,omitempty"`
-	Search      *string      `json:"search,omitempty"`
-	Tags        []string     `json:"tags,omitempty"`
-	SortBy      *string      `json:"sortBy,omitempty"`
-	SortOrder   *string      `json:"sortOrder,omitempty"`
-	Limit       *int         `json:"limit,omitempty"`
-	Offset      *int         `json:"offset,omitempty"`
+	ProjectSlug string            `json:"projectSlug" validate:"required"`
+	Folder      *string           `json:"folder,omitempty"`
+	Type        *AssetType        `json:"type,omitempty"`
+	Status      *AssetStatus      `json:"status,omitempty"`
+	ReviewState *ReviewState      `json:"reviewState,omitempty"`
+	Search      *string           `json:"search,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	SortBy      *string           `json:"sortBy,omitempty"`
+	SortOrder   *string           `json:"sortOrder,omitempty"`
+	Limit       *int              `json:"limit,omitempty"`
+	Offset      *int              `json:"offset,omitempty"`
 }
 
 // ListAssetsResponse is the response from listing assets.
@@ -104,7 +134,7 @@ type ListAssetsResponse struct {
 
 // MoveAssetsRequest is the request for moving assets.
 type MoveAssetsRequest struct {
-	AssetIDs []string `json:"assetIds"`
+	AssetIDs []string `json:"assetIds" validate:"required"`
 	Folder   *string  `json:"folder"`
 }
 
@@ -114,7 +144,9 @@ type MoveAssetsResponse struct {
 	MovedCount int  `json:"movedCount"`
 }
 
-// TransformOptions represents image transformation options.
+// TransformOptions represents image transformation options. Width is snapped
+// to the nearest of AllowedWidths unless StrictWidth is set, in which case a
+// width not in AllowedWidths is an error. See Validate for the other checks.
 type TransformOptions struct {
 	Width      *int    `json:"width,omitempty"`
 	Height     *int    `json:"height,omitempty"`
@@ -134,6 +166,8 @@ type TransformOptions struct {
 	Rotate     *int    `json:"rotate,omitempty"`
 	Flip       bool    `json:"flip,omitempty"`
 	Flop       bool    `json:"flop,omitempty"`
+
+	StrictWidth bool `json:"-"`
 }
 
 // ImageWatermarkPosition represents the position of a watermark.
@@ -187,32 +221,34 @@ type FolderTreeNode struct {
 
 // GetFolderTreeRequest is the request for getting the folder tree.
 type GetFolderTreeRequest struct {
-	ProjectSlug string `json:"projectSlug"`
+	ProjectSlug string `json:"projectSlug" validate:"required"`
 	MaxDepth    *int   `json:"maxDepth,omitempty"`
 }
 
 // CreateFolderRequest is the request for creating a folder.
 type CreateFolderRequest struct {
-	ProjectSlug string  `json:"projectSlug"`
-	Name        string  `json:"name"`
+	ProjectSlug string  `json:"projectSlug" validate:"required"`
+	Name        string  `json:"name" validate:"required"`
 	ParentID    *string `json:"parentId,omitempty"`
 }
 
 // Folder represents a CDN folder.
 type Folder struct {
-	ID         string     `json:"id"`
-	Name       string     `json:"name"`
-	Path       string     `json:"path"`
-	ParentID   *string    `json:"parentId,omitempty"`
-	AssetCount int        `json:"assetCount"`
-	TotalSize  int64      `json:"totalSize"`
-	CreatedAt  time.Time  `json:"createdAt"`
-	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+	ID                string             `json:"id"`
+	Name              string             `json:"name"`
+	Path              string             `json:"path"`
+	ParentID          *string            `json:"parentId,omitempty"`
+	AssetCount        int                `json:"assetCount"`
+	TotalSize         int64              `json:"totalSize"`
+	ReplicationPolicy *ReplicationPolicy `json:"replicationPolicy,omitempty"`
+	RequireApproval   bool               `json:"requireApproval"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         *time.Time         `json:"updatedAt,omitempty"`
 }
 
 // UpdateFolderRequest is the request for updating a folder.
 type UpdateFolderRequest struct {
-	ID   string  `json:"id"`
+	ID   string  `json:"id" validate:"required"`
 	Name *string `json:"name,omitempty"`
 }
 
@@ -244,7 +280,7 @@ type ListFoldersResponse struct {
 
 // MoveFolderRequest is the request for moving a folder.
 type MoveFolderRequest struct {
-	ID          string  `json:"id"`
+	ID          string  `json:"id" validate:"required"`
 	NewParentID *string `json:"newParentId"`
 }
 
@@ -257,3 +293,36 @@ type MoveFolderResponse struct {
 type SuccessResponse struct {
 	Success bool `json:"success"`
 }
+
+// QRCodeFormat is the image format of a generated QR code.
+type QRCodeFormat string
+
+const (
+	QRCodeFormatPNG QRCodeFormat = "png"
+	QRCodeFormatSVG QRCodeFormat = "svg"
+)
+
+// QRErrorCorrection is the QR code error correction level.
+type QRErrorCorrection string
+
+const (
+	QRErrorCorrectionLow      QRErrorCorrection = "L"
+	QRErrorCorrectionMedium   QRErrorCorrection = "M"
+	QRErrorCorrectionQuartile QRErrorCorrection = "Q"
+	QRErrorCorrectionHigh     QRErrorCorrection = "H"
+)
+
+// GenerateQRCodeRequest is the request for generating a QR code asset.
+type GenerateQRCodeRequest struct {
+	ProjectSlug     string             `json:"projectSlug" validate:"required"`
+	Data            string             `json:"data" validate:"required"`
+	Size            *int               `json:"size,omitempty"`
+	Margin          *int               `json:"margin,omitempty"`
+	Format          *QRCodeFormat      `json:"format,omitempty"`
+	ErrorCorrection *QRErrorCorrection `json:"errorCorrection,omitempty"`
+	ForegroundColor *string            `json:"foregroundColor,omitempty"`
+	BackgroundColor *string            `json:"backgroundColor,omitempty"`
+	Filename        *string            `json:"filename,omitempty"`
+	Folder          *string            `json:"folder,omitempty"`
+	Tags            []string           `json:"tags,omitempty"`
+}
@@ -2,13 +2,18 @@ package cdn
 
 import (
 	"context"
-	"net/url"
 	"strconv"
+
+	"github.com/stack0/sdk-go/client"
 )
 
 // GetUsage gets current usage stats for the billing period.
 func (c *Client) GetUsage(ctx context.Context, req *CdnUsageRequest) (*CdnUsageResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.ProjectSlug != nil {
 			params.Set("projectSlug", *req.ProjectSlug)
@@ -16,12 +21,8 @@ func (c *Client) GetUsage(ctx context.Context, req *CdnUsageRequest) (*CdnUsageR
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
 		}
-		if req.PeriodStart != nil {
-			params.Set("periodStart", req.PeriodStart.Format("2006-01-02T15:04:05Z07:00"))
-		}
-		if req.PeriodEnd != nil {
-			params.Set("periodEnd", req.PeriodEnd.Format("2006-01-02T15:04:05Z07:00"))
-		}
+		client.SetTime(params, "periodStart", req.PeriodStart)
+		client.SetTime(params, "periodEnd", req.PeriodEnd)
 	}
 
 	path := "/cdn/usage"
@@ -38,7 +39,11 @@ func (c *Client) GetUsage(ctx context.Context, req *CdnUsageRequest) (*CdnUsageR
 
 // GetUsageHistory gets usage history (time series data for charts).
 func (c *Client) GetUsageHistory(ctx context.Context, req *CdnUsageHistoryRequest) (*CdnUsageHistoryResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.ProjectSlug != nil {
 			params.Set("projectSlug", *req.ProjectSlug)
@@ -68,7 +73,11 @@ func (c *Client) GetUsageHistory(ctx context.Context, req *CdnUsageHistoryReques
 
 // GetStorageBreakdown gets storage breakdown by type or folder.
 func (c *Client) GetStorageBreakdown(ctx context.Context, req *CdnStorageBreakdownRequest) (*CdnStorageBreakdownResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.ProjectSlug != nil {
 			params.Set("projectSlug", *req.ProjectSlug)
@@ -7,7 +7,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/stack0/sdk-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,12 +19,12 @@ func TestClient_GetUsage(t *testing.T) {
 
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(CdnUsageResponse{
-				PeriodStart:         time.Now().AddDate(0, -1, 0),
-				PeriodEnd:           time.Now(),
-				StorageUsedBytes:    5000000000,
-				BandwidthUsedBytes:  50000000000,
-				RequestCount:        100000,
-				TransformationCount: 50000,
+				PeriodStart:     time.Now().AddDate(0, -1, 0),
+				PeriodEnd:       time.Now(),
+				StorageBytes:    5000000000,
+				BandwidthBytes:  50000000000,
+				Requests:        100000,
+				Transformations: 50000,
 			})
 		})
 		defer server.Close()
@@ -33,9 +32,9 @@ func TestClient_GetUsage(t *testing.T) {
 		resp, err := cdnClient.GetUsage(context.Background(), nil)
 
 		require.NoError(t, err)
-		assert.Equal(t, int64(5000000000), resp.StorageUsedBytes)
-		assert.Equal(t, int64(50000000000), resp.BandwidthUsedBytes)
-		assert.Equal(t, 100000, resp.RequestCount)
+		assert.Equal(t, int64(5000000000), resp.StorageBytes)
+		assert.Equal(t, int64(50000000000), resp.BandwidthBytes)
+		assert.Equal(t, int64(100000), resp.Requests)
 	})
 
 	t.Run("with environment filter", func(t *testing.T) {
@@ -47,7 +46,7 @@ func TestClient_GetUsage(t *testing.T) {
 		})
 		defer server.Close()
 
-		env := types.EnvironmentProduction
+		env := CdnEnvironmentProduction
 		resp, err := cdnClient.GetUsage(context.Background(), &CdnUsageRequest{
 			Environment: &env,
 		})
@@ -86,9 +85,9 @@ func TestClient_GetUsageHistory(t *testing.T) {
 
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(CdnUsageHistoryResponse{
-				Data: []CdnUsageHistoryDataPoint{
-					{Date: "2024-01-01", StorageBytes: 1000000, BandwidthBytes: 5000000, Requests: 1000},
-					{Date: "2024-01-02", StorageBytes: 1100000, BandwidthBytes: 5500000, Requests: 1100},
+				Data: []CdnUsageDataPoint{
+					{Timestamp: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), BandwidthBytes: 5000000, Requests: 1000},
+					{Timestamp: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), BandwidthBytes: 5500000, Requests: 1100},
 				},
 			})
 		})
@@ -105,7 +104,7 @@ func TestClient_GetUsageHistory(t *testing.T) {
 			assert.Contains(t, r.URL.RawQuery, "days=30")
 
 			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(CdnUsageHistoryResponse{Data: []CdnUsageHistoryDataPoint{}})
+			json.NewEncoder(w).Encode(CdnUsageHistoryResponse{Data: []CdnUsageDataPoint{}})
 		})
 		defer server.Close()
 
@@ -123,7 +122,7 @@ func TestClient_GetUsageHistory(t *testing.T) {
 			assert.Contains(t, r.URL.RawQuery, "granularity=hourly")
 
 			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(CdnUsageHistoryResponse{Data: []CdnUsageHistoryDataPoint{}})
+			json.NewEncoder(w).Encode(CdnUsageHistoryResponse{Data: []CdnUsageDataPoint{}})
 		})
 		defer server.Close()
 
@@ -146,10 +145,10 @@ func TestClient_GetStorageBreakdown(t *testing.T) {
 
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(CdnStorageBreakdownResponse{
-				Breakdown: []CdnStorageBreakdownItem{
-					{Category: "image", Size: 2000000000, Count: 500},
-					{Category: "video", Size: 10000000000, Count: 50},
-					{Category: "document", Size: 500000000, Count: 200},
+				Items: []CdnStorageBreakdownItem{
+					{Key: "image", SizeBytes: 2000000000, Count: 500},
+					{Key: "video", SizeBytes: 10000000000, Count: 50},
+					{Key: "document", SizeBytes: 500000000, Count: 200},
 				},
 			})
 		})
@@ -161,7 +160,7 @@ func TestClient_GetStorageBreakdown(t *testing.T) {
 		})
 
 		require.NoError(t, err)
-		assert.Len(t, resp.Breakdown, 3)
+		assert.Len(t, resp.Items, 3)
 	})
 
 	t.Run("by folder", func(t *testing.T) {
@@ -170,9 +169,9 @@ func TestClient_GetStorageBreakdown(t *testing.T) {
 
 			w.WriteHeader(http.StatusOK)
 			json.NewEncoder(w).Encode(CdnStorageBreakdownResponse{
-				Breakdown: []CdnStorageBreakdownItem{
-					{Category: "/images", Size: 3000000000, Count: 300},
-					{Category: "/documents", Size: 1000000000, Count: 150},
+				Items: []CdnStorageBreakdownItem{
+					{Key: "/images", SizeBytes: 3000000000, Count: 300},
+					{Key: "/documents", SizeBytes: 1000000000, Count: 150},
 				},
 			})
 		})
@@ -184,7 +183,7 @@ func TestClient_GetStorageBreakdown(t *testing.T) {
 		})
 
 		require.NoError(t, err)
-		assert.Len(t, resp.Breakdown, 2)
+		assert.Len(t, resp.Items, 2)
 	})
 
 	t.Run("with project filter", func(t *testing.T) {
@@ -192,7 +191,7 @@ func TestClient_GetStorageBreakdown(t *testing.T) {
 			assert.Contains(t, r.URL.RawQuery, "projectSlug=my-project")
 
 			w.WriteHeader(http.StatusOK)
-			json.NewEncoder(w).Encode(CdnStorageBreakdownResponse{Breakdown: []CdnStorageBreakdownItem{}})
+			json.NewEncoder(w).Encode(CdnStorageBreakdownResponse{Items: []CdnStorageBreakdownItem{}})
 		})
 		defer server.Close()
 
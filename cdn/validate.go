@@ -0,0 +1,79 @@
+package cdn
+
+import "github.com/stack0/sdk-go/types"
+
+func init() {
+	types.RegisterEnum(CdnEnvironmentSandbox, CdnEnvironmentProduction)
+	types.RegisterEnum(ConversionFormatWebP, ConversionFormatAVIF, ConversionFormatJPEG, ConversionFormatPNG)
+	types.RegisterEnum(
+		ConversionJobStatusPending,
+		ConversionJobStatusProcessing,
+		ConversionJobStatusCompleted,
+		ConversionJobStatusFailed,
+		ConversionJobStatusCancelled,
+	)
+	types.RegisterEnum(ImportAuthTypeIAMCredentials, ImportAuthTypeRoleAssumption)
+	types.RegisterEnum(ImportPathModePreserve, ImportPathModeFlatten)
+	types.RegisterEnum(
+		ImportJobStatusPending,
+		ImportJobStatusValidating,
+		ImportJobStatusImporting,
+		ImportJobStatusCompleted,
+		ImportJobStatusFailed,
+		ImportJobStatusCancelled,
+	)
+	types.RegisterEnum(
+		ImportFileStatusPending,
+		ImportFileStatusImporting,
+		ImportFileStatusCompleted,
+		ImportFileStatusFailed,
+		ImportFileStatusSkipped,
+	)
+	types.RegisterEnum(
+		PrivateFileStatusPending,
+		PrivateFileStatusReady,
+		PrivateFileStatusFailed,
+		PrivateFileStatusDeleted,
+	)
+	types.RegisterEnum(
+		BundleStatusPending,
+		BundleStatusProcessing,
+		BundleStatusReady,
+		BundleStatusFailed,
+		BundleStatusExpired,
+	)
+	types.RegisterEnum(StorageProviderS3, StorageProviderGCS)
+	types.RegisterEnum(AssetTypeImage, AssetTypeVideo, AssetTypeAudio, AssetTypeDocument, AssetTypeOther)
+	types.RegisterEnum(
+		AssetStatusPending,
+		AssetStatusProcessing,
+		AssetStatusReady,
+		AssetStatusFailed,
+		AssetStatusDeleted,
+	)
+	types.RegisterEnum(ReviewStateDraft, ReviewStatePendingReview, ReviewStateApproved)
+	types.RegisterEnum(QRCodeFormatPNG, QRCodeFormatSVG)
+	types.RegisterEnum(
+		QRErrorCorrectionLow,
+		QRErrorCorrectionMedium,
+		QRErrorCorrectionQuartile,
+		QRErrorCorrectionHigh,
+	)
+	types.RegisterEnum(VideoOutputHLS, VideoOutputDASH, VideoOutputMP4)
+	types.RegisterEnum(
+		TranscodeJobStatusPending,
+		TranscodeJobStatusQueued,
+		TranscodeJobStatusProcessing,
+		TranscodeJobStatusCompleted,
+		TranscodeJobStatusFailed,
+		TranscodeJobStatusCancelled,
+	)
+}
+
+// Validate implements types.Validator. Folder and AssetIDs are mutually
+// exclusive.
+func (r *CreateConversionRequest) Validate() error {
+	return types.CheckFields(r,
+		types.MutuallyExclusive(types.Field("folder", r.Folder != nil), types.Field("assetIds", len(r.AssetIDs) > 0)),
+	)
+}
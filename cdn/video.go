@@ -8,6 +8,10 @@ import (
 
 // Transcode starts a video transcoding job.
 func (c *Client) Transcode(ctx context.Context, req *TranscodeVideoRequest) (*TranscodeJob, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp TranscodeJob
 	if err := c.http.Post(ctx, "/cdn/video/transcode", req, &resp); err != nil {
 		return nil, err
@@ -26,6 +30,10 @@ func (c *Client) GetJob(ctx context.Context, jobID string) (*TranscodeJob, error
 
 // ListJobs lists transcoding jobs with filters.
 func (c *Client) ListJobs(ctx context.Context, req *ListJobsRequest) (*ListJobsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Set("projectSlug", req.ProjectSlug)
 	if req.AssetID != nil {
@@ -68,6 +76,10 @@ func (c *Client) GetStreamingURLs(ctx context.Context, assetID string) (*Streami
 
 // GetThumbnail generates a thumbnail from a video.
 func (c *Client) GetThumbnail(ctx context.Context, req *ThumbnailRequest) (*ThumbnailResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Set("timestamp", strconv.FormatFloat(req.Timestamp, 'f', -1, 64))
 	if req.Width != nil {
@@ -86,6 +98,10 @@ func (c *Client) GetThumbnail(ctx context.Context, req *ThumbnailRequest) (*Thum
 
 // RegenerateThumbnail regenerates a thumbnail for a video.
 func (c *Client) RegenerateThumbnail(ctx context.Context, req *RegenerateThumbnailRequest) (*RegenerateThumbnailResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp RegenerateThumbnailResponse
 	if err := c.http.Post(ctx, "/cdn/video/thumbnail/regenerate", req, &resp); err != nil {
 		return nil, err
@@ -104,6 +120,10 @@ func (c *Client) ListThumbnails(ctx context.Context, assetID string) (*ListThumb
 
 // ExtractAudio extracts audio from a video file.
 func (c *Client) ExtractAudio(ctx context.Context, req *ExtractAudioRequest) (*ExtractAudioResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp ExtractAudioResponse
 	if err := c.http.Post(ctx, "/cdn/video/extract-audio", req, &resp); err != nil {
 		return nil, err
@@ -113,6 +133,10 @@ func (c *Client) ExtractAudio(ctx context.Context, req *ExtractAudioRequest) (*E
 
 // GenerateGif generates an animated GIF from a video segment.
 func (c *Client) GenerateGif(ctx context.Context, req *GenerateGifRequest) (*VideoGif, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp VideoGif
 	if err := c.http.Post(ctx, "/cdn/video/gif", req, &resp); err != nil {
 		return nil, err
@@ -138,8 +162,66 @@ func (c *Client) ListGifs(ctx context.Context, assetID string) ([]VideoGif, erro
 	return resp, nil
 }
 
+// UpdateGif updates a GIF's metadata.
+func (c *Client) UpdateGif(ctx context.Context, req *UpdateGifRequest) (*VideoGif, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp VideoGif
+	if err := c.http.Patch(ctx, "/cdn/video/gif/"+req.GifID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteGif deletes a generated GIF.
+func (c *Client) DeleteGif(ctx context.Context, gifID string) (*SuccessResponse, error) {
+	var resp SuccessResponse
+	if err := c.http.Delete(ctx, "/cdn/video/gif/"+gifID, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpdateThumbnail updates a thumbnail's metadata.
+func (c *Client) UpdateThumbnail(ctx context.Context, req *UpdateThumbnailRequest) (*VideoThumbnail, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp VideoThumbnail
+	if err := c.http.Patch(ctx, "/cdn/video/thumbnail/"+req.ThumbnailID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteThumbnail deletes a generated thumbnail.
+func (c *Client) DeleteThumbnail(ctx context.Context, thumbnailID string) (*SuccessResponse, error) {
+	var resp SuccessResponse
+	if err := c.http.Delete(ctx, "/cdn/video/thumbnail/"+thumbnailID, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetArtifactUsage gets the storage used by GIFs and thumbnails generated
+// from a video asset.
+func (c *Client) GetArtifactUsage(ctx context.Context, assetID string) (*VideoArtifactUsage, error) {
+	var resp VideoArtifactUsage
+	if err := c.http.Get(ctx, "/cdn/video/"+assetID+"/artifacts/usage", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // CreateMergeJob creates a merge job to combine videos/images.
 func (c *Client) CreateMergeJob(ctx context.Context, req *CreateMergeJobRequest) (*MergeJob, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp MergeJob
 	if err := c.http.Post(ctx, "/cdn/video/merge", req, &resp); err != nil {
 		return nil, err
@@ -158,6 +240,10 @@ func (c *Client) GetMergeJob(ctx context.Context, jobID string) (*MergeJobWithOu
 
 // ListMergeJobs lists merge jobs with optional filters.
 func (c *Client) ListMergeJobs(ctx context.Context, req *ListMergeJobsRequest) (*ListMergeJobsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	params.Set("projectSlug", req.ProjectSlug)
 	if req.Status != nil {
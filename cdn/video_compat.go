@@ -0,0 +1,164 @@
+package cdn
+
+import "encoding/json"
+
+// Compatibility shims for the video types that were renamed when the video
+// schema was consolidated. They will be removed in a future major version.
+
+// TranscodingStatus is the previous name of TranscodeJobStatus.
+//
+// Deprecated: Use TranscodeJobStatus.
+type TranscodingStatus = TranscodeJobStatus
+
+// Deprecated: Use the TranscodeJobStatus constants.
+const (
+	TranscodingPending   = TranscodeJobStatusPending
+	TranscodingQueued    = TranscodeJobStatusQueued
+	TranscodingProcess   = TranscodeJobStatusProcessing
+	TranscodingCompleted = TranscodeJobStatusCompleted
+	TranscodingFailed    = TranscodeJobStatusFailed
+	TranscodingCancelled = TranscodeJobStatusCancelled
+)
+
+// MergeInputItem is the previous name of MergeInput.
+//
+// Deprecated: Use MergeInput.
+type MergeInputItem = MergeInput
+
+// MergeJobOutputAsset is the previous shape of MergeJobWithOutput.OutputAsset.
+//
+// Deprecated: MergeJobWithOutput.OutputAsset is now an *Asset. The previous
+// shape is still decoded into MergeJobWithOutput.LegacyOutputAsset.
+type MergeJobOutputAsset struct {
+	ID        string   `json:"id"`
+	CDNURL    string   `json:"cdnUrl"`
+	DirectURL string   `json:"directUrl"`
+	Filename  string   `json:"filename"`
+	Size      int64    `json:"size"`
+	Duration  *float64 `json:"duration,omitempty"`
+}
+
+// NewMergeJobOutputAsset converts an Asset to the previous output asset shape.
+// DirectURL is left empty because Asset does not carry it. It returns nil if
+// asset is nil.
+//
+// Deprecated: Use the Asset directly.
+func NewMergeJobOutputAsset(asset *Asset) *MergeJobOutputAsset {
+	if asset == nil {
+		return nil
+	}
+	return &MergeJobOutputAsset{
+		ID:       asset.ID,
+		CDNURL:   asset.CDNURL,
+		Filename: asset.Filename,
+		Size:     asset.Size,
+		Duration: asset.Duration,
+	}
+}
+
+// The types below keep a deprecated copy of a field next to the current one.
+// Their JSON methods decode the wire field into the current field and mirror
+// it into the deprecated one, and encode the deprecated field only when the
+// current one is unset.
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *StreamingURLs) UnmarshalJSON(data []byte) error {
+	type plain StreamingURLs
+	if err := json.Unmarshal(data, (*plain)(s)); err != nil {
+		return err
+	}
+	s.HLSURL = nil
+	if s.HLS != "" {
+		hls := s.HLS
+		s.HLSURL = &hls
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s StreamingURLs) MarshalJSON() ([]byte, error) {
+	type plain StreamingURLs
+	if s.HLS == "" && s.HLSURL != nil {
+		s.HLS = *s.HLSURL
+	}
+	return json.Marshal(plain(s))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *RegenerateThumbnailResponse) UnmarshalJSON(data []byte) error {
+	type plain RegenerateThumbnailResponse
+	if err := json.Unmarshal(data, (*plain)(r)); err != nil {
+		return err
+	}
+	r.URL = nil
+	if r.ThumbnailURL != "" {
+		url := r.ThumbnailURL
+		r.URL = &url
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r RegenerateThumbnailResponse) MarshalJSON() ([]byte, error) {
+	type plain RegenerateThumbnailResponse
+	if r.ThumbnailURL == "" && r.URL != nil {
+		r.ThumbnailURL = *r.URL
+	}
+	return json.Marshal(plain(r))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (g *VideoGif) UnmarshalJSON(data []byte) error {
+	type plain VideoGif
+	if err := json.Unmarshal(data, (*plain)(g)); err != nil {
+		return err
+	}
+	g.URLPtr = nil
+	if g.URL != "" {
+		url := g.URL
+		g.URLPtr = &url
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (g VideoGif) MarshalJSON() ([]byte, error) {
+	type plain VideoGif
+	if g.URL == "" && g.URLPtr != nil {
+		g.URL = *g.URLPtr
+	}
+	return json.Marshal(plain(g))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The deprecated LegacyOutputAsset
+// is decoded from the wire directly, so it keeps fields such as DirectURL that
+// Asset does not carry.
+func (j *MergeJobWithOutput) UnmarshalJSON(data []byte) error {
+	type plain MergeJobWithOutput
+	if err := json.Unmarshal(data, (*plain)(j)); err != nil {
+		return err
+	}
+	var legacy struct {
+		OutputAsset *MergeJobOutputAsset `json:"outputAsset"`
+	}
+	if err := json.Unmarshal(data, &legacy); err != nil {
+		return err
+	}
+	j.LegacyOutputAsset = legacy.OutputAsset
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (j MergeJobWithOutput) MarshalJSON() ([]byte, error) {
+	type plain MergeJobWithOutput
+	if j.OutputAsset == nil && j.LegacyOutputAsset != nil {
+		j.OutputAsset = &Asset{
+			ID:       j.LegacyOutputAsset.ID,
+			CDNURL:   j.LegacyOutputAsset.CDNURL,
+			Filename: j.LegacyOutputAsset.Filename,
+			Size:     j.LegacyOutputAsset.Size,
+			Duration: j.LegacyOutputAsset.Duration,
+		}
+	}
+	return json.Marshal(plain(j))
+}
@@ -4,11 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
-	"net/http/httptest"
 	"testing"
-	"time"
 
-	"github.com/stack0/sdk-go/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -308,6 +305,110 @@ func TestClient_ListGifs(t *testing.T) {
 	assert.Len(t, resp, 2)
 }
 
+func TestClient_UpdateGif(t *testing.T) {
+	gifID := "gif-123"
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/cdn/video/gif/"+gifID, r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "intro loop", body["name"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(VideoGif{ID: gifID, Name: ptr("intro loop")})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.UpdateGif(context.Background(), &UpdateGifRequest{
+		GifID: gifID,
+		Name:  ptr("intro loop"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "intro loop", *resp.Name)
+}
+
+func TestClient_DeleteGif(t *testing.T) {
+	gifID := "gif-123"
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/cdn/video/gif/"+gifID, r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SuccessResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.DeleteGif(context.Background(), gifID)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestClient_UpdateThumbnail(t *testing.T) {
+	thumbnailID := "thumb-123"
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/cdn/video/thumbnail/"+thumbnailID, r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(VideoThumbnail{ID: thumbnailID, Name: ptr("poster")})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.UpdateThumbnail(context.Background(), &UpdateThumbnailRequest{
+		ThumbnailID: thumbnailID,
+		Name:        ptr("poster"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "poster", *resp.Name)
+}
+
+func TestClient_DeleteThumbnail(t *testing.T) {
+	thumbnailID := "thumb-123"
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/cdn/video/thumbnail/"+thumbnailID, r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SuccessResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.DeleteThumbnail(context.Background(), thumbnailID)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestClient_GetArtifactUsage(t *testing.T) {
+	assetID := "asset-123"
+	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/cdn/video/"+assetID+"/artifacts/usage", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(VideoArtifactUsage{
+			AssetID:        assetID,
+			GifCount:       2,
+			GifBytes:       4096,
+			ThumbnailCount: 3,
+			ThumbnailBytes: 1024,
+			TotalBytes:     5120,
+		})
+	})
+	defer server.Close()
+
+	resp, err := cdnClient.GetArtifactUsage(context.Background(), assetID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.GifCount)
+	assert.Equal(t, int64(5120), resp.TotalBytes)
+}
+
 func TestClient_CreateMergeJob(t *testing.T) {
 	cdnClient, server := setupCDNTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
@@ -397,3 +498,59 @@ func TestClient_CancelMergeJob(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, resp.Success)
 }
+
+func TestVideoCompatShims(t *testing.T) {
+	assert.Equal(t, TranscodeJobStatusProcessing, TranscodingProcess)
+	assert.Equal(t, TranscodeJobStatusCompleted, TranscodingCompleted)
+	assert.True(t, MergeJob{Status: TranscodingCompleted}.Status == MergeJobStatusCompleted)
+
+	var inputs []MergeInputItem = []MergeInput{{AssetID: "asset-1"}}
+	assert.Len(t, inputs, 1)
+
+	var urls StreamingURLs
+	err := json.Unmarshal([]byte(`{"hlsUrl":"https://cdn.example.com/stream/video.m3u8","mp4Urls":[],"thumbnails":[]}`), &urls)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/stream/video.m3u8", urls.HLS)
+	require.NotNil(t, urls.HLSURL)
+	assert.Equal(t, urls.HLS, *urls.HLSURL)
+
+	b, err := json.Marshal(StreamingURLs{HLSURL: ptr("https://cdn.example.com/stream/video.m3u8")})
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"hlsUrl":"https://cdn.example.com/stream/video.m3u8"`)
+
+	var thumb RegenerateThumbnailResponse
+	err = json.Unmarshal([]byte(`{"success":true,"url":"https://cdn.example.com/thumb.jpg"}`), &thumb)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/thumb.jpg", thumb.ThumbnailURL)
+	require.NotNil(t, thumb.URL)
+	assert.Equal(t, thumb.ThumbnailURL, *thumb.URL)
+
+	var gif VideoGif
+	err = json.Unmarshal([]byte(`{"id":"gif-1","url":"https://cdn.example.com/video.gif"}`), &gif)
+	require.NoError(t, err)
+	require.NotNil(t, gif.URLPtr)
+	assert.Equal(t, gif.URL, *gif.URLPtr)
+
+	b, err = json.Marshal(VideoGif{URLPtr: ptr("https://cdn.example.com/video.gif")})
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"url":"https://cdn.example.com/video.gif"`)
+
+	var job MergeJobWithOutput
+	err = json.Unmarshal([]byte(`{"id":"merge-1","outputAsset":{"id":"output-asset","cdnUrl":"https://cdn.example.com/merged.mp4","directUrl":"https://storage.example.com/merged.mp4","filename":"merged.mp4","size":1024}}`), &job)
+	require.NoError(t, err)
+	assert.Equal(t, "merge-1", job.ID)
+	require.NotNil(t, job.OutputAsset)
+	assert.Equal(t, "merged.mp4", job.OutputAsset.Filename)
+	require.NotNil(t, job.LegacyOutputAsset)
+	assert.Equal(t, "https://storage.example.com/merged.mp4", job.LegacyOutputAsset.DirectURL)
+	assert.Equal(t, int64(1024), job.LegacyOutputAsset.Size)
+
+	b, err = json.Marshal(MergeJobWithOutput{LegacyOutputAsset: &MergeJobOutputAsset{ID: "output-asset", Filename: "merged.mp4"}})
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"filename":"merged.mp4"`)
+
+	output := NewMergeJobOutputAsset(&Asset{ID: "output-asset", Filename: "merged.mp4"})
+	require.NotNil(t, output)
+	assert.Equal(t, "merged.mp4", output.Filename)
+	assert.Nil(t, NewMergeJobOutputAsset(nil))
+}
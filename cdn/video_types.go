@@ -26,20 +26,21 @@ const (
 type VideoOutputFormat string
 
 const (
-	VideoOutputHLS VideoOutputFormat = "hls"
-	VideoOutputMP4 VideoOutputFormat = "mp4"
+	VideoOutputHLS  VideoOutputFormat = "hls"
+	VideoOutputDASH VideoOutputFormat = "dash"
+	VideoOutputMP4  VideoOutputFormat = "mp4"
 )
 
-// TranscodingStatus represents the status of a transcoding job.
-type TranscodingStatus string
+// TranscodeJobStatus represents the status of a transcoding job.
+type TranscodeJobStatus string
 
 const (
-	TranscodingPending   TranscodingStatus = "pending"
-	TranscodingQueued    TranscodingStatus = "queued"
-	TranscodingProcess   TranscodingStatus = "processing"
-	TranscodingCompleted TranscodingStatus = "completed"
-	TranscodingFailed    TranscodingStatus = "failed"
-	TranscodingCancelled TranscodingStatus = "cancelled"
+	TranscodeJobStatusPending    TranscodeJobStatus = "pending"
+	TranscodeJobStatusQueued     TranscodeJobStatus = "queued"
+	TranscodeJobStatusProcessing TranscodeJobStatus = "processing"
+	TranscodeJobStatusCompleted  TranscodeJobStatus = "completed"
+	TranscodeJobStatusFailed     TranscodeJobStatus = "failed"
+	TranscodeJobStatusCancelled  TranscodeJobStatus = "cancelled"
 )
 
 // VideoVariant represents a video output variant.
@@ -67,7 +68,7 @@ type TrimOptions struct {
 // TranscodeVideoRequest is the request for transcoding a video.
 type TranscodeVideoRequest struct {
 	ProjectSlug  string            `json:"projectSlug"`
-	AssetID      string            `json:"assetId"`
+	AssetID      string            `json:"assetId" validate:"required"`
 	OutputFormat VideoOutputFormat `json:"outputFormat"`
 	Variants     []VideoVariant    `json:"variants"`
 	Watermark    *WatermarkOptions `json:"watermark,omitempty"`
@@ -76,27 +77,28 @@ type TranscodeVideoRequest struct {
 }
 
 // TranscodeJob represents a video transcoding job.
+// Progress is a percentage from 0 to 100.
 type TranscodeJob struct {
-	ID              string            `json:"id"`
-	AssetID         string            `json:"assetId"`
-	Status          TranscodingStatus `json:"status"`
-	OutputFormat    VideoOutputFormat `json:"outputFormat"`
-	Variants        []VideoVariant    `json:"variants"`
-	Progress        *int              `json:"progress,omitempty"`
-	Error           *string           `json:"error,omitempty"`
-	MediaConvertJob *string           `json:"mediaConvertJobId,omitempty"`
-	CreatedAt       time.Time         `json:"createdAt"`
-	StartedAt       *time.Time        `json:"startedAt,omitempty"`
-	CompletedAt     *time.Time        `json:"completedAt,omitempty"`
+	ID              string             `json:"id"`
+	AssetID         string             `json:"assetId"`
+	Status          TranscodeJobStatus `json:"status"`
+	OutputFormat    VideoOutputFormat  `json:"outputFormat"`
+	Variants        []VideoVariant     `json:"variants"`
+	Progress        int                `json:"progress"`
+	Error           *string            `json:"error,omitempty"`
+	MediaConvertJob *string            `json:"mediaConvertJobId,omitempty"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	StartedAt       *time.Time         `json:"startedAt,omitempty"`
+	CompletedAt     *time.Time         `json:"completedAt,omitempty"`
 }
 
 // ListJobsRequest is the request for listing transcoding jobs.
 type ListJobsRequest struct {
-	ProjectSlug string             `json:"projectSlug"`
-	AssetID     *string            `json:"assetId,omitempty"`
-	Status      *TranscodingStatus `json:"status,omitempty"`
-	Limit       *int               `json:"limit,omitempty"`
-	Offset      *int               `json:"offset,omitempty"`
+	ProjectSlug string              `json:"projectSlug" validate:"required"`
+	AssetID     *string             `json:"assetId,omitempty"`
+	Status      *TranscodeJobStatus `json:"status,omitempty"`
+	Limit       *int                `json:"limit,omitempty"`
+	Offset      *int                `json:"offset,omitempty"`
 }
 
 // ListJobsResponse is the response from listing transcoding jobs.
@@ -121,15 +123,21 @@ type ThumbnailInfo struct {
 }
 
 // StreamingURLs represents the streaming URLs for a video.
+// HLS and DASH are manifest URLs and are empty when the video was not
+// transcoded to that format.
 type StreamingURLs struct {
-	HLSURL     *string         `json:"hlsUrl,omitempty"`
+	HLS        string          `json:"hlsUrl,omitempty"`
+	DASH       string          `json:"dashUrl,omitempty"`
 	MP4URLs    []MP4URL        `json:"mp4Urls"`
 	Thumbnails []ThumbnailInfo `json:"thumbnails"`
+
+	// Deprecated: Use HLS.
+	HLSURL *string `json:"-"`
 }
 
 // ThumbnailRequest is the request for generating a thumbnail.
 type ThumbnailRequest struct {
-	AssetID   string  `json:"assetId"`
+	AssetID   string  `json:"assetId" validate:"required"`
 	Timestamp float64 `json:"timestamp"`
 	Width     *int    `json:"width,omitempty"`
 	Format    *string `json:"format,omitempty"`
@@ -145,7 +153,7 @@ type ThumbnailResponse struct {
 
 // RegenerateThumbnailRequest is the request for regenerating a thumbnail.
 type RegenerateThumbnailRequest struct {
-	AssetID   string  `json:"assetId"`
+	AssetID   string  `json:"assetId" validate:"required"`
 	Timestamp float64 `json:"timestamp"`
 	Width     *int    `json:"width,omitempty"`
 	Format    *string `json:"format,omitempty"`
@@ -153,28 +161,35 @@ type RegenerateThumbnailRequest struct {
 
 // RegenerateThumbnailResponse is the response from regenerating a thumbnail.
 type RegenerateThumbnailResponse struct {
-	ID        *string `json:"id,omitempty"`
-	AssetID   string  `json:"assetId"`
-	Timestamp int     `json:"timestamp"`
-	URL       *string `json:"url,omitempty"`
-	Width     *int    `json:"width,omitempty"`
-	Height    *int    `json:"height,omitempty"`
-	Format    string  `json:"format"`
-	Status    *string `json:"status,omitempty"`
+	Success      bool    `json:"success"`
+	ID           *string `json:"id,omitempty"`
+	AssetID      string  `json:"assetId"`
+	Timestamp    int     `json:"timestamp"`
+	ThumbnailURL string  `json:"url,omitempty"`
+	Width        *int    `json:"width,omitempty"`
+	Height       *int    `json:"height,omitempty"`
+	Format       string  `json:"format"`
+	Status       *string `json:"status,omitempty"`
+
+	// Deprecated: Use ThumbnailURL.
+	URL *string `json:"-"`
 }
 
 // ExtractAudioRequest is the request for extracting audio.
 type ExtractAudioRequest struct {
-	ProjectSlug string  `json:"projectSlug"`
-	AssetID     string  `json:"assetId"`
-	Format      string  `json:"format"`
-	Bitrate     *int    `json:"bitrate,omitempty"`
+	ProjectSlug string `json:"projectSlug"`
+	AssetID     string `json:"assetId" validate:"required"`
+	Format      string `json:"format"`
+	Bitrate     *int   `json:"bitrate,omitempty"`
 }
 
 // ExtractAudioResponse is the response from extracting audio.
+// AudioURL is set once the extraction has completed.
 type ExtractAudioResponse struct {
-	JobID  string            `json:"jobId"`
-	Status TranscodingStatus `json:"status"`
+	Success  bool               `json:"success"`
+	JobID    string             `json:"jobId"`
+	Status   TranscodeJobStatus `json:"status"`
+	AudioURL string             `json:"audioUrl,omitempty"`
 }
 
 // GifStatus represents the status of a GIF generation.
@@ -190,7 +205,7 @@ const (
 // GenerateGifRequest is the request for generating a GIF.
 type GenerateGifRequest struct {
 	ProjectSlug     string   `json:"projectSlug"`
-	AssetID         string   `json:"assetId"`
+	AssetID         string   `json:"assetId" validate:"required"`
 	StartTime       *float64 `json:"startTime,omitempty"`
 	Duration        *float64 `json:"duration,omitempty"`
 	Width           *int     `json:"width,omitempty"`
@@ -202,10 +217,11 @@ type GenerateGifRequest struct {
 type VideoGif struct {
 	ID           string     `json:"id"`
 	AssetID      string     `json:"assetId"`
+	Name         *string    `json:"name,omitempty"`
 	StartTime    float64    `json:"startTime"`
 	Duration     float64    `json:"duration"`
 	FPS          int        `json:"fps"`
-	URL          *string    `json:"url,omitempty"`
+	URL          string     `json:"url,omitempty"`
 	Width        *int       `json:"width,omitempty"`
 	Height       *int       `json:"height,omitempty"`
 	SizeBytes    *int64     `json:"sizeBytes,omitempty"`
@@ -214,22 +230,50 @@ type VideoGif struct {
 	ErrorMessage *string    `json:"errorMessage,omitempty"`
 	CreatedAt    time.Time  `json:"createdAt"`
 	CompletedAt  *time.Time `json:"completedAt,omitempty"`
+
+	// Deprecated: Use URL. URLPtr is nil when URL is empty.
+	URLPtr *string `json:"-"`
 }
 
 // ListGifsRequest is the request for listing GIFs.
 type ListGifsRequest struct {
-	AssetID string `json:"assetId"`
+	AssetID string `json:"assetId" validate:"required"`
 }
 
 // VideoThumbnail represents a video thumbnail.
 type VideoThumbnail struct {
 	ID        string  `json:"id"`
 	AssetID   string  `json:"assetId"`
+	Name      *string `json:"name,omitempty"`
 	Timestamp int     `json:"timestamp"`
 	URL       string  `json:"url"`
 	Width     *int    `json:"width,omitempty"`
 	Height    *int    `json:"height,omitempty"`
 	Format    string  `json:"format"`
+	SizeBytes *int64  `json:"sizeBytes,omitempty"`
+}
+
+// UpdateGifRequest is the request for updating a GIF.
+type UpdateGifRequest struct {
+	GifID string  `json:"-" validate:"required"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// UpdateThumbnailRequest is the request for updating a thumbnail.
+type UpdateThumbnailRequest struct {
+	ThumbnailID string  `json:"-" validate:"required"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// VideoArtifactUsage reports the storage used by the GIFs and thumbnails
+// derived from a video asset.
+type VideoArtifactUsage struct {
+	AssetID        string `json:"assetId"`
+	GifCount       int    `json:"gifCount"`
+	GifBytes       int64  `json:"gifBytes"`
+	ThumbnailCount int    `json:"thumbnailCount"`
+	ThumbnailBytes int64  `json:"thumbnailBytes"`
+	TotalBytes     int64  `json:"totalBytes"`
 }
 
 // ListThumbnailsResponse is the response from listing thumbnails.
@@ -237,6 +281,19 @@ type ListThumbnailsResponse struct {
 	Thumbnails []VideoThumbnail `json:"thumbnails"`
 }
 
+// MergeJobStatus represents the status of a merge job. Merge jobs go through
+// the same statuses as transcoding jobs, so the two types are the same.
+type MergeJobStatus = TranscodeJobStatus
+
+const (
+	MergeJobStatusPending    = TranscodeJobStatusPending
+	MergeJobStatusQueued     = TranscodeJobStatusQueued
+	MergeJobStatusProcessing = TranscodeJobStatusProcessing
+	MergeJobStatusCompleted  = TranscodeJobStatusCompleted
+	MergeJobStatusFailed     = TranscodeJobStatusFailed
+	MergeJobStatusCancelled  = TranscodeJobStatusCancelled
+)
+
 // MergeOutputFormat represents merge output format.
 type MergeOutputFormat string
 
@@ -273,8 +330,8 @@ type TextOverlay struct {
 	Stroke          *TextOverlayStroke `json:"stroke,omitempty"`
 }
 
-// MergeInputItem represents an input item for merge operations.
-type MergeInputItem struct {
+// MergeInput represents an input item for merge operations.
+type MergeInput struct {
 	AssetID     string       `json:"assetId"`
 	Duration    *float64     `json:"duration,omitempty"`
 	StartTime   *float64     `json:"startTime,omitempty"`
@@ -299,27 +356,28 @@ type MergeOutputConfig struct {
 
 // CreateMergeJobRequest is the request for creating a merge job.
 type CreateMergeJobRequest struct {
-	ProjectSlug string             `json:"projectSlug"`
-	Inputs      []MergeInputItem   `json:"inputs"`
+	ProjectSlug string             `json:"projectSlug" validate:"required"`
+	Inputs      []MergeInput       `json:"inputs" validate:"required"`
 	AudioTrack  *AudioTrackInput   `json:"audioTrack,omitempty"`
 	Output      *MergeOutputConfig `json:"output,omitempty"`
 	WebhookURL  *string            `json:"webhookUrl,omitempty"`
 }
 
 // MergeJob represents a video merge job.
+// Progress is a percentage from 0 to 100.
 type MergeJob struct {
 	ID                   string            `json:"id"`
 	OrganizationID       string            `json:"organizationId"`
 	ProjectID            string            `json:"projectId"`
 	Environment          string            `json:"environment"`
-	Inputs               []MergeInputItem  `json:"inputs"`
+	Inputs               []MergeInput      `json:"inputs"`
 	AudioTrackAssetID    *string           `json:"audioTrackAssetId,omitempty"`
 	OutputFormat         MergeOutputFormat `json:"outputFormat"`
 	OutputQuality        VideoQuality      `json:"outputQuality"`
 	OutputFilename       *string           `json:"outputFilename,omitempty"`
 	OutputAssetID        *string           `json:"outputAssetId,omitempty"`
-	Status               TranscodingStatus `json:"status"`
-	Progress             *int              `json:"progress,omitempty"`
+	Status               MergeJobStatus    `json:"status"`
+	Progress             int               `json:"progress"`
 	ErrorMessage         *string           `json:"errorMessage,omitempty"`
 	StartedAt            *time.Time        `json:"startedAt,omitempty"`
 	CompletedAt          *time.Time        `json:"completedAt,omitempty"`
@@ -329,28 +387,22 @@ type MergeJob struct {
 	UpdatedAt            *time.Time        `json:"updatedAt,omitempty"`
 }
 
-// MergeJobOutputAsset represents the output asset from a merge job.
-type MergeJobOutputAsset struct {
-	ID        string   `json:"id"`
-	CDNURL    string   `json:"cdnUrl"`
-	DirectURL string   `json:"directUrl"`
-	Filename  string   `json:"filename"`
-	Size      int64    `json:"size"`
-	Duration  *float64 `json:"duration,omitempty"`
-}
-
 // MergeJobWithOutput represents a merge job with output details.
+// OutputAsset is set once the job has completed.
 type MergeJobWithOutput struct {
 	MergeJob
-	OutputAsset *MergeJobOutputAsset `json:"outputAsset,omitempty"`
+	OutputAsset *Asset `json:"outputAsset,omitempty"`
+
+	// Deprecated: Use OutputAsset.
+	LegacyOutputAsset *MergeJobOutputAsset `json:"-"`
 }
 
 // ListMergeJobsRequest is the request for listing merge jobs.
 type ListMergeJobsRequest struct {
-	ProjectSlug string             `json:"projectSlug"`
-	Status      *TranscodingStatus `json:"status,omitempty"`
-	Limit       *int               `json:"limit,omitempty"`
-	Offset      *int               `json:"offset,omitempty"`
+	ProjectSlug string          `json:"projectSlug" validate:"required"`
+	Status      *MergeJobStatus `json:"status,omitempty"`
+	Limit       *int            `json:"limit,omitempty"`
+	Offset      *int            `json:"offset,omitempty"`
 }
 
 // ListMergeJobsResponse is the response from listing merge jobs.
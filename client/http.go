@@ -8,6 +8,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/stack0/sdk-go/types"
@@ -18,6 +22,16 @@ type HTTPClient struct {
 	apiKey     string
 	baseURL    string
 	httpClient *http.Client
+	*settings
+
+	environment types.Environment
+}
+
+// settings holds the configuration a client shares with the copies made by
+// WithEnvironment.
+type settings struct {
+	flights        *flightGroup
+	skipValidation bool
 }
 
 // New creates a new HTTP client.
@@ -28,17 +42,40 @@ func New(apiKey, baseURL string) *HTTPClient {
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		settings: &settings{},
 	}
 }
 
-// doRequest performs an HTTP request.
+// doRequest performs an HTTP request and returns the response body.
 func (c *HTTPClient) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	resp, err := c.send(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return respBody, nil
+}
+
+// send performs an HTTP request. The caller must close the body of the
+// returned response; error responses are read, closed and returned as a
+// *types.APIError.
+func (c *HTTPClient) send(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
 	var reqBody io.Reader
 	if body != nil {
 		jsonBytes, err := json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
+		if c.environment != "" && hasEnvironmentField(body) {
+			if scoped, ok := withEnvironmentField(jsonBytes, c.environment); ok {
+				jsonBytes = scoped
+			}
+		}
 		reqBody = bytes.NewReader(jsonBytes)
 	}
 
@@ -51,19 +88,53 @@ func (c *HTTPClient) doRequest(ctx context.Context, method, path string, body in
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	return c.do(ctx, req)
+}
 
-	resp, err := c.httpClient.Do(req)
+// sendRaw performs an HTTP request with a body that is sent as-is. path may
+// be an absolute URL, such as a presigned storage URL, which is requested
+// without the API key or the default environment.
+func (c *HTTPClient) sendRaw(ctx context.Context, method, path, contentType string, headers map[string]string, body io.Reader) (*http.Response, error) {
+	target := path
+	absolute := isAbsoluteURL(path)
+	if !absolute {
+		target = c.baseURL + path
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	if !absolute {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	return c.do(ctx, req)
+}
+
+// do sends req. Error responses are read, closed and returned as a
+// *types.APIError.
+func (c *HTTPClient) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
 		var errResp types.ErrorResponse
 		if err := json.Unmarshal(respBody, &errResp); err != nil {
 			errResp.Message = string(respBody)
@@ -73,15 +144,158 @@ func (c *HTTPClient) doRequest(ctx context.Context, method, path string, body in
 			Code:       errResp.Code,
 			Message:    errResp.Message,
 			Response:   errResp,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
 		}
 	}
 
-	return respBody, nil
+	return resp, nil
+}
+
+func isAbsoluteURL(path string) bool {
+	return strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://")
+}
+
+// hasEnvironmentField reports whether body is a struct, or a pointer to one,
+// whose JSON encoding has an environment field: the body of a request that
+// accepts an environment.
+func hasEnvironmentField(body interface{}) bool {
+	t := reflect.TypeOf(body)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for _, f := range types.JSONFields(t) {
+		if f.JSONName == "environment" {
+			return true
+		}
+	}
+	return false
+}
+
+// withEnvironmentField adds an environment field to a JSON object body unless
+// it already has one. It reports false if body is not a JSON object.
+func withEnvironmentField(body []byte, env types.Environment) ([]byte, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		return nil, false
+	}
+	if _, ok := fields["environment"]; ok {
+		return body, true
+	}
+	fields["environment"], _ = json.Marshal(env)
+	scoped, err := json.Marshal(fields)
+	if err != nil {
+		return nil, false
+	}
+	return scoped, true
+}
+
+// parseRetryAfter parses a Retry-After header given either as seconds or as
+// an HTTP date. It returns zero if the header is empty or invalid.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// SetDeduplicateGets enables or disables collapsing identical in-flight GET
+// requests. When enabled, concurrent Gets for the same path share a single
+// HTTP request and each caller decodes the shared response. Call it before
+// the client is used from multiple goroutines.
+func (c *HTTPClient) SetDeduplicateGets(enabled bool) {
+	if enabled {
+		c.flights = &flightGroup{}
+	} else {
+		c.flights = nil
+	}
+}
+
+// WithEnvironment returns a copy of the client that sends env as the
+// environment of requests that accept one, unless the request names one
+// itself. It is added to JSON bodies whose type has an environment field;
+// endpoints that take the environment as a query parameter start their
+// parameters from EnvironmentQuery. The copy shares c's
+// *http.Client, and with it the connection pool, as well as its settings;
+// SetDeduplicateGets and SetValidateRequests on either apply to both.
+func (c *HTTPClient) WithEnvironment(env types.Environment) *HTTPClient {
+	scoped := *c
+	scoped.environment = env
+	return &scoped
+}
+
+// Environment returns the default environment set by WithEnvironment, or ""
+// if requests are sent as given.
+func (c *HTTPClient) Environment() types.Environment {
+	return c.environment
+}
+
+// EnvironmentQuery returns the initial query parameters of a request that
+// accepts an environment query parameter: the default environment set by
+// WithEnvironment, if any. Setting the parameter from the request replaces
+// the default.
+func (c *HTTPClient) EnvironmentQuery() url.Values {
+	params := url.Values{}
+	if c.environment != "" {
+		params.Set("environment", string(c.environment))
+	}
+	return params
+}
+
+// EnvironmentOrDefault returns env if it is set, and otherwise the default
+// environment set by WithEnvironment, which is "" if there is none. It is
+// for request bodies built as maps, which WithEnvironment cannot recognise.
+func (c *HTTPClient) EnvironmentOrDefault(env *types.Environment) types.Environment {
+	if env != nil {
+		return *env
+	}
+	return c.environment
+}
+
+// SetValidateRequests enables or disables client-side request validation.
+// It is enabled by default; disable it to send requests the SDK would reject,
+// for example an enum value added to the API after this SDK was released.
+func (c *HTTPClient) SetValidateRequests(enabled bool) {
+	c.skipValidation = !enabled
+}
+
+// Validate checks req with types.Validate before it is sent, returning a
+// *types.ValidationError for an invalid request. It returns nil if validation
+// is disabled or req is nil.
+func (c *HTTPClient) Validate(req interface{}) error {
+	if c.skipValidation || req == nil {
+		return nil
+	}
+	if rv := reflect.ValueOf(req); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return nil
+	}
+	return types.Validate(req)
 }
 
 // Get performs a GET request.
 func (c *HTTPClient) Get(ctx context.Context, path string, result interface{}) error {
-	respBody, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	var respBody []byte
+	var err error
+	if c.flights != nil {
+		respBody, err = c.flights.do(ctx, http.MethodGet+" "+path, func(ctx context.Context) ([]byte, error) {
+			return c.doRequest(ctx, http.MethodGet, path, nil)
+		})
+	} else {
+		respBody, err = c.doRequest(ctx, http.MethodGet, path, nil)
+	}
 	if err != nil {
 		return err
 	}
@@ -151,6 +365,48 @@ func (c *HTTPClient) DeleteWithBody(ctx context.Context, path string, body, resu
 	return nil
 }
 
+// Download performs a GET request and copies the raw response body to w
+// instead of decoding it, so large files are not held in memory. It returns
+// the number of bytes written. path may also be an absolute URL, such as a
+// CDN or presigned URL returned by the API; it is requested without the API
+// key.
+func (c *HTTPClient) Download(ctx context.Context, path string, w io.Writer) (int64, error) {
+	resp, err := c.sendRaw(ctx, http.MethodGet, path, "", nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		if ctx.Err() != nil {
+			return n, ctx.Err()
+		}
+		return n, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return n, nil
+}
+
+// Upload performs a PUT request that sends body as-is with the given content
+// type and additional headers, such as the headers returned with a presigned
+// upload URL. Like Download, it accepts an absolute URL, which is requested
+// without the API key.
+func (c *HTTPClient) Upload(ctx context.Context, path, contentType string, headers map[string]string, body io.Reader) error {
+	resp, err := c.sendRaw(ctx, http.MethodPut, path, contentType, headers, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	return nil
+}
+
 // BaseURL returns the base URL of the client.
 func (c *HTTPClient) BaseURL() string {
 	return c.baseURL
@@ -1,10 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stack0/sdk-go/types"
@@ -118,3 +121,149 @@ func TestHTTPClient_DeleteWithBody(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, result.Success)
 }
+
+type validatedRequest struct {
+	Name string `json:"name" validate:"required"`
+}
+
+func TestHTTPClient_Validate(t *testing.T) {
+	c := New("test-api-key", "http://localhost")
+
+	err := c.Validate(&validatedRequest{})
+	assert.ErrorIs(t, err, types.ErrInvalidRequest)
+	assert.NoError(t, c.Validate(&validatedRequest{Name: "a"}))
+	assert.NoError(t, c.Validate((*validatedRequest)(nil)))
+	assert.NoError(t, c.Validate(nil))
+	assert.NoError(t, c.Validate(map[string]interface{}{}))
+
+	c.SetValidateRequests(false)
+	assert.NoError(t, c.Validate(&validatedRequest{}))
+}
+
+type environmentBody struct {
+	Key         string             `json:"key"`
+	Environment *types.Environment `json:"environment,omitempty"`
+}
+
+type plainBody struct {
+	Key string `json:"key"`
+}
+
+func TestHTTPClient_WithEnvironment(t *testing.T) {
+	var queries []string
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	base := New("test-api-key", server.URL)
+	sandbox := base.WithEnvironment(types.EnvironmentSandbox)
+	ctx := context.Background()
+	production := types.EnvironmentProduction
+
+	require.NoError(t, sandbox.Post(ctx, "/mail/send/batch", &environmentBody{Key: "value"}, nil))
+	require.NoError(t, sandbox.Post(ctx, "/mail/send/batch", environmentBody{Environment: &production}, nil))
+	require.NoError(t, sandbox.Post(ctx, "/mail/webhooks/wh-1/rotate-secret", &plainBody{}, nil))
+	require.NoError(t, sandbox.Post(ctx, "/mail/webhooks/wh-1/rotate-secret", map[string]string{}, nil))
+	require.NoError(t, base.Post(ctx, "/mail/send/batch", &environmentBody{}, nil))
+	require.NoError(t, sandbox.Get(ctx, "/mail/templates/tpl-1", nil))
+
+	assert.Equal(t, "sandbox", bodies[0]["environment"])
+	assert.Equal(t, "value", bodies[0]["key"])
+	assert.Equal(t, "production", bodies[1]["environment"])
+	assert.NotContains(t, bodies[2], "environment")
+	assert.NotContains(t, bodies[3], "environment")
+	assert.NotContains(t, bodies[4], "environment")
+	assert.Equal(t, "", queries[5])
+
+	assert.Equal(t, types.EnvironmentSandbox, sandbox.Environment())
+	assert.Equal(t, types.Environment(""), base.Environment())
+	base.SetValidateRequests(false)
+	assert.True(t, sandbox.skipValidation)
+	assert.Same(t, base.httpClient, sandbox.httpClient)
+}
+
+func TestHTTPClient_EnvironmentQuery(t *testing.T) {
+	base := New("test-api-key", "https://api.example.com")
+	sandbox := base.WithEnvironment(types.EnvironmentSandbox)
+
+	assert.Empty(t, base.EnvironmentQuery())
+	assert.Equal(t, "environment=sandbox", sandbox.EnvironmentQuery().Encode())
+
+	params := sandbox.EnvironmentQuery()
+	params.Set("environment", "production")
+	assert.Equal(t, "environment=production", params.Encode())
+
+	production := types.EnvironmentProduction
+	assert.Equal(t, types.EnvironmentProduction, sandbox.EnvironmentOrDefault(&production))
+	assert.Equal(t, types.EnvironmentSandbox, sandbox.EnvironmentOrDefault(nil))
+	assert.Equal(t, types.Environment(""), base.EnvironmentOrDefault(nil))
+}
+
+func TestHTTPClient_Download(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "Bearer test-api-key", r.Header.Get("Authorization"))
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"code": "NOT_FOUND", "message": "not found"})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("raw content"))
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", server.URL)
+
+	var buf bytes.Buffer
+	n, err := client.Download(context.Background(), "/file", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), n)
+	assert.Equal(t, "raw content", buf.String())
+
+	_, err = client.Download(context.Background(), "/missing", &buf)
+	var apiErr *types.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "NOT_FOUND", apiErr.Code)
+}
+
+func TestHTTPClient_Upload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/upload/asset-1", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		assert.Equal(t, "image/png", r.Header.Get("Content-Type"))
+		assert.Equal(t, "AES256", r.Header.Get("X-Amz-Server-Side-Encryption"))
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "png-bytes", string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", "https://api.example.com")
+
+	err := client.Upload(context.Background(), server.URL+"/upload/asset-1", "image/png",
+		map[string]string{"X-Amz-Server-Side-Encryption": "AES256"}, strings.NewReader("png-bytes"))
+	require.NoError(t, err)
+}
+
+func TestHTTPClient_Upload_TruncatedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "100")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("partial"))
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", "https://api.example.com")
+
+	err := client.Upload(context.Background(), server.URL+"/upload/asset-1", "image/png", nil, strings.NewReader("png-bytes"))
+	require.Error(t, err)
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// DefaultMaxTransientErrors is the number of consecutive transient errors
+// Poll tolerates when PollOptions.MaxTransientErrors is zero.
+const DefaultMaxTransientErrors = 5
+
+// PollOptions configure Poll. TimeoutMessage is used for the
+// *types.TimeoutError returned when Timeout elapses.
+//
+// When MaxInterval is greater than Interval, the wait between checks doubles
+// after each check, starting from Interval, up to MaxInterval. Otherwise
+// checks are Interval apart.
+//
+// MaxTransientErrors bounds how many consecutive rate-limit (429) or server
+// (5xx) errors are retried before the error is returned; successful checks
+// reset the count. A negative value disables retrying.
+type PollOptions struct {
+	Interval           time.Duration
+	MaxInterval        time.Duration
+	Timeout            time.Duration
+	TimeoutMessage     string
+	MaxTransientErrors int
+}
+
+// Poll calls check every Interval, backing off towards MaxInterval, until it
+// reports done, returns a non-transient error, or Timeout elapses. After a
+// transient API error the next check waits for the error's RetryAfter if that
+// is longer than the current interval.
+func Poll[T any](ctx context.Context, opts PollOptions, check func(ctx context.Context) (T, bool, error)) (T, error) {
+	var zero T
+	maxTransient := opts.MaxTransientErrors
+	if maxTransient == 0 {
+		maxTransient = DefaultMaxTransientErrors
+	}
+
+	startTime := time.Now()
+	transient := 0
+	interval := opts.Interval
+	for time.Since(startTime) < opts.Timeout {
+		wait := interval
+		if interval < opts.MaxInterval {
+			interval *= 2
+			if interval > opts.MaxInterval {
+				interval = opts.MaxInterval
+			}
+		}
+
+		result, done, err := check(ctx)
+		if err != nil {
+			var apiErr *types.APIError
+			if !errors.As(err, &apiErr) || !apiErr.Transient() || transient >= maxTransient {
+				return zero, err
+			}
+			transient++
+			if apiErr.RetryAfter > wait {
+				wait = apiErr.RetryAfter
+			}
+		} else {
+			if done {
+				return result, nil
+			}
+			transient = 0
+		}
+
+		if remaining := opts.Timeout - time.Since(startTime); wait > remaining {
+			wait = remaining
+		}
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+
+	return zero, types.NewTimeoutError(opts.TimeoutMessage)
+}
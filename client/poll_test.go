@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 3*time.Second, parseRetryAfter("3", now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter(now.Add(90*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now))
+}
+
+func TestHTTPClient_ErrorResponse_RetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "2")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message":"Too many requests","code":"RATE_LIMITED"}`))
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	err := New("test-api-key", server.URL).Get(context.Background(), "/test", &result)
+
+	var apiErr *types.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 2*time.Second, apiErr.RetryAfter)
+	assert.True(t, apiErr.Transient())
+}
+
+func TestPoll_RetriesTransientErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"slow down"}`))
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"message":"unavailable"}`))
+		default:
+			w.Write([]byte(`{"status":"completed"}`))
+		}
+	}))
+	defer server.Close()
+	httpClient := New("test-api-key", server.URL)
+
+	result, err := Poll(context.Background(), PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	}, func(ctx context.Context) (string, bool, error) {
+		var resp struct{ Status string }
+		if err := httpClient.Get(ctx, "/job", &resp); err != nil {
+			return "", false, err
+		}
+		return resp.Status, resp.Status == "completed", nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "completed", result)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestPoll_HonoursRetryAfter(t *testing.T) {
+	var attempts []time.Time
+	_, err := Poll(context.Background(), PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	}, func(ctx context.Context) (int, bool, error) {
+		attempts = append(attempts, time.Now())
+		if len(attempts) == 1 {
+			return 0, false, &types.APIError{StatusCode: 429, RetryAfter: 50 * time.Millisecond}
+		}
+		return 1, true, nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	assert.GreaterOrEqual(t, attempts[1].Sub(attempts[0]), 50*time.Millisecond)
+}
+
+func TestPoll_Backoff(t *testing.T) {
+	var attempts []time.Time
+	_, err := Poll(context.Background(), PollOptions{
+		Interval:    10 * time.Millisecond,
+		MaxInterval: 40 * time.Millisecond,
+		Timeout:     time.Second,
+	}, func(ctx context.Context) (int, bool, error) {
+		attempts = append(attempts, time.Now())
+		return 1, len(attempts) == 5, nil
+	})
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 5)
+	for i, want := range []time.Duration{10, 20, 40, 40} {
+		gap := attempts[i+1].Sub(attempts[i])
+		assert.GreaterOrEqual(t, gap, want*time.Millisecond, "wait %d", i)
+	}
+	// The interval is capped at MaxInterval rather than doubling to 80ms.
+	assert.Less(t, attempts[4].Sub(attempts[3]), 80*time.Millisecond)
+}
+
+func TestPoll_GivesUpAfterMaxTransientErrors(t *testing.T) {
+	calls := 0
+	_, err := Poll(context.Background(), PollOptions{
+		Interval:           time.Millisecond,
+		Timeout:            time.Second,
+		MaxTransientErrors: 2,
+	}, func(ctx context.Context) (int, bool, error) {
+		calls++
+		return 0, false, &types.APIError{StatusCode: 502, Message: "bad gateway"}
+	})
+
+	var apiErr *types.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 502, apiErr.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestPoll_ReturnsNonTransientErrors(t *testing.T) {
+	calls := 0
+	_, err := Poll(context.Background(), PollOptions{
+		Interval: time.Millisecond,
+		Timeout:  time.Second,
+	}, func(ctx context.Context) (int, bool, error) {
+		calls++
+		return 0, false, &types.APIError{StatusCode: 404, Message: "not found"}
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestPoll_Timeout(t *testing.T) {
+	_, err := Poll(context.Background(), PollOptions{
+		Interval:       time.Millisecond,
+		Timeout:        20 * time.Millisecond,
+		TimeoutMessage: "Job timed out",
+	}, func(ctx context.Context) (int, bool, error) {
+		return 0, false, nil
+	})
+
+	var timeoutErr *types.TimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, "Job timed out", timeoutErr.Message)
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Layouts of times in query parameters. Times are encoded as RFC 3339 in UTC,
+// so the value never carries a "+hh:mm" offset, which some servers and proxies
+// decode as a space. Date-only parameters use QueryDateLayout.
+const (
+	QueryTimeLayout = time.RFC3339
+	QueryDateLayout = "2006-01-02"
+)
+
+// JoinExpand joins relation names into the comma-separated value of an
+// expand query parameter.
+func JoinExpand[T ~string](expand []T) string {
+	return joinNames(expand)
+}
+
+// WithExpand appends an expand query parameter listing the given relations to
+// path, preserving any existing query string. Path is returned unchanged when
+// expand is empty.
+func WithExpand[T ~string](path string, expand []T) string {
+	return withListParam(path, "expand", expand)
+}
+
+// JoinFields joins field names into the comma-separated value of a fields
+// query parameter, which limits a response to the named fields.
+func JoinFields[T ~string](fields []T) string {
+	return joinNames(fields)
+}
+
+// WithFields appends a fields query parameter to path like WithExpand.
+func WithFields[T ~string](path string, fields []T) string {
+	return withListParam(path, "fields", fields)
+}
+
+// SetMetadataFilter adds a metadata[key]=value query parameter to params for
+// each entry of filter. List endpoints return only resources whose metadata
+// has every given value.
+func SetMetadataFilter(params url.Values, filter map[string]string) {
+	for key, value := range filter {
+		params.Set("metadata["+key+"]", value)
+	}
+}
+
+// FormatTime formats t for a query parameter, e.g. "2024-03-05T13:30:00Z".
+// Fractional seconds are dropped.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(QueryTimeLayout)
+}
+
+// FormatDate formats the calendar date of t in its own location for a
+// date-only query parameter, e.g. "2024-03-05".
+func FormatDate(t time.Time) string {
+	return t.Format(QueryDateLayout)
+}
+
+// SetTime sets the query parameter key to t formatted with FormatTime, unless
+// t is nil.
+func SetTime(params url.Values, key string, t *time.Time) {
+	if t != nil {
+		params.Set(key, FormatTime(*t))
+	}
+}
+
+// SetDate sets the query parameter key to t formatted with FormatDate, unless
+// t is nil.
+func SetDate(params url.Values, key string, t *time.Time) {
+	if t != nil {
+		params.Set(key, FormatDate(*t))
+	}
+}
+
+func joinNames[T ~string](values []T) string {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = string(v)
+	}
+	return strings.Join(names, ",")
+}
+
+func withListParam[T ~string](path, key string, values []T) string {
+	if len(values) == 0 {
+		return path
+	}
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + url.Values{key: {joinNames(values)}}.Encode()
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testExpand string
+
+func TestJoinExpand(t *testing.T) {
+	assert.Equal(t, "", JoinExpand([]testExpand{}))
+	assert.Equal(t, "audience,template", JoinExpand([]testExpand{"audience", "template"}))
+}
+
+func TestWithExpand(t *testing.T) {
+	t.Run("no relations", func(t *testing.T) {
+		assert.Equal(t, "/mail/campaigns/c1", WithExpand[testExpand]("/mail/campaigns/c1", nil))
+	})
+
+	t.Run("without query", func(t *testing.T) {
+		assert.Equal(t, "/mail/campaigns/c1?expand=audience%2Ctemplate",
+			WithExpand("/mail/campaigns/c1", []testExpand{"audience", "template"}))
+	})
+
+	t.Run("with existing query", func(t *testing.T) {
+		assert.Equal(t, "/cdn/assets/a1?projectSlug=p&expand=transcodeJobs",
+			WithExpand("/cdn/assets/a1?projectSlug=p", []string{"transcodeJobs"}))
+	})
+}
+
+func TestWithFields(t *testing.T) {
+	assert.Equal(t, "/mail/templates/t1", WithFields[testExpand]("/mail/templates/t1", nil))
+	assert.Equal(t, "/mail/templates/t1?fields=id%2Cname",
+		WithFields("/mail/templates/t1", []testExpand{"id", "name"}))
+	assert.Equal(t, "id,name", JoinFields([]string{"id", "name"}))
+}
+
+func TestSetMetadataFilter(t *testing.T) {
+	params := url.Values{}
+	SetMetadataFilter(params, map[string]string{"orderId": "ord_123", "region": "eu"})
+
+	assert.Equal(t, "ord_123", params.Get("metadata[orderId]"))
+	assert.Equal(t, "eu", params.Get("metadata[region]"))
+	assert.Equal(t, "metadata%5BorderId%5D=ord_123&metadata%5Bregion%5D=eu", params.Encode())
+
+	empty := url.Values{}
+	SetMetadataFilter(empty, nil)
+	assert.Empty(t, empty)
+}
+
+func TestFormatTime(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+	tm := time.Date(2024, 3, 5, 0, 30, 15, 500, berlin)
+
+	assert.Equal(t, "2024-03-04T23:30:15Z", FormatTime(tm))
+	assert.Equal(t, "2024-03-05", FormatDate(tm))
+}
+
+func TestSetTime(t *testing.T) {
+	tm := time.Date(2024, 3, 5, 12, 0, 0, 0, time.FixedZone("EST", -5*3600))
+	params := url.Values{}
+
+	SetTime(params, "startDate", &tm)
+	SetTime(params, "endDate", nil)
+	SetDate(params, "day", &tm)
+
+	assert.Equal(t, "day=2024-03-05&startDate=2024-03-05T17%3A00%3A00Z", params.Encode())
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// flightGroup collapses concurrent requests with the same key into a single
+// request whose response is shared by every caller.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// do runs fn for key unless a call for key is already in flight, in which
+// case it waits for that call and returns its result. A waiter whose own
+// context is still live retries when the shared call was cancelled by its
+// initiator's context.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(context.Context) ([]byte, error)) ([]byte, error) {
+	g.mu.Lock()
+	if f, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if isContextError(f.err) && ctx.Err() == nil {
+			return g.do(ctx, key, fn)
+		}
+		return f.body, f.err
+	}
+
+	f := &flight{done: make(chan struct{})}
+	if g.calls == nil {
+		g.calls = make(map[string]*flight)
+	}
+	g.calls[key] = f
+	g.mu.Unlock()
+
+	f.body, f.err = fn(ctx)
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+	close(f.done)
+
+	return f.body, f.err
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// joinDelay gives goroutines that are about to call flightGroup.do time to
+// join the call in flight, as waiting callers can't be observed directly.
+const joinDelay = 50 * time.Millisecond
+
+func TestHTTPClient_DeduplicateGets(t *testing.T) {
+	var hits int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			close(started)
+		}
+		<-release
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]string{"id": "tpl-1"})
+	}))
+	defer server.Close()
+
+	c := New("test-api-key", server.URL)
+	c.SetDeduplicateGets(true)
+
+	const callers = 10
+	results := make([]map[string]string, callers)
+	errs := make([]error, callers)
+	var wg, ready sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		ready.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ready.Done()
+			errs[i] = c.Get(context.Background(), "/mail/templates/tpl-1", &results[i])
+		}(i)
+	}
+
+	<-started
+	ready.Wait()
+	time.Sleep(joinDelay)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "tpl-1", results[i]["id"])
+	}
+}
+
+func TestHTTPClient_DeduplicateGets_Disabled(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]string{})
+	}))
+	defer server.Close()
+
+	c := New("test-api-key", server.URL)
+	c.SetDeduplicateGets(true)
+	c.SetDeduplicateGets(false)
+
+	require.NoError(t, c.Get(context.Background(), "/a", nil))
+	require.NoError(t, c.Get(context.Background(), "/a", nil))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+	assert.Nil(t, c.flights)
+}
+
+func TestFlightGroup_WaiterRetriesAfterInitiatorCancel(t *testing.T) {
+	g := &flightGroup{}
+	started := make(chan struct{})
+	var calls int32
+
+	fn := func(ctx context.Context) ([]byte, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return []byte("ok"), nil
+	}
+
+	initiatorCtx, cancel := context.WithCancel(context.Background())
+	initiatorErr := make(chan error, 1)
+	go func() {
+		_, err := g.do(initiatorCtx, "k", fn)
+		initiatorErr <- err
+	}()
+	<-started
+
+	waiterBody := make(chan []byte, 1)
+	go func() {
+		body, _ := g.do(context.Background(), "k", fn)
+		waiterBody <- body
+	}()
+	time.Sleep(joinDelay)
+	cancel()
+
+	assert.ErrorIs(t, <-initiatorErr, context.Canceled)
+	assert.Equal(t, []byte("ok"), <-waiterBody)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
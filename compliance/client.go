@@ -0,0 +1,29 @@
+package compliance
+
+import (
+	"context"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// Client provides data-processing and subprocessor information for vendor
+// and compliance reviews.
+type Client struct {
+	http *client.HTTPClient
+}
+
+// NewClient creates a new compliance client.
+func NewClient(http *client.HTTPClient) *Client {
+	return &Client{http: http}
+}
+
+// GetDataProcessing retrieves the current data-processing metadata: regions
+// and retention defaults per product, and the subprocessor list with its
+// version.
+func (c *Client) GetDataProcessing(ctx context.Context) (*DataProcessingInfo, error) {
+	var resp DataProcessingInfo
+	if err := c.http.Get(ctx, "/compliance/data-processing", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
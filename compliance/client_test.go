@@ -0,0 +1,60 @@
+package compliance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupComplianceTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewClient(httpClient), server
+}
+
+func TestClient_GetDataProcessing(t *testing.T) {
+	complianceClient, server := setupComplianceTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/compliance/data-processing", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DataProcessingInfo{
+			DPAVersion:                "2026-01",
+			DPAURL:                    "https://stack0.dev/legal/dpa",
+			SubprocessorListVersion:   "14",
+			SubprocessorListUpdatedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			Products: []ProductDataProcessing{
+				{
+					Product:        ProductMail,
+					Regions:        []string{"us-east-1", "eu-west-1"},
+					DataCategories: []string{"email addresses", "message content"},
+					Retention: []RetentionDefault{
+						{DataType: "message_bodies", Days: 30, Configurable: true},
+						{DataType: "contacts", Days: 0},
+					},
+				},
+			},
+			Subprocessors: []Subprocessor{
+				{Name: "Amazon Web Services", Purpose: "Hosting", Location: "United States", Products: []Product{ProductMail, ProductCDN}},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := complianceClient.GetDataProcessing(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "14", resp.SubprocessorListVersion)
+	require.Len(t, resp.Products, 1)
+	assert.Equal(t, []string{"us-east-1", "eu-west-1"}, resp.Products[0].Regions)
+	assert.Equal(t, 30, resp.Products[0].Retention[0].Days)
+	assert.True(t, resp.Products[0].Retention[0].Configurable)
+	assert.Equal(t, []Product{ProductMail, ProductCDN}, resp.Subprocessors[0].Products)
+}
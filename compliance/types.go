@@ -0,0 +1,53 @@
+package compliance
+
+import "time"
+
+// Product identifies a Stack0 product for data-processing purposes.
+type Product string
+
+const (
+	ProductMail        Product = "mail"
+	ProductCDN         Product = "cdn"
+	ProductScreenshots Product = "screenshots"
+	ProductExtraction  Product = "extraction"
+)
+
+// RetentionDefault is the default retention period for one kind of data.
+// Days is zero when the data is kept until deleted. Configurable reports
+// whether the organization can change the period.
+type RetentionDefault struct {
+	DataType     string `json:"dataType"`
+	Days         int    `json:"days"`
+	Configurable bool   `json:"configurable"`
+}
+
+// ProductDataProcessing describes where and how a product processes data.
+type ProductDataProcessing struct {
+	Product        Product            `json:"product"`
+	Regions        []string           `json:"regions"`
+	DataCategories []string           `json:"dataCategories"`
+	Retention      []RetentionDefault `json:"retention"`
+}
+
+// Subprocessor is a third party that processes customer data on Stack0's
+// behalf.
+type Subprocessor struct {
+	Name     string    `json:"name"`
+	Purpose  string    `json:"purpose"`
+	Location string    `json:"location"`
+	Products []Product `json:"products"`
+	URL      *string   `json:"url,omitempty"`
+}
+
+// DataProcessingInfo is the current data-processing metadata for the
+// organization. SubprocessorListVersion changes whenever the subprocessor
+// list does, so tooling can compare it against the last version reviewed.
+type DataProcessingInfo struct {
+	DPAVersion                string                  `json:"dpaVersion"`
+	DPAURL                    string                  `json:"dpaUrl"`
+	SubprocessorListVersion   string                  `json:"subprocessorListVersion"`
+	SubprocessorListUpdatedAt time.Time               `json:"subprocessorListUpdatedAt"`
+	Products                  []ProductDataProcessing `json:"products"`
+	Subprocessors             []Subprocessor          `json:"subprocessors"`
+	GeneratedAt               time.Time               `json:"generatedAt"`
+}
@@ -0,0 +1,606 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/stack0/sdk-go/mail"
+)
+
+// Kind is a kind of resource managed by a Spec.
+type Kind string
+
+const (
+	KindDomain   Kind = "domain"
+	KindTemplate Kind = "template"
+	KindAudience Kind = "audience"
+	KindSequence Kind = "sequence"
+)
+
+// Action is what applying a Change does to a resource.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Change is one step of a Plan. Key is the resource's key in the Spec and ID
+// the live resource's ID, empty for creates. For updates, Fields lists the
+// fields that differ, by their API names.
+type Change struct {
+	Kind   Kind
+	Action Action
+	Key    string
+	ID     string
+	Fields []string
+
+	apply func(ctx context.Context) error
+}
+
+// String formats the change as a line of a diff, such as
+// "~ template welcome (subject, html)".
+func (c Change) String() string {
+	var b strings.Builder
+	switch c.Action {
+	case ActionCreate:
+		b.WriteString("+ ")
+	case ActionUpdate:
+		b.WriteString("~ ")
+	case ActionDelete:
+		b.WriteString("- ")
+	}
+	b.WriteString(string(c.Kind) + " " + c.Key)
+	if len(c.Fields) > 0 {
+		b.WriteString(" (" + strings.Join(c.Fields, ", ") + ")")
+	}
+	return b.String()
+}
+
+// Plan is the list of changes that brings a project in line with a Spec, in
+// the order Apply makes them: creates and updates of domains, templates,
+// audiences and sequences, then deletes in the reverse order so nothing is
+// deleted while still referenced.
+type Plan struct {
+	Changes []Change
+}
+
+// Empty reports whether the project already matches the Spec.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String formats the plan as a diff, one change per line.
+func (p *Plan) String() string {
+	if p.Empty() {
+		return "No changes.\n"
+	}
+	var b strings.Builder
+	for _, c := range p.Changes {
+		b.WriteString(c.String() + "\n")
+	}
+	return b.String()
+}
+
+// PlanOptions configures Reconciler.Plan.
+type PlanOptions struct {
+	// Prune deletes live resources that are not in the Spec. Only kinds with
+	// at least one entry in the Spec are pruned, so a Spec that lists no
+	// domains leaves the project's domains alone.
+	Prune bool
+}
+
+// ApplyError is returned by Apply when a change fails. Changes before it
+// were applied; Applied counts them.
+type ApplyError struct {
+	Change  Change
+	Applied int
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *ApplyError) Error() string {
+	return fmt.Sprintf("config: %s %s %q: %v", e.Change.Action, e.Change.Kind, e.Change.Key, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ApplyError) Unwrap() error {
+	return e.Err
+}
+
+// Reconciler compares Specs with a live project and applies the difference.
+type Reconciler struct {
+	mail *mail.Client
+}
+
+// NewReconciler creates a Reconciler that manages resources through m.
+func NewReconciler(m *mail.Client) *Reconciler {
+	return &Reconciler{mail: m}
+}
+
+// Plan validates spec, reads the live project and returns the changes that
+// would bring it in line with spec. Nothing is changed until the plan is
+// passed to Apply.
+func (r *Reconciler) Plan(ctx context.Context, spec *Spec, opts *PlanOptions) (*Plan, error) {
+	if spec == nil {
+		return nil, errors.New("config: spec is required")
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	prune := opts != nil && opts.Prune
+
+	var changes, deletes []Change
+	for _, plan := range []func(context.Context, *Spec, bool) ([]Change, []Change, error){
+		r.planDomains,
+		r.planTemplates,
+		r.planAudiences,
+		r.planSequences,
+	} {
+		c, d, err := plan(ctx, spec, prune)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c...)
+		deletes = append(d, deletes...)
+	}
+	return &Plan{Changes: append(changes, deletes...)}, nil
+}
+
+// Apply makes a plan's changes in order, stopping at the first that fails
+// with an *ApplyError. Changes that were applied are not rolled back; plan and
+// apply again once the cause is fixed.
+func (r *Reconciler) Apply(ctx context.Context, plan *Plan) error {
+	for i, c := range plan.Changes {
+		if err := c.apply(ctx); err != nil {
+			return &ApplyError{Change: c, Applied: i, Err: err}
+		}
+	}
+	return nil
+}
+
+// Reconcile plans and applies spec in one step, returning the plan that was
+// applied.
+func (r *Reconciler) Reconcile(ctx context.Context, spec *Spec, opts *PlanOptions) (*Plan, error) {
+	plan, err := r.Plan(ctx, spec, opts)
+	if err != nil {
+		return nil, err
+	}
+	return plan, r.Apply(ctx, plan)
+}
+
+func (r *Reconciler) planDomains(ctx context.Context, spec *Spec, prune bool) ([]Change, []Change, error) {
+	if len(spec.Domains) == 0 {
+		return nil, nil, nil
+	}
+	live, err := r.mail.Domains.List(ctx, &mail.ListDomainsRequest{ProjectSlug: spec.ProjectSlug, Environment: spec.Environment})
+	if err != nil {
+		return nil, nil, err
+	}
+	byName := make(map[string]mail.Domain, len(live))
+	for _, d := range live {
+		byName[d.Domain] = d
+	}
+
+	var changes []Change
+	for _, want := range spec.Domains {
+		want := want
+		have, ok := byName[want.Domain]
+		if !ok {
+			changes = append(changes, Change{Kind: KindDomain, Action: ActionCreate, Key: want.Domain, apply: func(ctx context.Context) error {
+				added, err := r.mail.Domains.Add(ctx, &mail.AddDomainRequest{
+					ProjectSlug: &spec.ProjectSlug,
+					Environment: spec.Environment,
+					Domain:      want.Domain,
+				})
+				if err != nil {
+					return err
+				}
+				update := domainUpdate(want, nil)
+				if update == nil {
+					return nil
+				}
+				if added.Domain == nil {
+					return errors.New("domain was added but its ID was not returned")
+				}
+				update.ID = added.Domain.ID
+				_, err = r.mail.Domains.Update(ctx, update)
+				return err
+			}})
+			continue
+		}
+
+		var d differ
+		d.subdomain("returnPathSubdomain", want.ReturnPathSubdomain, have.ReturnPathDomain, want.Domain)
+		d.subdomain("trackingSubdomain", want.TrackingSubdomain, have.TrackingDomain, want.Domain)
+		d.boolean("openTracking", want.OpenTracking, have.OpenTracking)
+		d.boolean("clickTracking", want.ClickTracking, have.ClickTracking)
+		if len(d.fields) == 0 {
+			continue
+		}
+		update := domainUpdate(want, d.fields)
+		update.ID = have.ID
+		changes = append(changes, Change{Kind: KindDomain, Action: ActionUpdate, Key: want.Domain, ID: have.ID, Fields: d.fields, apply: func(ctx context.Context) error {
+			_, err := r.mail.Domains.Update(ctx, update)
+			return err
+		}})
+	}
+
+	var deletes []Change
+	if prune {
+		for _, have := range live {
+			if !slices.ContainsFunc(spec.Domains, func(d DomainSpec) bool { return d.Domain == have.Domain }) {
+				id := have.ID
+				deletes = append(deletes, Change{Kind: KindDomain, Action: ActionDelete, Key: have.Domain, ID: id, apply: func(ctx context.Context) error {
+					_, err := r.mail.Domains.Delete(ctx, id)
+					return err
+				}})
+			}
+		}
+	}
+	return changes, sortByKey(deletes), nil
+}
+
+// domainUpdate returns the update setting want's fields that are named in
+// fields, or every field want manages when fields is nil. It returns nil if
+// there is nothing to set.
+func domainUpdate(want DomainSpec, fields []string) *mail.UpdateDomainRequest {
+	set := func(name string, managed bool) bool {
+		return managed && (fields == nil || slices.Contains(fields, name))
+	}
+	var req mail.UpdateDomainRequest
+	changed := false
+	if set("returnPathSubdomain", want.ReturnPathSubdomain != nil) {
+		req.ReturnPathSubdomain, changed = want.ReturnPathSubdomain, true
+	}
+	if set("trackingSubdomain", want.TrackingSubdomain != nil) {
+		req.TrackingSubdomain, changed = want.TrackingSubdomain, true
+	}
+	if set("openTracking", want.OpenTracking != nil) {
+		req.OpenTracking, changed = want.OpenTracking, true
+	}
+	if set("clickTracking", want.ClickTracking != nil) {
+		req.ClickTracking, changed = want.ClickTracking, true
+	}
+	if !changed {
+		return nil
+	}
+	return &req
+}
+
+func (r *Reconciler) planTemplates(ctx context.Context, spec *Spec, prune bool) ([]Change, []Change, error) {
+	if len(spec.Templates) == 0 {
+		return nil, nil, nil
+	}
+	live, err := listAll(func(limit, offset int) ([]mail.Template, int, error) {
+		resp, err := r.mail.Templates.List(ctx, &mail.ListTemplatesRequest{Environment: spec.Environment, Limit: &limit, Offset: &offset})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Templates, resp.Total, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	bySlug := make(map[string]mail.Template, len(live))
+	for _, t := range live {
+		bySlug[t.Slug] = t
+	}
+
+	var changes []Change
+	for _, want := range spec.Templates {
+		want := want
+		have, ok := bySlug[want.Slug]
+		if !ok {
+			req := &mail.CreateTemplateRequest{
+				Environment:     spec.Environment,
+				Name:            want.Name,
+				Slug:            want.Slug,
+				Description:     want.Description,
+				Subject:         want.Subject,
+				PreviewText:     want.PreviewText,
+				Text:            want.Text,
+				MJML:            want.MJML,
+				VariablesSchema: want.VariablesSchema,
+				IsActive:        want.IsActive,
+			}
+			if want.HTML != nil && want.MJML == nil {
+				req.HTML = *want.HTML
+			}
+			changes = append(changes, Change{Kind: KindTemplate, Action: ActionCreate, Key: want.Slug, apply: func(ctx context.Context) error {
+				_, err := r.mail.Templates.Create(ctx, req)
+				return err
+			}})
+			continue
+		}
+
+		var d differ
+		d.str("name", &want.Name, &have.Name)
+		d.str("description", want.Description, have.Description)
+		d.str("subject", &want.Subject, &have.Subject)
+		d.str("previewText", want.PreviewText, have.PreviewText)
+		if want.MJML == nil {
+			d.str("html", want.HTML, &have.HTML)
+		}
+		d.str("text", want.Text, have.Text)
+		d.str("mjml", want.MJML, have.MJML)
+		d.value("variablesSchema", want.VariablesSchema, have.VariablesSchema)
+		d.boolean("isActive", want.IsActive, have.IsActive)
+		if len(d.fields) == 0 {
+			continue
+		}
+
+		req := &mail.UpdateTemplateRequest{ID: have.ID}
+		for _, f := range d.fields {
+			switch f {
+			case "name":
+				req.Name = &want.Name
+			case "description":
+				req.Description = want.Description
+			case "subject":
+				req.Subject = &want.Subject
+			case "previewText":
+				req.PreviewText = want.PreviewText
+			case "html":
+				req.HTML = want.HTML
+			case "text":
+				req.Text = want.Text
+			case "mjml":
+				req.MJML = want.MJML
+			case "variablesSchema":
+				req.VariablesSchema = want.VariablesSchema
+			case "isActive":
+				req.IsActive = want.IsActive
+			}
+		}
+		changes = append(changes, Change{Kind: KindTemplate, Action: ActionUpdate, Key: want.Slug, ID: have.ID, Fields: d.fields, apply: func(ctx context.Context) error {
+			_, err := r.mail.Templates.Update(ctx, req)
+			return err
+		}})
+	}
+
+	var deletes []Change
+	if prune {
+		for _, have := range live {
+			if !slices.ContainsFunc(spec.Templates, func(t TemplateSpec) bool { return t.Slug == have.Slug }) {
+				id := have.ID
+				deletes = append(deletes, Change{Kind: KindTemplate, Action: ActionDelete, Key: have.Slug, ID: id, apply: func(ctx context.Context) error {
+					_, err := r.mail.Templates.Delete(ctx, id)
+					return err
+				}})
+			}
+		}
+	}
+	return changes, sortByKey(deletes), nil
+}
+
+func (r *Reconciler) planAudiences(ctx context.Context, spec *Spec, prune bool) ([]Change, []Change, error) {
+	if len(spec.Audiences) == 0 {
+		return nil, nil, nil
+	}
+	live, err := listAll(func(limit, offset int) ([]mail.Audience, int, error) {
+		resp, err := r.mail.Audiences.List(ctx, &mail.ListAudiencesRequest{Environment: spec.Environment, Limit: &limit, Offset: &offset})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Audiences, resp.Total, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	byName := make(map[string]mail.Audience, len(live))
+	for _, a := range live {
+		byName[a.Name] = a
+	}
+
+	var changes []Change
+	for _, want := range spec.Audiences {
+		want := want
+		have, ok := byName[want.Name]
+		if !ok {
+			changes = append(changes, Change{Kind: KindAudience, Action: ActionCreate, Key: want.Name, apply: func(ctx context.Context) error {
+				_, err := r.mail.Audiences.Create(ctx, &mail.CreateAudienceRequest{
+					Environment: spec.Environment,
+					Name:        want.Name,
+					Description: want.Description,
+				})
+				return err
+			}})
+			continue
+		}
+
+		var d differ
+		d.str("description", want.Description, have.Description)
+		if len(d.fields) == 0 {
+			continue
+		}
+		id := have.ID
+		changes = append(changes, Change{Kind: KindAudience, Action: ActionUpdate, Key: want.Name, ID: id, Fields: d.fields, apply: func(ctx context.Context) error {
+			_, err := r.mail.Audiences.Update(ctx, &mail.UpdateAudienceRequest{ID: id, Description: want.Description})
+			return err
+		}})
+	}
+
+	var deletes []Change
+	if prune {
+		for _, have := range live {
+			if !slices.ContainsFunc(spec.Audiences, func(a AudienceSpec) bool { return a.Name == have.Name }) {
+				id := have.ID
+				deletes = append(deletes, Change{Kind: KindAudience, Action: ActionDelete, Key: have.Name, ID: id, apply: func(ctx context.Context) error {
+					_, err := r.mail.Audiences.Delete(ctx, id)
+					return err
+				}})
+			}
+		}
+	}
+	return changes, sortByKey(deletes), nil
+}
+
+func (r *Reconciler) planSequences(ctx context.Context, spec *Spec, prune bool) ([]Change, []Change, error) {
+	if len(spec.Sequences) == 0 {
+		return nil, nil, nil
+	}
+	live, err := listAll(func(limit, offset int) ([]mail.Sequence, int, error) {
+		resp, err := r.mail.Sequences.List(ctx, &mail.ListSequencesRequest{Environment: spec.Environment, Limit: &limit, Offset: &offset})
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Sequences, resp.Total, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	byName := make(map[string]mail.Sequence, len(live))
+	for _, s := range live {
+		byName[s.Name] = s
+	}
+
+	var changes []Change
+	for _, want := range spec.Sequences {
+		want := want
+		have, ok := byName[want.Name]
+		if !ok {
+			changes = append(changes, Change{Kind: KindSequence, Action: ActionCreate, Key: want.Name, apply: func(ctx context.Context) error {
+				_, err := r.mail.Sequences.Create(ctx, &mail.CreateSequenceRequest{
+					Environment:      spec.Environment,
+					Name:             want.Name,
+					Description:      want.Description,
+					TriggerType:      want.TriggerType,
+					TriggerFrequency: want.TriggerFrequency,
+					TriggerConfig:    want.TriggerConfig,
+				})
+				return err
+			}})
+			continue
+		}
+
+		var d differ
+		d.str("description", want.Description, have.Description)
+		triggerType := string(want.TriggerType)
+		d.str("triggerType", &triggerType, (*string)(&have.TriggerType))
+		d.str("triggerFrequency", (*string)(want.TriggerFrequency), (*string)(&have.TriggerFrequency))
+		d.value("triggerConfig", want.TriggerConfig, have.TriggerConfig)
+		if len(d.fields) == 0 {
+			continue
+		}
+
+		req := &mail.UpdateSequenceRequest{ID: have.ID}
+		for _, f := range d.fields {
+			switch f {
+			case "description":
+				req.Description = want.Description
+			case "triggerType":
+				req.TriggerType = &want.TriggerType
+			case "triggerFrequency":
+				req.TriggerFrequency = want.TriggerFrequency
+			case "triggerConfig":
+				req.TriggerConfig = want.TriggerConfig
+			}
+		}
+		changes = append(changes, Change{Kind: KindSequence, Action: ActionUpdate, Key: want.Name, ID: have.ID, Fields: d.fields, apply: func(ctx context.Context) error {
+			_, err := r.mail.Sequences.Update(ctx, req)
+			return err
+		}})
+	}
+
+	var deletes []Change
+	if prune {
+		for _, have := range live {
+			if !slices.ContainsFunc(spec.Sequences, func(s SequenceSpec) bool { return s.Name == have.Name }) {
+				id := have.ID
+				deletes = append(deletes, Change{Kind: KindSequence, Action: ActionDelete, Key: have.Name, ID: id, apply: func(ctx context.Context) error {
+					_, err := r.mail.Sequences.Delete(ctx, id)
+					return err
+				}})
+			}
+		}
+	}
+	return changes, sortByKey(deletes), nil
+}
+
+const listPageSize = 100
+
+// listAll reads every page of a list endpoint.
+func listAll[T any](list func(limit, offset int) ([]T, int, error)) ([]T, error) {
+	var all []T
+	for {
+		page, total, err := list(listPageSize, len(all))
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < listPageSize || len(all) >= total {
+			return all, nil
+		}
+	}
+}
+
+func sortByKey(changes []Change) []Change {
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// differ collects the names of managed fields whose live value differs from
+// the Spec. A nil wanted value is unmanaged and never differs.
+type differ struct {
+	fields []string
+}
+
+func (d *differ) str(name string, want, have *string) {
+	if want == nil {
+		return
+	}
+	if have == nil || *have != *want {
+		d.fields = append(d.fields, name)
+	}
+}
+
+func (d *differ) boolean(name string, want *bool, have bool) {
+	if want != nil && *want != have {
+		d.fields = append(d.fields, name)
+	}
+}
+
+// subdomain compares a wanted subdomain with the live fully qualified name.
+func (d *differ) subdomain(name string, want, have *string, domain string) {
+	if want == nil {
+		return
+	}
+	fqdn := *want + "." + domain
+	d.str(name, &fqdn, have)
+}
+
+// value compares values by their JSON encoding, so numbers decoded from YAML
+// as ints match the float64s decoded from API responses.
+func (d *differ) value(name string, want, have map[string]interface{}) {
+	if want == nil {
+		return
+	}
+	if !equalJSON(want, have) {
+		d.fields = append(d.fields, name)
+	}
+}
+
+func equalJSON(a, b interface{}) bool {
+	var na, nb interface{}
+	for _, v := range []struct {
+		in  interface{}
+		out *interface{}
+	}{{a, &na}, {b, &nb}} {
+		data, err := json.Marshal(v.in)
+		if err != nil {
+			return false
+		}
+		if err := json.Unmarshal(data, v.out); err != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(na, nb)
+}
@@ -0,0 +1,207 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/mail"
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// liveProject serves list endpoints from fixed data and records every other
+// request as "METHOD path" along with its body.
+type liveProject struct {
+	domains   []mail.Domain
+	templates []mail.Template
+	audiences []mail.Audience
+	sequences []mail.Sequence
+
+	requests    []string
+	bodies      []map[string]interface{}
+	domainQuery url.Values
+	fail        string
+}
+
+func setupReconciler(t *testing.T, live *liveProject) (*Reconciler, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			var resp interface{}
+			switch r.URL.Path {
+			case "/mail/domains":
+				live.domainQuery = r.URL.Query()
+				resp = live.domains
+			case "/mail/templates":
+				resp = mail.ListTemplatesResponse{Templates: live.templates, Total: len(live.templates)}
+			case "/mail/audiences":
+				resp = mail.ListAudiencesResponse{Audiences: live.audiences, Total: len(live.audiences)}
+			case "/mail/sequences":
+				resp = mail.ListSequencesResponse{Sequences: live.sequences, Total: len(live.sequences)}
+			default:
+				t.Errorf("unexpected GET %s", r.URL.Path)
+			}
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(resp)
+			return
+		}
+
+		request := r.Method + " " + r.URL.Path
+		live.requests = append(live.requests, request)
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		live.bodies = append(live.bodies, body)
+		if request == live.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":"boom"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"new-1"}`))
+	}))
+	return NewReconciler(mail.New(client.New("test-api-key", server.URL))), server
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestReconciler_Plan(t *testing.T) {
+	live := &liveProject{
+		domains: []mail.Domain{
+			{ID: "dom-1", Domain: "mail.acme.com", OpenTracking: true, TrackingDomain: ptr("links.mail.acme.com")},
+		},
+		templates: []mail.Template{
+			{ID: "tpl-1", Slug: "welcome", Name: "Welcome", Subject: "Hi", HTML: "<p>Hi</p>", IsActive: true},
+			{ID: "tpl-2", Slug: "legacy", Name: "Legacy"},
+		},
+		audiences: []mail.Audience{
+			{ID: "aud-1", Name: "Newsletter", Description: ptr("Monthly news")},
+		},
+		sequences: []mail.Sequence{
+			{ID: "seq-1", Name: "Onboarding", TriggerType: mail.SequenceTriggerContactAdded, TriggerConfig: map[string]interface{}{"delayMinutes": 5.0}},
+			{ID: "seq-2", Name: "Winback", TriggerType: mail.SequenceTriggerManual},
+		},
+	}
+	reconciler, server := setupReconciler(t, live)
+	defer server.Close()
+
+	spec := &Spec{
+		ProjectSlug: "acme",
+		Domains: []DomainSpec{
+			{Domain: "mail.acme.com", OpenTracking: ptr(true), TrackingSubdomain: ptr("links")},
+		},
+		Templates: []TemplateSpec{
+			{Slug: "welcome", Name: "Welcome", Subject: "Welcome to Acme", HTML: ptr("<p>Hi</p>")},
+			{Slug: "receipt", Name: "Receipt", Subject: "Your receipt", HTML: ptr("<p>Thanks</p>")},
+		},
+		Audiences: []AudienceSpec{
+			{Name: "Newsletter", Description: ptr("Monthly news")},
+		},
+		Sequences: []SequenceSpec{
+			{Name: "Onboarding", TriggerType: mail.SequenceTriggerContactAdded, TriggerConfig: map[string]interface{}{"delayMinutes": 5}},
+		},
+	}
+
+	t.Run("without prune", func(t *testing.T) {
+		plan, err := reconciler.Plan(context.Background(), spec, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "~ template welcome (subject)\n+ template receipt\n", plan.String())
+		assert.Empty(t, live.requests, "planning should not change anything")
+	})
+
+	t.Run("with prune", func(t *testing.T) {
+		plan, err := reconciler.Plan(context.Background(), spec, &PlanOptions{Prune: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, "~ template welcome (subject)\n+ template receipt\n- sequence Winback\n- template legacy\n", plan.String())
+	})
+
+	t.Run("in sync", func(t *testing.T) {
+		plan, err := reconciler.Plan(context.Background(), &Spec{Audiences: spec.Audiences, Sequences: spec.Sequences}, nil)
+
+		require.NoError(t, err)
+		assert.True(t, plan.Empty())
+		assert.Equal(t, "No changes.\n", plan.String())
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		_, err := reconciler.Plan(context.Background(), &Spec{Templates: []TemplateSpec{{Slug: "welcome"}}}, nil)
+
+		assert.ErrorIs(t, err, types.ErrInvalidRequest)
+	})
+}
+
+func TestReconciler_Apply(t *testing.T) {
+	live := &liveProject{
+		templates: []mail.Template{{ID: "tpl-1", Slug: "welcome", Name: "Welcome", Subject: "Hi"}},
+		audiences: []mail.Audience{{ID: "aud-1", Name: "Old list"}},
+	}
+	reconciler, server := setupReconciler(t, live)
+	defer server.Close()
+
+	plan, err := reconciler.Reconcile(context.Background(), &Spec{
+		Templates: []TemplateSpec{{Slug: "welcome", Name: "Welcome", Subject: "Welcome to Acme"}},
+		Audiences: []AudienceSpec{{Name: "Newsletter"}},
+	}, &PlanOptions{Prune: true})
+
+	require.NoError(t, err)
+	assert.Len(t, plan.Changes, 3)
+	assert.Equal(t, []string{
+		"PUT /mail/templates/tpl-1",
+		"POST /mail/audiences",
+		"DELETE /mail/audiences/aud-1",
+	}, live.requests)
+	assert.Equal(t, "Welcome to Acme", live.bodies[0]["subject"])
+	assert.NotContains(t, live.bodies[0], "name", "unchanged fields should not be sent")
+	assert.Equal(t, "Newsletter", live.bodies[1]["name"])
+}
+
+func TestReconciler_Apply_ScopedDomain(t *testing.T) {
+	live := &liveProject{}
+	reconciler, server := setupReconciler(t, live)
+	defer server.Close()
+
+	sandbox := types.EnvironmentSandbox
+	plan, err := reconciler.Reconcile(context.Background(), &Spec{
+		ProjectSlug: "acme-staging",
+		Environment: &sandbox,
+		Domains:     []DomainSpec{{Domain: "mail.acme.dev"}},
+	}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "+ domain mail.acme.dev\n", plan.String())
+	// Listing and creating use the same scope, so the next plan finds the domain.
+	assert.Equal(t, "acme-staging", live.domainQuery.Get("projectSlug"))
+	assert.Equal(t, "sandbox", live.domainQuery.Get("environment"))
+	assert.Equal(t, []string{"POST /mail/domains"}, live.requests)
+	assert.Equal(t, "mail.acme.dev", live.bodies[0]["domain"])
+	assert.Equal(t, "acme-staging", live.bodies[0]["projectSlug"])
+	assert.Equal(t, "sandbox", live.bodies[0]["environment"])
+}
+
+func TestReconciler_Apply_Error(t *testing.T) {
+	live := &liveProject{fail: "POST /mail/audiences"}
+	reconciler, server := setupReconciler(t, live)
+	defer server.Close()
+
+	plan, err := reconciler.Plan(context.Background(), &Spec{
+		Templates: []TemplateSpec{{Slug: "welcome", Name: "Welcome", Subject: "Hi", HTML: ptr("<p>Hi</p>")}},
+		Audiences: []AudienceSpec{{Name: "Newsletter"}},
+	}, nil)
+	require.NoError(t, err)
+
+	err = reconciler.Apply(context.Background(), plan)
+
+	var applyErr *ApplyError
+	require.ErrorAs(t, err, &applyErr)
+	assert.Equal(t, 1, applyErr.Applied)
+	assert.Equal(t, KindAudience, applyErr.Change.Kind)
+	assert.Contains(t, err.Error(), `config: create audience "Newsletter"`)
+}
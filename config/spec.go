@@ -0,0 +1,188 @@
+// Package config manages a project's mail setup declaratively. A Spec lists
+// the domains, templates, audiences and sequences a project should have; a
+// Reconciler compares it with the live project, shows the changes as a Plan
+// and applies them, so the setup can be kept in version control and rolled
+// out from CI.
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/stack0/sdk-go/mail"
+	"github.com/stack0/sdk-go/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is the desired mail setup of a project. Each resource is identified by
+// a key that is stable across environments: domains by name, templates by
+// slug, and audiences and sequences by name.
+//
+// Optional fields left nil are not managed: Plan neither compares nor
+// changes them, so settings made in the dashboard survive.
+type Spec struct {
+	ProjectSlug string             `json:"projectSlug,omitempty" yaml:"projectSlug,omitempty"`
+	Environment *types.Environment `json:"environment,omitempty" yaml:"environment,omitempty"`
+	Domains     []DomainSpec       `json:"domains,omitempty" yaml:"domains,omitempty" validate:"dive"`
+	Templates   []TemplateSpec     `json:"templates,omitempty" yaml:"templates,omitempty" validate:"dive"`
+	Audiences   []AudienceSpec     `json:"audiences,omitempty" yaml:"audiences,omitempty" validate:"dive"`
+	Sequences   []SequenceSpec     `json:"sequences,omitempty" yaml:"sequences,omitempty" validate:"dive"`
+}
+
+// DomainSpec is a sending domain. Domains are listed per project, so a Spec
+// with domains must set ProjectSlug.
+type DomainSpec struct {
+	Domain              string  `json:"domain" yaml:"domain" validate:"required"`
+	ReturnPathSubdomain *string `json:"returnPathSubdomain,omitempty" yaml:"returnPathSubdomain,omitempty"`
+	TrackingSubdomain   *string `json:"trackingSubdomain,omitempty" yaml:"trackingSubdomain,omitempty"`
+	OpenTracking        *bool   `json:"openTracking,omitempty" yaml:"openTracking,omitempty"`
+	ClickTracking       *bool   `json:"clickTracking,omitempty" yaml:"clickTracking,omitempty"`
+}
+
+// TemplateSpec is an email template. Bodies can be given inline or, when the
+// Spec is read with Load, as paths relative to the spec file; HTMLFile,
+// TextFile and MJMLFile are read into HTML, Text and MJML. When MJML is set
+// the HTML is compiled from it and HTML is ignored.
+type TemplateSpec struct {
+	Slug            string                 `json:"slug" yaml:"slug" validate:"required"`
+	Name            string                 `json:"name" yaml:"name" validate:"required"`
+	Description     *string                `json:"description,omitempty" yaml:"description,omitempty"`
+	Subject         string                 `json:"subject" yaml:"subject"`
+	PreviewText     *string                `json:"previewText,omitempty" yaml:"previewText,omitempty"`
+	HTML            *string                `json:"html,omitempty" yaml:"html,omitempty"`
+	HTMLFile        string                 `json:"htmlFile,omitempty" yaml:"htmlFile,omitempty"`
+	Text            *string                `json:"text,omitempty" yaml:"text,omitempty"`
+	TextFile        string                 `json:"textFile,omitempty" yaml:"textFile,omitempty"`
+	MJML            *string                `json:"mjml,omitempty" yaml:"mjml,omitempty"`
+	MJMLFile        string                 `json:"mjmlFile,omitempty" yaml:"mjmlFile,omitempty"`
+	VariablesSchema map[string]interface{} `json:"variablesSchema,omitempty" yaml:"variablesSchema,omitempty"`
+	IsActive        *bool                  `json:"isActive,omitempty" yaml:"isActive,omitempty"`
+}
+
+// AudienceSpec is a contact audience. Only the audience itself is managed,
+// not its contacts.
+type AudienceSpec struct {
+	Name        string  `json:"name" yaml:"name" validate:"required"`
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+// SequenceSpec is a sequence's settings and trigger. Its graph of nodes and
+// connections is not managed here; use SequencesClient.Export and Import to
+// promote graphs between environments.
+type SequenceSpec struct {
+	Name             string                         `json:"name" yaml:"name" validate:"required"`
+	Description      *string                        `json:"description,omitempty" yaml:"description,omitempty"`
+	TriggerType      mail.SequenceTriggerType       `json:"triggerType" yaml:"triggerType" validate:"required"`
+	TriggerFrequency *mail.SequenceTriggerFrequency `json:"triggerFrequency,omitempty" yaml:"triggerFrequency,omitempty"`
+	TriggerConfig    map[string]interface{}         `json:"triggerConfig,omitempty" yaml:"triggerConfig,omitempty"`
+}
+
+// Parse decodes a Spec from YAML or JSON; JSON is read as YAML, of which it
+// is a subset. Unknown fields are rejected so typos do not silently leave a
+// setting unmanaged. File references in templates are left unresolved; use
+// Load to resolve them.
+func Parse(data []byte) (*Spec, error) {
+	var spec Spec
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&spec); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("config: parse spec: %w", err)
+	}
+	return &spec, nil
+}
+
+// Load reads a Spec from a YAML or JSON file and reads the template bodies it
+// refers to, relative to the file's directory.
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Dir(path)
+	for i := range spec.Templates {
+		t := &spec.Templates[i]
+		for _, ref := range []struct {
+			file string
+			dst  **string
+		}{
+			{t.HTMLFile, &t.HTML},
+			{t.TextFile, &t.Text},
+			{t.MJMLFile, &t.MJML},
+		} {
+			if ref.file == "" {
+				continue
+			}
+			if *ref.dst != nil {
+				return nil, fmt.Errorf("config: template %q: both a body and a file reference are set", t.Slug)
+			}
+			body, err := os.ReadFile(filepath.Join(dir, ref.file))
+			if err != nil {
+				return nil, fmt.Errorf("config: template %q: %w", t.Slug, err)
+			}
+			s := string(body)
+			*ref.dst = &s
+		}
+	}
+	return spec, nil
+}
+
+// Validate implements types.Validator. Besides required fields, keys must be
+// unique within each kind of resource.
+func (s *Spec) Validate() error {
+	var rules [][]types.FieldError
+	rules = append(rules, duplicates("domains", len(s.Domains), func(i int) string { return s.Domains[i].Domain }))
+	rules = append(rules, duplicates("templates", len(s.Templates), func(i int) string { return s.Templates[i].Slug }))
+	rules = append(rules, duplicates("audiences", len(s.Audiences), func(i int) string { return s.Audiences[i].Name }))
+	rules = append(rules, duplicates("sequences", len(s.Sequences), func(i int) string { return s.Sequences[i].Name }))
+	if len(s.Domains) > 0 && s.ProjectSlug == "" {
+		rules = append(rules, []types.FieldError{{Field: "projectSlug", Message: "required when domains are set"}})
+	}
+	return types.CheckFields(s, rules...)
+}
+
+// Validate implements types.Validator. File references must have been read
+// by Load, or the template's bodies would silently go unmanaged.
+func (t *TemplateSpec) Validate() error {
+	var unread []types.FieldError
+	for _, ref := range []struct {
+		field string
+		file  string
+		body  *string
+	}{
+		{"htmlFile", t.HTMLFile, t.HTML},
+		{"textFile", t.TextFile, t.Text},
+		{"mjmlFile", t.MJMLFile, t.MJML},
+	} {
+		if ref.file != "" && ref.body == nil {
+			unread = append(unread, types.FieldError{Field: ref.field, Message: "not read; load the spec with Load"})
+		}
+	}
+	return types.CheckFields(t, unread)
+}
+
+func duplicates(field string, n int, key func(int) string) []types.FieldError {
+	var problems []types.FieldError
+	seen := make(map[string]bool, n)
+	for i := 0; i < n; i++ {
+		k := key(i)
+		if k == "" {
+			continue
+		}
+		if seen[k] {
+			problems = append(problems, types.FieldError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Message: fmt.Sprintf("duplicate key %q", k),
+			})
+		}
+		seen[k] = true
+	}
+	return problems
+}
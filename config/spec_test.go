@@ -0,0 +1,89 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stack0/sdk-go/mail"
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		spec, err := Parse([]byte(`
+projectSlug: acme
+environment: production
+templates:
+  - slug: welcome
+    name: Welcome
+    subject: Welcome to Acme
+    html: <p>Hi {{ name }}</p>
+sequences:
+  - name: Onboarding
+    triggerType: contact_added
+    triggerConfig:
+      delayMinutes: 5
+`))
+
+		require.NoError(t, err)
+		assert.Equal(t, "acme", spec.ProjectSlug)
+		assert.Equal(t, types.EnvironmentProduction, *spec.Environment)
+		require.Len(t, spec.Templates, 1)
+		assert.Equal(t, "<p>Hi {{ name }}</p>", *spec.Templates[0].HTML)
+		assert.Equal(t, mail.SequenceTriggerContactAdded, spec.Sequences[0].TriggerType)
+		assert.Equal(t, 5, spec.Sequences[0].TriggerConfig["delayMinutes"])
+	})
+
+	t.Run("json", func(t *testing.T) {
+		spec, err := Parse([]byte(`{"audiences": [{"name": "Newsletter", "description": "Monthly news"}]}`))
+
+		require.NoError(t, err)
+		require.Len(t, spec.Audiences, 1)
+		assert.Equal(t, "Monthly news", *spec.Audiences[0].Description)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := Parse([]byte("templates:\n  - slug: welcome\n    subjet: Hi\n"))
+
+		assert.ErrorContains(t, err, "subjet")
+	})
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "templates"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "templates", "welcome.mjml"), []byte("<mjml></mjml>"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "mail.yaml"), []byte(`
+templates:
+  - slug: welcome
+    name: Welcome
+    mjmlFile: templates/welcome.mjml
+`), 0o644))
+
+	spec, err := Load(filepath.Join(dir, "mail.yaml"))
+
+	require.NoError(t, err)
+	require.NotNil(t, spec.Templates[0].MJML)
+	assert.Equal(t, "<mjml></mjml>", *spec.Templates[0].MJML)
+	assert.NoError(t, spec.Validate())
+}
+
+func TestSpec_Validate(t *testing.T) {
+	spec := &Spec{
+		Domains:   []DomainSpec{{Domain: "mail.acme.com"}},
+		Templates: []TemplateSpec{{Slug: "welcome", Name: "Welcome"}, {Slug: "welcome", Name: "Welcome 2", HTMLFile: "welcome.html"}},
+		Sequences: []SequenceSpec{{Name: "Onboarding"}},
+	}
+
+	var verr *types.ValidationError
+	require.ErrorAs(t, spec.Validate(), &verr)
+	assert.Equal(t, []types.FieldError{
+		{Field: "templates[1].htmlFile", Message: "not read; load the spec with Load"},
+		{Field: "sequences[0].triggerType", Message: "required"},
+		{Field: "templates[1]", Message: `duplicate key "welcome"`},
+		{Field: "projectSlug", Message: "required when domains are set"},
+	}, verr.Fields)
+}
@@ -2,9 +2,11 @@ package extraction
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"net/url"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/stack0/sdk-go/client"
@@ -23,6 +25,10 @@ func NewClient(http *client.HTTPClient) *Client {
 
 // Extract extracts content from a URL.
 func (c *Client) Extract(ctx context.Context, req *CreateExtractionRequest) (*CreateExtractionResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp CreateExtractionResponse
 	if err := c.http.Post(ctx, "/webdata/extractions", req, &resp); err != nil {
 		return nil, err
@@ -32,13 +38,20 @@ func (c *Client) Extract(ctx context.Context, req *CreateExtractionRequest) (*Cr
 
 // Get retrieves an extraction by ID.
 func (c *Client) Get(ctx context.Context, req *GetExtractionRequest) (*ExtractionResult, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
 	if req.ProjectID != nil {
 		params.Set("projectId", *req.ProjectID)
 	}
+	if len(req.Fields) > 0 {
+		params.Set("fields", client.JoinFields(req.Fields))
+	}
 
 	path := "/webdata/extractions/" + req.ID
 	if len(params) > 0 {
@@ -54,7 +67,11 @@ func (c *Client) Get(ctx context.Context, req *GetExtractionRequest) (*Extractio
 
 // List lists extractions with pagination and filters.
 func (c *Client) List(ctx context.Context, req *ListExtractionsRequest) (*ListExtractionsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -68,6 +85,13 @@ func (c *Client) List(ctx context.Context, req *ListExtractionsRequest) (*ListEx
 		if req.URL != nil {
 			params.Set("url", *req.URL)
 		}
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
+		if len(req.Fields) > 0 {
+			params.Set("fields", client.JoinFields(req.Fields))
+		}
+		client.SetMetadataFilter(params, req.Metadata)
 		if req.Limit != nil {
 			params.Set("limit", strconv.Itoa(*req.Limit))
 		}
@@ -88,9 +112,33 @@ func (c *Client) List(ctx context.Context, req *ListExtractionsRequest) (*ListEx
 	return &resp, nil
 }
 
+// GetMetadataIndex returns the extraction metadata keys that List can filter
+// on.
+func (c *Client) GetMetadataIndex(ctx context.Context) (*types.MetadataIndex, error) {
+	var resp types.MetadataIndex
+	if err := c.http.Get(ctx, "/webdata/extractions/metadata-index", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetMetadataIndex replaces the extraction metadata keys that List can filter
+// on, up to the index's MaxKeys.
+func (c *Client) SetMetadataIndex(ctx context.Context, keys []string) (*types.MetadataIndex, error) {
+	var resp types.MetadataIndex
+	if err := c.http.Put(ctx, "/webdata/extractions/metadata-index", map[string][]string{"keys": keys}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Delete deletes an extraction.
 func (c *Client) Delete(ctx context.Context, req *GetExtractionRequest) (*SuccessResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -104,8 +152,8 @@ func (c *Client) Delete(ctx context.Context, req *GetExtractionRequest) (*Succes
 	}
 
 	body := map[string]interface{}{"id": req.ID}
-	if req.Environment != nil {
-		body["environment"] = *req.Environment
+	if env := c.http.EnvironmentOrDefault(req.Environment); env != "" {
+		body["environment"] = env
 	}
 	if req.ProjectID != nil {
 		body["projectId"] = *req.ProjectID
@@ -118,16 +166,20 @@ func (c *Client) Delete(ctx context.Context, req *GetExtractionRequest) (*Succes
 	return &resp, nil
 }
 
-// ExtractAndWaitOptions are options for ExtractAndWait.
+// ExtractAndWaitOptions are options for ExtractAndWait. Rate-limit and server
+// errors while polling are retried up to MaxTransientErrors times in a row;
+// see client.PollOptions.
 type ExtractAndWaitOptions struct {
-	PollInterval time.Duration
-	Timeout      time.Duration
+	PollInterval       time.Duration
+	Timeout            time.Duration
+	MaxTransientErrors int
 }
 
 // ExtractAndWait extracts content and waits for completion.
 func (c *Client) ExtractAndWait(ctx context.Context, req *CreateExtractionRequest, opts *ExtractAndWaitOptions) (*ExtractionResult, error) {
 	pollInterval := 1 * time.Second
 	timeout := 60 * time.Second
+	maxTransient := 0
 	if opts != nil {
 		if opts.PollInterval > 0 {
 			pollInterval = opts.PollInterval
@@ -135,6 +187,7 @@ func (c *Client) ExtractAndWait(ctx context.Context, req *CreateExtractionReques
 		if opts.Timeout > 0 {
 			timeout = opts.Timeout
 		}
+		maxTransient = opts.MaxTransientErrors
 	}
 
 	resp, err := c.Extract(ctx, req)
@@ -142,40 +195,38 @@ func (c *Client) ExtractAndWait(ctx context.Context, req *CreateExtractionReques
 		return nil, err
 	}
 
-	startTime := time.Now()
-	for time.Since(startTime) < timeout {
+	return client.Poll(ctx, client.PollOptions{
+		Interval:           pollInterval,
+		Timeout:            timeout,
+		MaxTransientErrors: maxTransient,
+		TimeoutMessage:     "Extraction timed out",
+	}, func(ctx context.Context) (*ExtractionResult, bool, error) {
 		extraction, err := c.Get(ctx, &GetExtractionRequest{
 			ID:          resp.ID,
 			Environment: req.Environment,
 			ProjectID:   req.ProjectID,
 		})
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
-		if extraction.Status == ExtractionStatusCompleted || extraction.Status == ExtractionStatusFailed {
-			if extraction.Status == ExtractionStatusFailed {
-				errMsg := "Extraction failed"
-				if extraction.Error != nil {
-					errMsg = *extraction.Error
-				}
-				return nil, errors.New(errMsg)
+		if extraction.Status == ExtractionStatusFailed {
+			errMsg := "Extraction failed"
+			if extraction.Error != nil {
+				errMsg = *extraction.Error
 			}
-			return extraction, nil
-		}
-
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(pollInterval):
+			return nil, false, errors.New(errMsg)
 		}
-	}
-
-	return nil, types.NewTimeoutError("Extraction timed out")
+		return extraction, extraction.Status == ExtractionStatusCompleted, nil
+	})
 }
 
 // Batch creates a batch extraction job for multiple URLs.
 func (c *Client) Batch(ctx context.Context, req *CreateBatchExtractionsRequest) (*CreateBatchResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp CreateBatchResponse
 	if err := c.http.Post(ctx, "/webdata/batch/extractions", req, &resp); err != nil {
 		return nil, err
@@ -185,7 +236,11 @@ func (c *Client) Batch(ctx context.Context, req *CreateBatchExtractionsRequest)
 
 // GetBatchJob retrieves a batch job by ID.
 func (c *Client) GetBatchJob(ctx context.Context, req *GetBatchJobRequest) (*BatchExtractionJob, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -207,7 +262,12 @@ func (c *Client) GetBatchJob(ctx context.Context, req *GetBatchJobRequest) (*Bat
 
 // ListBatchJobs lists batch jobs with pagination and filters.
 func (c *Client) ListBatchJobs(ctx context.Context, req *ListBatchJobsRequest) (*BatchJobsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("type", "extraction")
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -218,7 +278,9 @@ func (c *Client) ListBatchJobs(ctx context.Context, req *ListBatchJobsRequest) (
 		if req.Status != nil {
 			params.Set("status", string(*req.Status))
 		}
-		params.Set("type", "extraction")
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
 		if req.Limit != nil {
 			params.Set("limit", strconv.Itoa(*req.Limit))
 		}
@@ -241,7 +303,11 @@ func (c *Client) ListBatchJobs(ctx context.Context, req *ListBatchJobsRequest) (
 
 // CancelBatchJob cancels a batch job.
 func (c *Client) CancelBatchJob(ctx context.Context, req *GetBatchJobRequest) (*SuccessResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -265,6 +331,7 @@ func (c *Client) CancelBatchJob(ctx context.Context, req *GetBatchJobRequest) (*
 func (c *Client) BatchAndWait(ctx context.Context, req *CreateBatchExtractionsRequest, opts *ExtractAndWaitOptions) (*BatchExtractionJob, error) {
 	pollInterval := 2 * time.Second
 	timeout := 300 * time.Second
+	maxTransient := 0
 	if opts != nil {
 		if opts.PollInterval > 0 {
 			pollInterval = opts.PollInterval
@@ -272,6 +339,7 @@ func (c *Client) BatchAndWait(ctx context.Context, req *CreateBatchExtractionsRe
 		if opts.Timeout > 0 {
 			timeout = opts.Timeout
 		}
+		maxTransient = opts.MaxTransientErrors
 	}
 
 	resp, err := c.Batch(ctx, req)
@@ -279,40 +347,160 @@ func (c *Client) BatchAndWait(ctx context.Context, req *CreateBatchExtractionsRe
 		return nil, err
 	}
 
-	startTime := time.Now()
-	for time.Since(startTime) < timeout {
+	return client.Poll(ctx, client.PollOptions{
+		Interval:           pollInterval,
+		Timeout:            timeout,
+		MaxTransientErrors: maxTransient,
+		TimeoutMessage:     "Batch job timed out",
+	}, func(ctx context.Context) (*BatchExtractionJob, bool, error) {
 		job, err := c.GetBatchJob(ctx, &GetBatchJobRequest{
 			ID:          resp.ID,
 			Environment: req.Environment,
 			ProjectID:   req.ProjectID,
 		})
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
+		done := job.Status == types.BatchJobStatusCompleted || job.Status == types.BatchJobStatusFailed || job.Status == types.BatchJobStatusCancelled
+		return job, done, nil
+	})
+}
 
-		if job.Status == types.BatchJobStatusCompleted || job.Status == types.BatchJobStatusFailed || job.Status == types.BatchJobStatusCancelled {
-			return job, nil
-		}
+// Crawl starts crawling a site from req.SeedURL, extracting every page it
+// reaches. Use GetCrawl to follow its progress and GetCrawlResults to read
+// the extracted pages, or CrawlAndWait to do both.
+func (c *Client) Crawl(ctx context.Context, req *CrawlRequest) (*CreateCrawlResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
 
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(pollInterval):
+	var resp CreateCrawlResponse
+	if err := c.http.Post(ctx, "/webdata/crawls", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCrawl retrieves a crawl by ID.
+func (c *Client) GetCrawl(ctx context.Context, req *GetCrawlRequest) (*Crawl, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req.Environment != nil {
+		params.Set("environment", string(*req.Environment))
+	}
+	if req.ProjectID != nil {
+		params.Set("projectId", *req.ProjectID)
+	}
+
+	path := "/webdata/crawls/" + req.ID
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp Crawl
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetCrawlResults retrieves a page of a crawl's extracted pages. Results are
+// available while the crawl is running; pass NextCursor as req.Cursor to get
+// the next page.
+func (c *Client) GetCrawlResults(ctx context.Context, req *GetCrawlResultsRequest) (*CrawlResultsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req.Environment != nil {
+		params.Set("environment", string(*req.Environment))
+	}
+	if req.ProjectID != nil {
+		params.Set("projectId", *req.ProjectID)
+	}
+	if req.Limit != nil {
+		params.Set("limit", strconv.Itoa(*req.Limit))
+	}
+	if req.Cursor != nil {
+		params.Set("cursor", *req.Cursor)
+	}
+
+	path := "/webdata/crawls/" + req.ID + "/results"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp CrawlResultsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CrawlAndWait starts a crawl and waits until it completes or is cancelled.
+// It returns an error if the crawl fails. The default timeout is ten minutes.
+func (c *Client) CrawlAndWait(ctx context.Context, req *CrawlRequest, opts *ExtractAndWaitOptions) (*Crawl, error) {
+	pollInterval := 2 * time.Second
+	timeout := 600 * time.Second
+	maxTransient := 0
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			pollInterval = opts.PollInterval
+		}
+		if opts.Timeout > 0 {
+			timeout = opts.Timeout
 		}
+		maxTransient = opts.MaxTransientErrors
+	}
+
+	resp, err := c.Crawl(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, types.NewTimeoutError("Batch job timed out")
+	return client.Poll(ctx, client.PollOptions{
+		Interval:           pollInterval,
+		Timeout:            timeout,
+		MaxTransientErrors: maxTransient,
+		TimeoutMessage:     "Crawl timed out",
+	}, func(ctx context.Context) (*Crawl, bool, error) {
+		crawl, err := c.GetCrawl(ctx, &GetCrawlRequest{
+			ID:          resp.ID,
+			Environment: req.Environment,
+			ProjectID:   req.ProjectID,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+
+		if crawl.Status == CrawlStatusFailed {
+			errMsg := "Crawl failed"
+			if crawl.Error != nil {
+				errMsg = *crawl.Error
+			}
+			return nil, false, errors.New(errMsg)
+		}
+		return crawl, crawl.Status == CrawlStatusCompleted || crawl.Status == CrawlStatusCancelled, nil
+	})
 }
 
 // CreateSchedule creates a scheduled extraction job.
 func (c *Client) CreateSchedule(ctx context.Context, req *CreateExtractionScheduleRequest) (*CreateScheduleResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	body := map[string]interface{}{
 		"type": "extraction",
 		"name": req.Name,
 		"url":  req.URL,
 	}
-	if req.Environment != nil {
-		body["environment"] = *req.Environment
+	if env := c.http.EnvironmentOrDefault(req.Environment); env != "" {
+		body["environment"] = env
 	}
 	if req.ProjectID != nil {
 		body["projectId"] = *req.ProjectID
@@ -335,6 +523,12 @@ func (c *Client) CreateSchedule(ctx context.Context, req *CreateExtractionSchedu
 	if req.WebhookSecret != nil {
 		body["webhookSecret"] = *req.WebhookSecret
 	}
+	if req.AlertConfig != nil {
+		body["alertConfig"] = req.AlertConfig
+	}
+	if req.Tags != nil {
+		body["tags"] = req.Tags
+	}
 	if req.Metadata != nil {
 		body["metadata"] = req.Metadata
 	}
@@ -348,7 +542,11 @@ func (c *Client) CreateSchedule(ctx context.Context, req *CreateExtractionSchedu
 
 // UpdateSchedule updates a schedule.
 func (c *Client) UpdateSchedule(ctx context.Context, req *UpdateExtractionScheduleRequest) (*SuccessResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -386,6 +584,12 @@ func (c *Client) UpdateSchedule(ctx context.Context, req *UpdateExtractionSchedu
 	if req.WebhookSecret != nil {
 		body["webhookSecret"] = *req.WebhookSecret
 	}
+	if req.AlertConfig != nil {
+		body["alertConfig"] = req.AlertConfig
+	}
+	if req.Tags != nil {
+		body["tags"] = req.Tags
+	}
 	if req.Metadata != nil {
 		body["metadata"] = req.Metadata
 	}
@@ -397,9 +601,92 @@ func (c *Client) UpdateSchedule(ctx context.Context, req *UpdateExtractionSchedu
 	return &resp, nil
 }
 
+// CreateOrUpdateSchedule creates the extraction schedule named req.Name, or
+// updates it if one with that name already exists in the environment and
+// project, so repeated runs of the same configuration converge on a single
+// schedule. On update, fields left nil keep their current values. Names are
+// matched exactly; if more than one schedule has the name, an error is
+// returned and nothing is changed.
+func (c *Client) CreateOrUpdateSchedule(ctx context.Context, req *CreateExtractionScheduleRequest) (*CreateOrUpdateScheduleResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findScheduleByName(ctx, req.Name, req.Environment, req.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		created, err := c.CreateSchedule(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return &CreateOrUpdateScheduleResponse{ID: created.ID, Created: true}, nil
+	}
+
+	var config map[string]interface{}
+	if req.Config != nil {
+		data, err := json.Marshal(req.Config)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, err
+		}
+	}
+	_, err = c.UpdateSchedule(ctx, &UpdateExtractionScheduleRequest{
+		ID:              existing.ID,
+		Environment:     req.Environment,
+		ProjectID:       req.ProjectID,
+		Frequency:       req.Frequency,
+		Config:          config,
+		DetectChanges:   req.DetectChanges,
+		ChangeThreshold: req.ChangeThreshold,
+		WebhookURL:      req.WebhookURL,
+		WebhookSecret:   req.WebhookSecret,
+		AlertConfig:     req.AlertConfig,
+		Tags:            req.Tags,
+		Metadata:        req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreateOrUpdateScheduleResponse{ID: existing.ID, Created: false}, nil
+}
+
+// findScheduleByName pages through the extraction schedules in an environment and
+// project and returns the one named name, or nil if there is none.
+func (c *Client) findScheduleByName(ctx context.Context, name string, env *types.Environment, projectID *string) (*ExtractionSchedule, error) {
+	var found *ExtractionSchedule
+	list := &ListSchedulesRequest{Environment: env, ProjectID: projectID}
+	for {
+		page, err := c.ListSchedules(ctx, list)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Items {
+			if page.Items[i].Name != name {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("stack0: more than one extraction schedule is named %q", name)
+			}
+			found = &page.Items[i]
+		}
+		if page.NextCursor == nil || *page.NextCursor == "" {
+			return found, nil
+		}
+		list.Cursor = page.NextCursor
+	}
+}
+
 // GetSchedule retrieves a schedule by ID.
 func (c *Client) GetSchedule(ctx context.Context, req *GetScheduleRequest) (*ExtractionSchedule, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -421,7 +708,12 @@ func (c *Client) GetSchedule(ctx context.Context, req *GetScheduleRequest) (*Ext
 
 // ListSchedules lists schedules with pagination and filters.
 func (c *Client) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (*SchedulesResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("type", "extraction")
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -429,10 +721,12 @@ func (c *Client) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (
 		if req.ProjectID != nil {
 			params.Set("projectId", *req.ProjectID)
 		}
-		params.Set("type", "extraction")
 		if req.IsActive != nil {
 			params.Set("isActive", strconv.FormatBool(*req.IsActive))
 		}
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
 		if req.Limit != nil {
 			params.Set("limit", strconv.Itoa(*req.Limit))
 		}
@@ -441,13 +735,8 @@ func (c *Client) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (
 		}
 	}
 
-	path := "/webdata/schedules"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
-	}
-
 	var resp SchedulesResponse
-	if err := c.http.Get(ctx, path, &resp); err != nil {
+	if err := c.http.Get(ctx, "/webdata/schedules?"+params.Encode(), &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -455,7 +744,11 @@ func (c *Client) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (
 
 // DeleteSchedule deletes a schedule.
 func (c *Client) DeleteSchedule(ctx context.Context, req *GetScheduleRequest) (*SuccessResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -469,8 +762,8 @@ func (c *Client) DeleteSchedule(ctx context.Context, req *GetScheduleRequest) (*
 	}
 
 	body := map[string]interface{}{"id": req.ID}
-	if req.Environment != nil {
-		body["environment"] = *req.Environment
+	if env := c.http.EnvironmentOrDefault(req.Environment); env != "" {
+		body["environment"] = env
 	}
 	if req.ProjectID != nil {
 		body["projectId"] = *req.ProjectID
@@ -485,7 +778,11 @@ func (c *Client) DeleteSchedule(ctx context.Context, req *GetScheduleRequest) (*
 
 // ToggleSchedule toggles a schedule on or off.
 func (c *Client) ToggleSchedule(ctx context.Context, req *GetScheduleRequest) (*ToggleResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -507,7 +804,11 @@ func (c *Client) ToggleSchedule(ctx context.Context, req *GetScheduleRequest) (*
 
 // GetUsage gets usage statistics.
 func (c *Client) GetUsage(ctx context.Context, req *GetUsageRequest) (*ExtractionUsage, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -518,6 +819,9 @@ func (c *Client) GetUsage(ctx context.Context, req *GetUsageRequest) (*Extractio
 		if req.PeriodEnd != nil {
 			params.Set("periodEnd", *req.PeriodEnd)
 		}
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
 	}
 
 	path := "/webdata/usage"
@@ -534,7 +838,11 @@ func (c *Client) GetUsage(ctx context.Context, req *GetUsageRequest) (*Extractio
 
 // GetUsageDaily gets daily usage breakdown.
 func (c *Client) GetUsageDaily(ctx context.Context, req *GetUsageRequest) (*GetDailyUsageResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -545,6 +853,9 @@ func (c *Client) GetUsageDaily(ctx context.Context, req *GetUsageRequest) (*GetD
 		if req.PeriodEnd != nil {
 			params.Set("periodEnd", *req.PeriodEnd)
 		}
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
 	}
 
 	path := "/webdata/usage/daily"
@@ -558,3 +869,110 @@ func (c *Client) GetUsageDaily(ctx context.Context, req *GetUsageRequest) (*GetD
 	}
 	return &resp, nil
 }
+
+// ListScheduleFailures lists failed runs across schedules, newest first.
+// Set ScheduleID to restrict the feed to a single schedule.
+func (c *Client) ListScheduleFailures(ctx context.Context, req *ListScheduleFailuresRequest) (*ScheduleFailuresResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("type", "extraction")
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.ProjectID != nil {
+			params.Set("projectId", *req.ProjectID)
+		}
+		if req.ScheduleID != nil {
+			params.Set("scheduleId", *req.ScheduleID)
+		}
+		client.SetTime(params, "since", req.Since)
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Cursor != nil {
+			params.Set("cursor", *req.Cursor)
+		}
+	}
+
+	var resp ScheduleFailuresResponse
+	if err := c.http.Get(ctx, "/webdata/schedules/failures?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SubmitFeedback reports corrections for an extraction's wrongly extracted
+// fields. Feedback is used to improve later extractions with the same schema
+// and is reflected in GetAccuracyReport.
+func (c *Client) SubmitFeedback(ctx context.Context, req *SubmitFeedbackRequest) (*FeedbackResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req.Environment != nil {
+		params.Set("environment", string(*req.Environment))
+	}
+	if req.ProjectID != nil {
+		params.Set("projectId", *req.ProjectID)
+	}
+
+	path := "/webdata/extractions/" + req.ID + "/feedback"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	corrections := req.Corrections
+	if corrections == nil {
+		corrections = []FieldCorrection{}
+	}
+	body := map[string]interface{}{"corrections": corrections}
+
+	var resp FeedbackResponse
+	if err := c.http.Post(ctx, path, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAccuracyReport gets per-schema and per-field accuracy based on submitted
+// feedback.
+func (c *Client) GetAccuracyReport(ctx context.Context, req *GetAccuracyReportRequest) (*AccuracyReport, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.ProjectID != nil {
+			params.Set("projectId", *req.ProjectID)
+		}
+		if req.SchemaHash != nil {
+			params.Set("schemaHash", *req.SchemaHash)
+		}
+		if req.PeriodStart != nil {
+			params.Set("periodStart", *req.PeriodStart)
+		}
+		if req.PeriodEnd != nil {
+			params.Set("periodEnd", *req.PeriodEnd)
+		}
+	}
+
+	path := "/webdata/extractions/accuracy"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp AccuracyReport
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -48,6 +48,51 @@ func TestClient_Extract(t *testing.T) {
 	assert.Equal(t, ExtractionStatusPending, resp.Status)
 }
 
+func TestClient_Extract_SummarizeAndClassify(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"maxWords": float64(50)}, body["summarize"])
+		assert.Equal(t, map[string]interface{}{
+			"labels": []interface{}{"pricing", "docs", "blog"},
+		}, body["classify"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateExtractionResponse{ID: "ext-123", Status: ExtractionStatusPending})
+	})
+	defer server.Close()
+
+	maxWords := 50
+	_, err := extractionClient.Extract(context.Background(), &CreateExtractionRequest{
+		URL:       "https://example.com/pricing",
+		Summarize: &SummarizeOptions{MaxWords: &maxWords},
+		Classify:  &ClassifyOptions{Labels: []string{"pricing", "docs", "blog"}},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestClient_Get_SummaryAndClassification(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "ext-123",
+			"status": "completed",
+			"summary": "Three plans starting at $9/month.",
+			"classification": [{"label": "pricing", "score": 0.92}, {"label": "docs", "score": 0.05}]
+		}`))
+	})
+	defer server.Close()
+
+	resp, err := extractionClient.Get(context.Background(), &GetExtractionRequest{ID: "ext-123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Three plans starting at $9/month.", *resp.Summary)
+	require.Len(t, resp.Classification, 2)
+	assert.Equal(t, LabelScore{Label: "pricing", Score: 0.92}, resp.Classification[0])
+}
+
 func TestClient_Extract_WithSchema(t *testing.T) {
 	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		var req CreateExtractionRequest
@@ -130,6 +175,42 @@ func TestClient_List(t *testing.T) {
 	assert.Len(t, resp.Items, 2)
 }
 
+func TestClient_Fields(t *testing.T) {
+	t.Run("get", func(t *testing.T) {
+		extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "status,extractedData", r.URL.Query().Get("fields"))
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ExtractionResult{ID: "ext-123", Status: ExtractionStatusCompleted})
+		})
+		defer server.Close()
+
+		resp, err := extractionClient.Get(context.Background(), &GetExtractionRequest{
+			ID:     "ext-123",
+			Fields: []ExtractionField{ExtractionFieldStatus, ExtractionFieldExtractedData},
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.RawHTML)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "url,status", r.URL.Query().Get("fields"))
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ListExtractionsResponse{})
+		})
+		defer server.Close()
+
+		_, err := extractionClient.List(context.Background(), &ListExtractionsRequest{
+			Fields: []ExtractionField{ExtractionFieldURL, ExtractionFieldStatus},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
 func TestClient_Delete(t *testing.T) {
 	extractionID := "ext-123"
 	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -452,6 +533,155 @@ func TestClient_BatchAndWait_Success(t *testing.T) {
 	assert.Equal(t, types.BatchJobStatusCompleted, resp.Status)
 }
 
+func TestClient_Crawl(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/webdata/crawls", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "https://docs.example.com", body["seedUrl"])
+		assert.Equal(t, float64(3), body["maxDepth"])
+		assert.Equal(t, []interface{}{"/guides/**"}, body["includePatterns"])
+		assert.Equal(t, "markdown", body["mode"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateCrawlResponse{ID: "crawl-123", Status: CrawlStatusPending})
+	})
+	defer server.Close()
+
+	maxDepth := 3
+	mode := ExtractionModeMarkdown
+	resp, err := extractionClient.Crawl(context.Background(), &CrawlRequest{
+		SeedURL:         "https://docs.example.com",
+		MaxDepth:        &maxDepth,
+		IncludePatterns: []string{"/guides/**"},
+		Mode:            &mode,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "crawl-123", resp.ID)
+	assert.Equal(t, CrawlStatusPending, resp.Status)
+}
+
+func TestClient_Crawl_RequiresSeedURL(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("request should not be sent")
+	})
+	defer server.Close()
+
+	_, err := extractionClient.Crawl(context.Background(), &CrawlRequest{})
+
+	assert.ErrorIs(t, err, types.ErrInvalidRequest)
+}
+
+func TestClient_GetCrawlResults(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/webdata/crawls/crawl-123/results", r.URL.Path)
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+		assert.Equal(t, "cursor-1", r.URL.Query().Get("cursor"))
+
+		markdown := "# Getting started"
+		parent := "https://docs.example.com"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CrawlResultsResponse{
+			Items: []CrawlPage{{
+				ExtractionResult: ExtractionResult{
+					ID:       "ext-1",
+					URL:      "https://docs.example.com/guides/start",
+					Status:   ExtractionStatusCompleted,
+					Markdown: &markdown,
+				},
+				Depth:     1,
+				ParentURL: &parent,
+			}},
+		})
+	})
+	defer server.Close()
+
+	limit := 50
+	cursor := "cursor-1"
+	resp, err := extractionClient.GetCrawlResults(context.Background(), &GetCrawlResultsRequest{
+		ID:     "crawl-123",
+		Limit:  &limit,
+		Cursor: &cursor,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	page := resp.Items[0]
+	assert.Equal(t, "https://docs.example.com/guides/start", page.URL)
+	assert.Equal(t, "# Getting started", *page.Markdown)
+	assert.Equal(t, 1, page.Depth)
+	assert.Equal(t, "https://docs.example.com", *page.ParentURL)
+	assert.Nil(t, resp.NextCursor)
+}
+
+func TestClient_CrawlAndWait_Success(t *testing.T) {
+	var callCount int32
+
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(CreateCrawlResponse{ID: "crawl-123", Status: CrawlStatusPending})
+			return
+		}
+
+		assert.Equal(t, "/webdata/crawls/crawl-123", r.URL.Path)
+		status := CrawlStatusRunning
+		if atomic.AddInt32(&callCount, 1) >= 2 {
+			status = CrawlStatusCompleted
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Crawl{
+			ID:             "crawl-123",
+			Status:         status,
+			PagesProcessed: 12,
+			PagesSucceeded: 12,
+		})
+	})
+	defer server.Close()
+
+	resp, err := extractionClient.CrawlAndWait(context.Background(), &CrawlRequest{
+		SeedURL: "https://docs.example.com",
+	}, &ExtractAndWaitOptions{
+		PollInterval: 10 * time.Millisecond,
+		Timeout:      5 * time.Second,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, CrawlStatusCompleted, resp.Status)
+	assert.Equal(t, 12, resp.PagesSucceeded)
+}
+
+func TestClient_CrawlAndWait_Failed(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(CreateCrawlResponse{ID: "crawl-123", Status: CrawlStatusPending})
+			return
+		}
+
+		errorMessage := "Seed URL returned 404"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Crawl{ID: "crawl-123", Status: CrawlStatusFailed, Error: &errorMessage})
+	})
+	defer server.Close()
+
+	resp, err := extractionClient.CrawlAndWait(context.Background(), &CrawlRequest{
+		SeedURL: "https://docs.example.com",
+	}, &ExtractAndWaitOptions{
+		PollInterval: 10 * time.Millisecond,
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.Equal(t, "Seed URL returned 404", err.Error())
+}
+
 func TestClient_CreateSchedule(t *testing.T) {
 	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
@@ -527,6 +757,91 @@ func TestClient_ListSchedules(t *testing.T) {
 	assert.Len(t, resp.Items, 2)
 }
 
+func TestClient_CreateOrUpdateSchedule(t *testing.T) {
+	t.Run("creates a missing schedule", func(t *testing.T) {
+		extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if r.Method == http.MethodGet {
+				json.NewEncoder(w).Encode(SchedulesResponse{
+					Items: []ExtractionSchedule{{ID: "sched-1", Name: "Other"}},
+				})
+				return
+			}
+			assert.Equal(t, "/webdata/schedules", r.URL.Path)
+			json.NewEncoder(w).Encode(CreateScheduleResponse{ID: "sched-2"})
+		})
+		defer server.Close()
+
+		resp, err := extractionClient.CreateOrUpdateSchedule(context.Background(), &CreateExtractionScheduleRequest{
+			Name: "Nightly",
+			URL:  "https://example.com",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "sched-2", resp.ID)
+		assert.True(t, resp.Created)
+	})
+
+	t.Run("updates an existing schedule", func(t *testing.T) {
+		var updated bool
+		nextCursor := "page-2"
+		weekly := types.ScheduleFrequencyWeekly
+		extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if r.Method == http.MethodGet {
+				if r.URL.Query().Get("cursor") == "" {
+					json.NewEncoder(w).Encode(SchedulesResponse{
+						Items:      []ExtractionSchedule{{ID: "sched-1", Name: "Other"}},
+						NextCursor: &nextCursor,
+					})
+					return
+				}
+				json.NewEncoder(w).Encode(SchedulesResponse{
+					Items: []ExtractionSchedule{{ID: "sched-2", Name: "Nightly"}},
+				})
+				return
+			}
+			updated = true
+			assert.Equal(t, "/webdata/schedules/sched-2", r.URL.Path)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "weekly", body["frequency"])
+			json.NewEncoder(w).Encode(SuccessResponse{Success: true})
+		})
+		defer server.Close()
+
+		resp, err := extractionClient.CreateOrUpdateSchedule(context.Background(), &CreateExtractionScheduleRequest{
+			Name:      "Nightly",
+			URL:       "https://example.com",
+			Frequency: &weekly,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, "sched-2", resp.ID)
+		assert.False(t, resp.Created)
+	})
+
+	t.Run("ambiguous name", func(t *testing.T) {
+		extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SchedulesResponse{
+				Items: []ExtractionSchedule{{ID: "sched-1", Name: "Nightly"}, {ID: "sched-2", Name: "Nightly"}},
+			})
+		})
+		defer server.Close()
+
+		_, err := extractionClient.CreateOrUpdateSchedule(context.Background(), &CreateExtractionScheduleRequest{
+			Name: "Nightly",
+			URL:  "https://example.com",
+		})
+
+		assert.EqualError(t, err, `stack0: more than one extraction schedule is named "Nightly"`)
+	})
+}
+
 func TestClient_DeleteSchedule(t *testing.T) {
 	scheduleID := "sched-123"
 	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -565,6 +880,68 @@ func TestClient_ToggleSchedule(t *testing.T) {
 	assert.False(t, resp.IsActive)
 }
 
+func TestClient_CreateSchedule_WithAlertConfig(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+
+		alertConfig, ok := body["alertConfig"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, true, alertConfig["enabled"])
+		assert.Equal(t, float64(3), alertConfig["consecutiveFailures"])
+		assert.Equal(t, []interface{}{"ops@example.com"}, alertConfig["emails"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateScheduleResponse{ID: "sched-123"})
+	})
+	defer server.Close()
+
+	consecutiveFailures := 3
+	resp, err := extractionClient.CreateSchedule(context.Background(), &CreateExtractionScheduleRequest{
+		Name: "My Schedule",
+		URL:  "https://example.com",
+		AlertConfig: &types.ScheduleAlertConfig{
+			Enabled:             true,
+			ConsecutiveFailures: &consecutiveFailures,
+			Emails:              []string{"ops@example.com"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "sched-123", resp.ID)
+}
+
+func TestClient_ListScheduleFailures(t *testing.T) {
+	since := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/webdata/schedules/failures", r.URL.Path)
+		assert.Equal(t, "extraction", r.URL.Query().Get("type"))
+		assert.Equal(t, "sched-123", r.URL.Query().Get("scheduleId"))
+		assert.Equal(t, "2024-01-15T10:00:00Z", r.URL.Query().Get("since"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ScheduleFailuresResponse{
+			Items: []types.ScheduleFailure{
+				{ID: "fail-1", ScheduleID: "sched-123", ConsecutiveFailures: 3, AlertSent: true},
+			},
+		})
+	})
+	defer server.Close()
+
+	scheduleID := "sched-123"
+	resp, err := extractionClient.ListScheduleFailures(context.Background(), &ListScheduleFailuresRequest{
+		ScheduleID: &scheduleID,
+		Since:      &since,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, 3, resp.Items[0].ConsecutiveFailures)
+	assert.True(t, resp.Items[0].AlertSent)
+}
+
 func TestClient_GetUsage(t *testing.T) {
 	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodGet, r.Method)
@@ -611,6 +988,74 @@ func TestClient_GetUsageDaily(t *testing.T) {
 	assert.Len(t, resp.Days, 2)
 }
 
+func TestClient_List_WithTags(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webdata/extractions", r.URL.Path)
+		assert.Equal(t, "team-growth,campaign-q3", r.URL.Query().Get("tags"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListExtractionsResponse{
+			Items: []ExtractionResult{{ID: "item-1", Tags: []string{"team-growth"}}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := extractionClient.List(context.Background(), &ListExtractionsRequest{
+		Tags: []string{"team-growth", "campaign-q3"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, []string{"team-growth"}, resp.Items[0].Tags)
+}
+
+func TestClient_CreateSchedule_WithTags(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"team-growth"}, body["tags"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateScheduleResponse{ID: "sched-123"})
+	})
+	defer server.Close()
+
+	_, err := extractionClient.CreateSchedule(context.Background(), &CreateExtractionScheduleRequest{
+		Name: "My Schedule",
+		URL:  "https://example.com",
+		Tags: []string{"team-growth"},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestClient_GetUsage_ByTag(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webdata/usage", r.URL.Path)
+		assert.Equal(t, "team-growth,team-search", r.URL.Query().Get("tags"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ExtractionUsage{
+			ExtractionCreditsUsed: 300,
+			ByTag: []TagUsage{
+				{Tag: "team-growth", Extractions: 100, CreditsUsed: 100},
+				{Tag: "team-search", Screenshots: 50, Extractions: 150, CreditsUsed: 200},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := extractionClient.GetUsage(context.Background(), &GetUsageRequest{
+		Tags: []string{"team-growth", "team-search"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.ByTag, 2)
+	assert.Equal(t, "team-search", resp.ByTag[1].Tag)
+	assert.Equal(t, 200, resp.ByTag[1].CreditsUsed)
+}
+
 func TestExtractionStatus_Constants(t *testing.T) {
 	assert.Equal(t, ExtractionStatus("pending"), ExtractionStatusPending)
 	assert.Equal(t, ExtractionStatus("processing"), ExtractionStatusProcessing)
@@ -624,3 +1069,129 @@ func TestExtractionMode_Constants(t *testing.T) {
 	assert.Equal(t, ExtractionMode("markdown"), ExtractionModeMarkdown)
 	assert.Equal(t, ExtractionMode("raw"), ExtractionModeRaw)
 }
+
+func TestClient_SubmitFeedback(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/webdata/extractions/ext-123/feedback", r.URL.Path)
+		assert.Equal(t, "production", r.URL.Query().Get("environment"))
+
+		var body struct {
+			Corrections []map[string]interface{} `json:"corrections"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Len(t, body.Corrections, 2)
+		assert.Equal(t, "price.amount", body.Corrections[0]["field"])
+		assert.Equal(t, 19.99, body.Corrections[0]["value"])
+		assert.Contains(t, body.Corrections[1], "value")
+		assert.Nil(t, body.Corrections[1]["value"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(FeedbackResponse{ID: "fb-1", ExtractionID: "ext-123", Corrections: 2})
+	})
+	defer server.Close()
+
+	env := types.EnvironmentProduction
+	resp, err := extractionClient.SubmitFeedback(context.Background(), &SubmitFeedbackRequest{
+		ID:          "ext-123",
+		Environment: &env,
+		Corrections: []FieldCorrection{
+			{Field: "price.amount", Value: 19.99},
+			{Field: "discount", Value: nil},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Corrections)
+}
+
+func TestClient_SubmitFeedback_Confirm(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, []interface{}{}, body["corrections"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(FeedbackResponse{ID: "fb-2", ExtractionID: "ext-123"})
+	})
+	defer server.Close()
+
+	resp, err := extractionClient.SubmitFeedback(context.Background(), &SubmitFeedbackRequest{ID: "ext-123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, resp.Corrections)
+}
+
+func TestClient_GetAccuracyReport(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/webdata/extractions/accuracy", r.URL.Path)
+		assert.Equal(t, "abc123", r.URL.Query().Get("schemaHash"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(AccuracyReport{
+			Schemas: []SchemaAccuracy{{
+				SchemaHash:  "abc123",
+				Extractions: 500,
+				Reviewed:    40,
+				Accuracy:    0.9,
+				Fields:      []FieldAccuracy{{Field: "price.amount", Corrections: 4, Accuracy: 0.9}},
+			}},
+		})
+	})
+	defer server.Close()
+
+	schemaHash := "abc123"
+	resp, err := extractionClient.GetAccuracyReport(context.Background(), &GetAccuracyReportRequest{SchemaHash: &schemaHash})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Schemas, 1)
+	assert.Equal(t, 0.9, resp.Schemas[0].Accuracy)
+	assert.Equal(t, "price.amount", resp.Schemas[0].Fields[0].Field)
+}
+
+func TestClient_List_WithMetadata(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webdata/extractions", r.URL.Path)
+		assert.Equal(t, "ord_123", r.URL.Query().Get("metadata[orderId]"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListExtractionsResponse{})
+	})
+	defer server.Close()
+
+	_, err := extractionClient.List(context.Background(), &ListExtractionsRequest{
+		Metadata: map[string]string{"orderId": "ord_123"},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestClient_MetadataIndex(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webdata/extractions/metadata-index", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPut:
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []interface{}{"orderId", "customerId"}, body["keys"])
+		case http.MethodGet:
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(types.MetadataIndex{Keys: []string{"orderId", "customerId"}, MaxKeys: 10})
+	})
+	defer server.Close()
+
+	index, err := extractionClient.SetMetadataIndex(context.Background(), []string{"orderId", "customerId"})
+	require.NoError(t, err)
+	assert.Equal(t, 10, index.MaxKeys)
+
+	index, err = extractionClient.GetMetadataIndex(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orderId", "customerId"}, index.Keys)
+}
@@ -0,0 +1,172 @@
+package extraction
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// Decode decodes the result's extracted data into v, which must be a non-nil
+// pointer, typically to a struct whose json tags match the extraction schema.
+//
+// Errors name the offending key, e.g. `"offers[0].price" is string, not
+// float64`. After a successful decode, Decode also reports the required
+// fields with no value in the data, as SchemaFor defines them; v is still
+// filled in with everything that was found.
+func (r *ExtractionResult) Decode(v interface{}) error {
+	if r.ExtractedData == nil {
+		return fmt.Errorf("extraction: result %s has no extracted data", r.ID)
+	}
+	return decodeValue(r.ExtractedData, "", v)
+}
+
+// DecodeField decodes the value of a single top-level key of the result's
+// extracted data into v, e.g. a list of products:
+//
+//	var products []Product
+//	err := result.DecodeField("products", &products)
+//
+// It returns an error listing the available keys if key is missing.
+func (r *ExtractionResult) DecodeField(key string, v interface{}) error {
+	value, ok := r.ExtractedData[key]
+	if !ok {
+		keys := make([]string, 0, len(r.ExtractedData))
+		for k := range r.ExtractedData {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return fmt.Errorf("extraction: extracted data has no key %q (keys: %s)", key, strings.Join(keys, ", "))
+	}
+	return decodeValue(value, key, v)
+}
+
+// decodeValue decodes data, found at path in the extracted data, into v.
+func decodeValue(data interface{}, path string, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("extraction: decode target must be a non-nil pointer, not %T", v)
+	}
+	target := rv.Type().Elem()
+	what := "extracted data"
+	if path != "" {
+		what = fmt.Sprintf("extracted data %q", path)
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("extraction: encode %s: %w", what, err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			at := joinPath(path, indexPath(typeErr.Field))
+			if at == "" {
+				return fmt.Errorf("extraction: decode %s into %s: value is %s, not %s: %w", what, target, typeErr.Value, typeErr.Type, err)
+			}
+			return fmt.Errorf("extraction: decode %s into %s: %q is %s, not %s: %w", what, target, at, typeErr.Value, typeErr.Type, err)
+		}
+		return fmt.Errorf("extraction: decode %s into %s: %w", what, target, err)
+	}
+
+	var missing []string
+	missingKeys(target, data, path, &missing)
+	if len(missing) > 0 {
+		return fmt.Errorf("extraction: decode %s into %s: missing required %s", what, target, quoteList(missing))
+	}
+	return nil
+}
+
+// missingKeys appends to missing the paths of required struct fields of t
+// that have no value in data, descending into nested objects and arrays.
+func missingKeys(t reflect.Type, data interface{}, path string, missing *[]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType || reflect.PointerTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := data.(map[string]interface{})
+		if !ok {
+			return
+		}
+		for _, f := range types.JSONFields(t) {
+			value, ok := obj[f.JSONName]
+			if !ok || value == nil {
+				if requiredField(f) {
+					*missing = append(*missing, joinPath(path, f.JSONName))
+				}
+				continue
+			}
+			missingKeys(f.Type, value, joinPath(path, f.JSONName), missing)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := data.([]interface{})
+		if !ok {
+			return
+		}
+		for i, item := range items {
+			missingKeys(t.Elem(), item, fmt.Sprintf("%s[%d]", path, i), missing)
+		}
+	case reflect.Map:
+		obj, ok := data.(map[string]interface{})
+		if !ok {
+			return
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			missingKeys(t.Elem(), obj[k], joinPath(path, k), missing)
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	if key == "" {
+		return path
+	}
+	return path + "." + key
+}
+
+// indexPath rewrites the numeric segments encoding/json uses for array
+// elements in error paths, as in "offers.0.price", to "offers[0].price".
+func indexPath(field string) string {
+	if field == "" {
+		return field
+	}
+	segments := strings.Split(field, ".")
+	var b strings.Builder
+	for i, seg := range segments {
+		if _, err := strconv.Atoi(seg); err == nil && i > 0 {
+			b.WriteString("[" + seg + "]")
+			continue
+		}
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(seg)
+	}
+	return b.String()
+}
+
+func quoteList(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, k := range keys {
+		quoted[i] = fmt.Sprintf("%q", k)
+	}
+	return strings.Join(quoted, ", ")
+}
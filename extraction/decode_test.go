@@ -0,0 +1,96 @@
+package extraction
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeTestResult(t *testing.T, data string) *ExtractionResult {
+	t.Helper()
+	result := &ExtractionResult{ID: "ext-123", Status: ExtractionStatusCompleted}
+	require.NoError(t, json.Unmarshal([]byte(data), &result.ExtractedData))
+	return result
+}
+
+func TestExtractionResult_Decode(t *testing.T) {
+	result := decodeTestResult(t, `{
+		"url": "https://example.com/product",
+		"title": "Widget",
+		"offers": [{"seller": "Acme", "price": 9.5}],
+		"published": "2024-03-05T13:30:00Z"
+	}`)
+
+	var product typedProduct
+	err := result.Decode(&product)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Widget", product.Title)
+	assert.Nil(t, product.InStock)
+	assert.Equal(t, []typedOffer{{Seller: "Acme", Price: 9.5}}, product.Offers)
+}
+
+func TestExtractionResult_Decode_TypeMismatch(t *testing.T) {
+	result := decodeTestResult(t, `{"title": "Widget", "offers": [{"seller": "Acme", "price": "9.50"}]}`)
+
+	var product typedProduct
+	err := result.Decode(&product)
+
+	var typeErr *json.UnmarshalTypeError
+	require.ErrorAs(t, err, &typeErr)
+	assert.Contains(t, err.Error(), `decode extracted data into extraction.typedProduct: "offers`)
+	assert.Contains(t, err.Error(), `price" is string, not float64`)
+}
+
+func TestIndexPath(t *testing.T) {
+	assert.Equal(t, "offers[0].price", indexPath("offers.0.price"))
+	assert.Equal(t, "offers.price", indexPath("offers.price"))
+	assert.Equal(t, "matrix[1][2]", indexPath("matrix.1.2"))
+	assert.Equal(t, "", indexPath(""))
+}
+
+func TestExtractionResult_Decode_MissingKeys(t *testing.T) {
+	result := decodeTestResult(t, `{
+		"url": "https://example.com/product",
+		"title": null,
+		"offers": [{"seller": "Acme", "price": 9.5}, {"price": 8}],
+		"published": "2024-03-05T13:30:00Z"
+	}`)
+
+	var product typedProduct
+	err := result.Decode(&product)
+
+	assert.EqualError(t, err, `extraction: decode extracted data into extraction.typedProduct: missing required "title", "offers[1].seller"`)
+	assert.Equal(t, "https://example.com/product", product.URL)
+}
+
+func TestExtractionResult_Decode_NoData(t *testing.T) {
+	result := &ExtractionResult{ID: "ext-123"}
+
+	var product typedProduct
+	err := result.Decode(&product)
+
+	assert.EqualError(t, err, "extraction: result ext-123 has no extracted data")
+}
+
+func TestExtractionResult_DecodeField(t *testing.T) {
+	result := decodeTestResult(t, `{"offers": [{"seller": "Acme", "price": 9.5}], "count": 1}`)
+
+	var offers []typedOffer
+	err := result.DecodeField("offers", &offers)
+
+	require.NoError(t, err)
+	assert.Equal(t, []typedOffer{{Seller: "Acme", Price: 9.5}}, offers)
+
+	err = result.DecodeField("products", &offers)
+	assert.EqualError(t, err, `extraction: extracted data has no key "products" (keys: count, offers)`)
+
+	var count string
+	err = result.DecodeField("count", &count)
+	assert.ErrorContains(t, err, `decode extracted data "count" into string: "count" is number, not string`)
+
+	err = result.DecodeField("offers", offers)
+	assert.EqualError(t, err, "extraction: decode target must be a non-nil pointer, not []extraction.typedOffer")
+}
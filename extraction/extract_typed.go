@@ -0,0 +1,165 @@
+package extraction
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// ExtractAs extracts the page at req.URL into a value of struct type T. The
+// extraction schema is derived from T with SchemaFor, overriding req.Mode and
+// req.Schema; the other request fields, such as Prompt, are sent as given.
+// It waits for the extraction with ExtractAndWait's default options and
+// decodes the extracted data into T with ExtractionResult.Decode.
+//
+//	type Product struct {
+//		Title   string   `json:"title"`
+//		Price   float64  `json:"price" description:"Price in the page's currency"`
+//		InStock *bool    `json:"inStock"`
+//		Tags    []string `json:"tags,omitempty"`
+//	}
+//	product, err := extraction.ExtractAs[Product](ctx, client.Extraction, &extraction.CreateExtractionRequest{
+//		URL: "https://example.com/product",
+//	})
+func ExtractAs[T any](ctx context.Context, c *Client, req *CreateExtractionRequest) (*T, error) {
+	schema, err := SchemaFor[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	r := CreateExtractionRequest{}
+	if req != nil {
+		r = *req
+	}
+	mode := ExtractionModeSchema
+	r.Mode = &mode
+	r.Schema = schema
+
+	result, err := c.ExtractAndWait(ctx, &r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var v T
+	if err := result.Decode(&v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// SchemaFor returns the JSON Schema for struct type T used by ExtractAs.
+// Properties are named by the fields' json tags, following encoding/json's
+// rules for embedded structs. A field is required unless it is a pointer or
+// tagged omitempty, and a description tag is sent as the property's
+// description to guide the extraction. time.Time fields are date-time
+// strings.
+//
+// SchemaFor returns an error if T is not a struct, is recursive, or has a
+// field of a type JSON cannot represent, such as a channel or func.
+func SchemaFor[T any]() (map[string]interface{}, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("extraction: schema type must be a struct, not %s", t)
+	}
+	return typeSchema(t, make(map[reflect.Type]bool))
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// typeSchema returns the JSON Schema for values of type t. seen holds the
+// struct types being expanded, to detect recursive types.
+func typeSchema(t reflect.Type, seen map[reflect.Type]bool) (map[string]interface{}, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return map[string]interface{}{"type": "string", "format": "date-time"}, nil
+	}
+	if reflect.PointerTo(t).Implements(unmarshalerType) {
+		// Custom decoding accepts any shape; leave the value unconstrained.
+		return map[string]interface{}{}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}, nil
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}, nil
+	case reflect.Interface:
+		return map[string]interface{}{}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := typeSchema(t.Elem(), seen)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "array", "items": items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("extraction: unsupported map key type %s", t.Key())
+		}
+		values, err := typeSchema(t.Elem(), seen)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"type": "object", "additionalProperties": values}, nil
+	case reflect.Struct:
+		if seen[t] {
+			return nil, fmt.Errorf("extraction: recursive type %s", t)
+		}
+		seen[t] = true
+		defer delete(seen, t)
+
+		properties := make(map[string]interface{})
+		var required []string
+		if err := addFieldSchemas(t, properties, &required, seen); err != nil {
+			return nil, err
+		}
+		schema := map[string]interface{}{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema, nil
+	default:
+		return nil, fmt.Errorf("extraction: unsupported type %s", t)
+	}
+}
+
+// addFieldSchemas adds the schemas of the fields of struct type t to
+// properties, and the names of required fields to required.
+func addFieldSchemas(t reflect.Type, properties map[string]interface{}, required *[]string, seen map[reflect.Type]bool) error {
+	for _, f := range types.JSONFields(t) {
+		schema, err := typeSchema(f.Type, seen)
+		if err != nil {
+			return fmt.Errorf("%w (field %s.%s)", err, f.Owner, f.Name)
+		}
+		if description := f.Tag.Get("description"); description != "" {
+			schema["description"] = description
+		}
+		properties[f.JSONName] = schema
+		if requiredField(f) {
+			*required = append(*required, f.JSONName)
+		}
+	}
+	return nil
+}
+
+// requiredField reports whether a field must have a value: it is required
+// unless it is a pointer or tagged omitempty.
+func requiredField(f types.JSONField) bool {
+	return f.Type.Kind() != reflect.Pointer && !f.OmitEmpty
+}
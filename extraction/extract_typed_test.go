@@ -0,0 +1,153 @@
+package extraction
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type typedOffer struct {
+	Seller string  `json:"seller"`
+	Price  float64 `json:"price" description:"Price in USD"`
+}
+
+type typedBase struct {
+	URL string `json:"url"`
+}
+
+type typedProduct struct {
+	typedBase
+	Title     string            `json:"title"`
+	InStock   *bool             `json:"inStock"`
+	Rating    int               `json:"rating,omitempty"`
+	Offers    []typedOffer      `json:"offers"`
+	Specs     map[string]string `json:"specs,omitempty"`
+	Published time.Time         `json:"published"`
+	Internal  string            `json:"-"`
+	note      string
+}
+
+func TestSchemaFor(t *testing.T) {
+	schema, err := SchemaFor[typedProduct]()
+	require.NoError(t, err)
+
+	b, err := json.Marshal(schema)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"url": {"type": "string"},
+			"title": {"type": "string"},
+			"inStock": {"type": "boolean"},
+			"rating": {"type": "integer"},
+			"offers": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"properties": {
+						"seller": {"type": "string"},
+						"price": {"type": "number", "description": "Price in USD"}
+					},
+					"required": ["seller", "price"]
+				}
+			},
+			"specs": {"type": "object", "additionalProperties": {"type": "string"}},
+			"published": {"type": "string", "format": "date-time"}
+		},
+		"required": ["url", "title", "offers", "published"]
+	}`, string(b))
+}
+
+type typedNode struct {
+	Name     string      `json:"name"`
+	Children []typedNode `json:"children"`
+}
+
+func TestSchemaFor_Unsupported(t *testing.T) {
+	_, err := SchemaFor[string]()
+	assert.ErrorContains(t, err, "must be a struct")
+
+	_, err = SchemaFor[typedNode]()
+	assert.ErrorContains(t, err, "recursive type")
+
+	_, err = SchemaFor[struct {
+		Done chan bool `json:"done"`
+	}]()
+	assert.ErrorContains(t, err, "unsupported type chan bool")
+}
+
+func TestExtractAs(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, "schema", body["mode"])
+			assert.Equal(t, "Only the main offer", body["prompt"])
+			schema, _ := body["schema"].(map[string]interface{})
+			assert.Contains(t, schema["properties"], "offers")
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(CreateExtractionResponse{ID: "ext-123", Status: ExtractionStatusPending})
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ExtractionResult{
+			ID:     "ext-123",
+			Status: ExtractionStatusCompleted,
+			ExtractedData: map[string]interface{}{
+				"url":       "https://example.com/product",
+				"title":     "Widget",
+				"inStock":   true,
+				"offers":    []interface{}{map[string]interface{}{"seller": "Acme", "price": 9.5}},
+				"published": "2024-03-05T13:30:00Z",
+			},
+		})
+	})
+	defer server.Close()
+
+	prompt := "Only the main offer"
+	product, err := ExtractAs[typedProduct](context.Background(), extractionClient, &CreateExtractionRequest{
+		URL:    "https://example.com/product",
+		Prompt: &prompt,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Widget", product.Title)
+	assert.Equal(t, "https://example.com/product", product.URL)
+	require.NotNil(t, product.InStock)
+	assert.True(t, *product.InStock)
+	assert.Equal(t, []typedOffer{{Seller: "Acme", Price: 9.5}}, product.Offers)
+	assert.Equal(t, time.Date(2024, 3, 5, 13, 30, 0, 0, time.UTC), product.Published)
+}
+
+func TestExtractAs_TypeMismatch(t *testing.T) {
+	extractionClient, server := setupExtractionTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(CreateExtractionResponse{ID: "ext-123", Status: ExtractionStatusPending})
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ExtractionResult{
+			ID:            "ext-123",
+			Status:        ExtractionStatusCompleted,
+			ExtractedData: map[string]interface{}{"title": 42},
+		})
+	})
+	defer server.Close()
+
+	product, err := ExtractAs[typedProduct](context.Background(), extractionClient, &CreateExtractionRequest{
+		URL: "https://example.com/product",
+	})
+
+	assert.Nil(t, product)
+	assert.ErrorContains(t, err, "decode extracted data into extraction.typedProduct")
+}
@@ -36,6 +36,30 @@ type PageMetadata struct {
 	Images      []string `json:"images,omitempty"`
 }
 
+// ExtractionField names a field of ExtractionResult for use with Fields on
+// Get and List requests. Requesting only the fields you need avoids
+// transferring large values such as RawHTML; ID is always returned.
+type ExtractionField string
+
+const (
+	ExtractionFieldURL              ExtractionField = "url"
+	ExtractionFieldMode             ExtractionField = "mode"
+	ExtractionFieldStatus           ExtractionField = "status"
+	ExtractionFieldExtractedData    ExtractionField = "extractedData"
+	ExtractionFieldMarkdown         ExtractionField = "markdown"
+	ExtractionFieldRawHTML          ExtractionField = "rawHtml"
+	ExtractionFieldPageMetadata     ExtractionField = "pageMetadata"
+	ExtractionFieldSummary          ExtractionField = "summary"
+	ExtractionFieldClassification   ExtractionField = "classification"
+	ExtractionFieldError            ExtractionField = "error"
+	ExtractionFieldProcessingTimeMs ExtractionField = "processingTimeMs"
+	ExtractionFieldTokensUsed       ExtractionField = "tokensUsed"
+	ExtractionFieldTags             ExtractionField = "tags"
+	ExtractionFieldMetadata         ExtractionField = "metadata"
+	ExtractionFieldCreatedAt        ExtractionField = "createdAt"
+	ExtractionFieldCompletedAt      ExtractionField = "completedAt"
+)
+
 // ExtractionResult represents an extraction result.
 type ExtractionResult struct {
 	ID               string                 `json:"id"`
@@ -44,19 +68,46 @@ type ExtractionResult struct {
 	Environment      types.Environment      `json:"environment"`
 	URL              string                 `json:"url"`
 	Mode             string                 `json:"mode"`
+	SchemaHash       *string                `json:"schemaHash,omitempty"`
 	Status           ExtractionStatus       `json:"status"`
 	ExtractedData    map[string]interface{} `json:"extractedData,omitempty"`
 	Markdown         *string                `json:"markdown,omitempty"`
 	RawHTML          *string                `json:"rawHtml,omitempty"`
 	PageMetadata     *PageMetadata          `json:"pageMetadata,omitempty"`
+	Summary          *string                `json:"summary,omitempty"`
+	Classification   []LabelScore           `json:"classification,omitempty"`
 	Error            *string                `json:"error,omitempty"`
 	ProcessingTimeMs *int64                 `json:"processingTimeMs,omitempty"`
 	TokensUsed       *int                   `json:"tokensUsed,omitempty"`
+	Tags             []string               `json:"tags,omitempty"`
 	Metadata         map[string]interface{} `json:"metadata,omitempty"`
 	CreatedAt        time.Time              `json:"createdAt"`
 	CompletedAt      *time.Time             `json:"completedAt,omitempty"`
 }
 
+// SummarizeOptions requests a summary of the page in ExtractionResult.Summary.
+// MaxWords bounds its length and Instructions steers what it focuses on.
+type SummarizeOptions struct {
+	MaxWords     *int    `json:"maxWords,omitempty"`
+	Instructions *string `json:"instructions,omitempty"`
+}
+
+// ClassifyOptions requests that the page be scored against Labels, with the
+// scores returned in ExtractionResult.Classification. By default scores sum
+// to 1 across labels; set MultiLabel to score each label independently
+// between 0 and 1.
+type ClassifyOptions struct {
+	Labels     []string `json:"labels"`
+	MultiLabel *bool    `json:"multiLabel,omitempty"`
+}
+
+// LabelScore is the score of a classification label, sorted by descending
+// score in ExtractionResult.Classification.
+type LabelScore struct {
+	Label string  `json:"label"`
+	Score float64 `json:"score"`
+}
+
 // Cookie represents a browser cookie.
 type Cookie struct {
 	Name   string  `json:"name"`
@@ -66,12 +117,14 @@ type Cookie struct {
 
 // CreateExtractionRequest is the request for extracting content.
 type CreateExtractionRequest struct {
-	URL             string                 `json:"url"`
+	URL             string                 `json:"url" validate:"required"`
 	Environment     *types.Environment     `json:"environment,omitempty"`
 	ProjectID       *string                `json:"projectId,omitempty"`
 	Mode            *ExtractionMode        `json:"mode,omitempty"`
 	Schema          map[string]interface{} `json:"schema,omitempty"`
 	Prompt          *string                `json:"prompt,omitempty"`
+	Summarize       *SummarizeOptions      `json:"summarize,omitempty"`
+	Classify        *ClassifyOptions       `json:"classify,omitempty"`
 	IncludeLinks    *bool                  `json:"includeLinks,omitempty"`
 	IncludeImages   *bool                  `json:"includeImages,omitempty"`
 	IncludeMetadata *bool                  `json:"includeMetadata,omitempty"`
@@ -81,6 +134,7 @@ type CreateExtractionRequest struct {
 	Cookies         []Cookie               `json:"cookies,omitempty"`
 	WebhookURL      *string                `json:"webhookUrl,omitempty"`
 	WebhookSecret   *string                `json:"webhookSecret,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
 	Metadata        map[string]interface{} `json:"metadata,omitempty"`
 }
 
@@ -92,9 +146,10 @@ type CreateExtractionResponse struct {
 
 // GetExtractionRequest is the request for getting an extraction.
 type GetExtractionRequest struct {
-	ID          string             `json:"id"`
+	ID          string             `json:"id" validate:"required"`
 	Environment *types.Environment `json:"environment,omitempty"`
 	ProjectID   *string            `json:"projectId,omitempty"`
+	Fields      []ExtractionField  `json:"fields,omitempty"`
 }
 
 // ListExtractionsRequest is the request for listing extractions.
@@ -103,6 +158,9 @@ type ListExtractionsRequest struct {
 	ProjectID   *string            `json:"projectId,omitempty"`
 	Status      *ExtractionStatus  `json:"status,omitempty"`
 	URL         *string            `json:"url,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
+	Metadata    map[string]string  `json:"metadata,omitempty"`
+	Fields      []ExtractionField  `json:"fields,omitempty"`
 	Limit       *int               `json:"limit,omitempty"`
 	Cursor      *string            `json:"cursor,omitempty"`
 }
@@ -129,6 +187,7 @@ type BatchExtractionJob struct {
 	SuccessfulURLs int                    `json:"successfulUrls"`
 	FailedURLs     int                    `json:"failedUrls"`
 	WebhookURL     *string                `json:"webhookUrl,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
 	CreatedAt      time.Time              `json:"createdAt"`
 	StartedAt      *time.Time             `json:"startedAt,omitempty"`
@@ -140,6 +199,8 @@ type BatchExtractionConfig struct {
 	Mode            *ExtractionMode        `json:"mode,omitempty"`
 	Schema          map[string]interface{} `json:"schema,omitempty"`
 	Prompt          *string                `json:"prompt,omitempty"`
+	Summarize       *SummarizeOptions      `json:"summarize,omitempty"`
+	Classify        *ClassifyOptions       `json:"classify,omitempty"`
 	IncludeLinks    *bool                  `json:"includeLinks,omitempty"`
 	IncludeImages   *bool                  `json:"includeImages,omitempty"`
 	IncludeMetadata *bool                  `json:"includeMetadata,omitempty"`
@@ -149,14 +210,15 @@ type BatchExtractionConfig struct {
 
 // CreateBatchExtractionsRequest is the request for creating a batch job.
 type CreateBatchExtractionsRequest struct {
-	URLs          []string                `json:"urls"`
-	Environment   *types.Environment      `json:"environment,omitempty"`
-	ProjectID     *string                 `json:"projectId,omitempty"`
-	Name          *string                 `json:"name,omitempty"`
-	Config        *BatchExtractionConfig  `json:"config,omitempty"`
-	WebhookURL    *string                 `json:"webhookUrl,omitempty"`
-	WebhookSecret *string                 `json:"webhookSecret,omitempty"`
-	Metadata      map[string]interface{}  `json:"metadata,omitempty"`
+	URLs          []string               `json:"urls" validate:"required"`
+	Environment   *types.Environment     `json:"environment,omitempty"`
+	ProjectID     *string                `json:"projectId,omitempty"`
+	Name          *string                `json:"name,omitempty"`
+	Config        *BatchExtractionConfig `json:"config,omitempty"`
+	WebhookURL    *string                `json:"webhookUrl,omitempty"`
+	WebhookSecret *string                `json:"webhookSecret,omitempty"`
+	Tags          []string               `json:"tags,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // CreateBatchResponse is the response from creating a batch job.
@@ -167,7 +229,7 @@ type CreateBatchResponse struct {
 
 // GetBatchJobRequest is the request for getting a batch job.
 type GetBatchJobRequest struct {
-	ID          string             `json:"id"`
+	ID          string             `json:"id" validate:"required"`
 	Environment *types.Environment `json:"environment,omitempty"`
 	ProjectID   *string            `json:"projectId,omitempty"`
 }
@@ -177,6 +239,7 @@ type ListBatchJobsRequest struct {
 	Environment *types.Environment    `json:"environment,omitempty"`
 	ProjectID   *string               `json:"projectId,omitempty"`
 	Status      *types.BatchJobStatus `json:"status,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
 	Limit       *int                  `json:"limit,omitempty"`
 	Cursor      *string               `json:"cursor,omitempty"`
 }
@@ -187,60 +250,162 @@ type BatchJobsResponse struct {
 	NextCursor *string              `json:"nextCursor,omitempty"`
 }
 
+// CrawlStatus represents the status of a crawl.
+type CrawlStatus string
+
+const (
+	CrawlStatusPending   CrawlStatus = "pending"
+	CrawlStatusRunning   CrawlStatus = "running"
+	CrawlStatusCompleted CrawlStatus = "completed"
+	CrawlStatusFailed    CrawlStatus = "failed"
+	CrawlStatusCancelled CrawlStatus = "cancelled"
+)
+
+// CrawlRequest is the request for crawling a site. The crawl starts at SeedURL
+// and follows links on the same host up to MaxDepth links away, stopping after
+// MaxPages pages. IncludePatterns and ExcludePatterns are glob patterns
+// matched against each discovered URL's path, e.g. "/docs/**"; a URL is
+// crawled if it matches any include pattern (or none are given) and no
+// exclude pattern. Every crawled page is extracted with Mode, Schema and
+// Prompt as in CreateExtractionRequest.
+type CrawlRequest struct {
+	SeedURL         string                 `json:"seedUrl" validate:"required"`
+	Environment     *types.Environment     `json:"environment,omitempty"`
+	ProjectID       *string                `json:"projectId,omitempty"`
+	MaxDepth        *int                   `json:"maxDepth,omitempty"`
+	MaxPages        *int                   `json:"maxPages,omitempty"`
+	IncludePatterns []string               `json:"includePatterns,omitempty"`
+	ExcludePatterns []string               `json:"excludePatterns,omitempty"`
+	Mode            *ExtractionMode        `json:"mode,omitempty"`
+	Schema          map[string]interface{} `json:"schema,omitempty"`
+	Prompt          *string                `json:"prompt,omitempty"`
+	WebhookURL      *string                `json:"webhookUrl,omitempty"`
+	WebhookSecret   *string                `json:"webhookSecret,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// CreateCrawlResponse is the response from starting a crawl.
+type CreateCrawlResponse struct {
+	ID     string      `json:"id"`
+	Status CrawlStatus `json:"status"`
+}
+
+// Crawl represents a crawl and its progress. PagesDiscovered counts URLs
+// found that match the crawl's patterns, including those not yet processed.
+type Crawl struct {
+	ID              string                 `json:"id"`
+	OrganizationID  string                 `json:"organizationId"`
+	ProjectID       *string                `json:"projectId,omitempty"`
+	Environment     types.Environment      `json:"environment"`
+	SeedURL         string                 `json:"seedUrl"`
+	Status          CrawlStatus            `json:"status"`
+	MaxDepth        int                    `json:"maxDepth"`
+	MaxPages        int                    `json:"maxPages"`
+	PagesDiscovered int                    `json:"pagesDiscovered"`
+	PagesProcessed  int                    `json:"pagesProcessed"`
+	PagesSucceeded  int                    `json:"pagesSucceeded"`
+	PagesFailed     int                    `json:"pagesFailed"`
+	Error           *string                `json:"error,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+	Metadata        map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt       time.Time              `json:"createdAt"`
+	StartedAt       *time.Time             `json:"startedAt,omitempty"`
+	CompletedAt     *time.Time             `json:"completedAt,omitempty"`
+}
+
+// GetCrawlRequest is the request for getting a crawl.
+type GetCrawlRequest struct {
+	ID          string             `json:"id" validate:"required"`
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+}
+
+// GetCrawlResultsRequest is the request for getting the pages of a crawl.
+type GetCrawlResultsRequest struct {
+	ID          string             `json:"id" validate:"required"`
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+	Limit       *int               `json:"limit,omitempty"`
+	Cursor      *string            `json:"cursor,omitempty"`
+}
+
+// CrawlPage is the extraction of a single crawled page. Depth is the number of
+// links followed from the seed URL, and ParentURL the page it was found on.
+type CrawlPage struct {
+	ExtractionResult
+	Depth     int     `json:"depth"`
+	ParentURL *string `json:"parentUrl,omitempty"`
+}
+
+// CrawlResultsResponse is the response from getting the pages of a crawl.
+type CrawlResultsResponse struct {
+	Items      []CrawlPage `json:"items"`
+	NextCursor *string     `json:"nextCursor,omitempty"`
+}
+
 // ExtractionSchedule represents an extraction schedule.
 type ExtractionSchedule struct {
-	ID              string                  `json:"id"`
-	OrganizationID  string                  `json:"organizationId"`
-	ProjectID       *string                 `json:"projectId,omitempty"`
-	Environment     types.Environment       `json:"environment"`
-	Name            string                  `json:"name"`
-	URL             string                  `json:"url"`
-	Type            string                  `json:"type"`
-	Frequency       types.ScheduleFrequency `json:"frequency"`
-	Config          map[string]interface{}  `json:"config"`
-	IsActive        bool                    `json:"isActive"`
-	DetectChanges   bool                    `json:"detectChanges"`
-	ChangeThreshold *int                    `json:"changeThreshold,omitempty"`
-	WebhookURL      *string                 `json:"webhookUrl,omitempty"`
-	TotalRuns       int                     `json:"totalRuns"`
-	SuccessfulRuns  int                     `json:"successfulRuns"`
-	FailedRuns      int                     `json:"failedRuns"`
-	LastRunAt       *time.Time              `json:"lastRunAt,omitempty"`
-	NextRunAt       *time.Time              `json:"nextRunAt,omitempty"`
-	Metadata        map[string]interface{}  `json:"metadata,omitempty"`
-	CreatedAt       time.Time               `json:"createdAt"`
-	UpdatedAt       time.Time               `json:"updatedAt"`
+	ID                  string                     `json:"id"`
+	OrganizationID      string                     `json:"organizationId"`
+	ProjectID           *string                    `json:"projectId,omitempty"`
+	Environment         types.Environment          `json:"environment"`
+	Name                string                     `json:"name"`
+	URL                 string                     `json:"url"`
+	Type                string                     `json:"type"`
+	Frequency           types.ScheduleFrequency    `json:"frequency"`
+	Config              map[string]interface{}     `json:"config"`
+	IsActive            bool                       `json:"isActive"`
+	DetectChanges       bool                       `json:"detectChanges"`
+	ChangeThreshold     *int                       `json:"changeThreshold,omitempty"`
+	WebhookURL          *string                    `json:"webhookUrl,omitempty"`
+	TotalRuns           int                        `json:"totalRuns"`
+	SuccessfulRuns      int                        `json:"successfulRuns"`
+	FailedRuns          int                        `json:"failedRuns"`
+	ConsecutiveFailures int                        `json:"consecutiveFailures"`
+	AlertConfig         *types.ScheduleAlertConfig `json:"alertConfig,omitempty"`
+	LastRunAt           *time.Time                 `json:"lastRunAt,omitempty"`
+	LastFailureAt       *time.Time                 `json:"lastFailureAt,omitempty"`
+	NextRunAt           *time.Time                 `json:"nextRunAt,omitempty"`
+	Tags                []string                   `json:"tags,omitempty"`
+	Metadata            map[string]interface{}     `json:"metadata,omitempty"`
+	CreatedAt           time.Time                  `json:"createdAt"`
+	UpdatedAt           time.Time                  `json:"updatedAt"`
 }
 
 // CreateExtractionScheduleRequest is the request for creating a schedule.
 type CreateExtractionScheduleRequest struct {
-	Name            string                   `json:"name"`
-	URL             string                   `json:"url"`
-	Environment     *types.Environment       `json:"environment,omitempty"`
-	ProjectID       *string                  `json:"projectId,omitempty"`
-	Frequency       *types.ScheduleFrequency `json:"frequency,omitempty"`
-	Config          *BatchExtractionConfig   `json:"config,omitempty"`
-	DetectChanges   *bool                    `json:"detectChanges,omitempty"`
-	ChangeThreshold *int                     `json:"changeThreshold,omitempty"`
-	WebhookURL      *string                  `json:"webhookUrl,omitempty"`
-	WebhookSecret   *string                  `json:"webhookSecret,omitempty"`
-	Metadata        map[string]interface{}   `json:"metadata,omitempty"`
+	Name            string                     `json:"name" validate:"required"`
+	URL             string                     `json:"url" validate:"required"`
+	Environment     *types.Environment         `json:"environment,omitempty"`
+	ProjectID       *string                    `json:"projectId,omitempty"`
+	Frequency       *types.ScheduleFrequency   `json:"frequency,omitempty"`
+	Config          *BatchExtractionConfig     `json:"config,omitempty"`
+	DetectChanges   *bool                      `json:"detectChanges,omitempty"`
+	ChangeThreshold *int                       `json:"changeThreshold,omitempty"`
+	WebhookURL      *string                    `json:"webhookUrl,omitempty"`
+	WebhookSecret   *string                    `json:"webhookSecret,omitempty"`
+	AlertConfig     *types.ScheduleAlertConfig `json:"alertConfig,omitempty"`
+	Tags            []string                   `json:"tags,omitempty"`
+	Metadata        map[string]interface{}     `json:"metadata,omitempty"`
 }
 
 // UpdateExtractionScheduleRequest is the request for updating a schedule.
 type UpdateExtractionScheduleRequest struct {
-	ID              string                   `json:"id"`
-	Environment     *types.Environment       `json:"environment,omitempty"`
-	ProjectID       *string                  `json:"projectId,omitempty"`
-	Name            *string                  `json:"name,omitempty"`
-	Frequency       *types.ScheduleFrequency `json:"frequency,omitempty"`
-	Config          map[string]interface{}   `json:"config,omitempty"`
-	IsActive        *bool                    `json:"isActive,omitempty"`
-	DetectChanges   *bool                    `json:"detectChanges,omitempty"`
-	ChangeThreshold *int                     `json:"changeThreshold,omitempty"`
-	WebhookURL      *string                  `json:"webhookUrl,omitempty"`
-	WebhookSecret   *string                  `json:"webhookSecret,omitempty"`
-	Metadata        map[string]interface{}   `json:"metadata,omitempty"`
+	ID              string                     `json:"id" validate:"required"`
+	Environment     *types.Environment         `json:"environment,omitempty"`
+	ProjectID       *string                    `json:"projectId,omitempty"`
+	Name            *string                    `json:"name,omitempty"`
+	Frequency       *types.ScheduleFrequency   `json:"frequency,omitempty"`
+	Config          map[string]interface{}     `json:"config,omitempty"`
+	IsActive        *bool                      `json:"isActive,omitempty"`
+	DetectChanges   *bool                      `json:"detectChanges,omitempty"`
+	ChangeThreshold *int                       `json:"changeThreshold,omitempty"`
+	WebhookURL      *string                    `json:"webhookUrl,omitempty"`
+	WebhookSecret   *string                    `json:"webhookSecret,omitempty"`
+	AlertConfig     *types.ScheduleAlertConfig `json:"alertConfig,omitempty"`
+	Tags            []string                   `json:"tags,omitempty"`
+	Metadata        map[string]interface{}     `json:"metadata,omitempty"`
 }
 
 // CreateScheduleResponse is the response from creating a schedule.
@@ -248,9 +413,15 @@ type CreateScheduleResponse struct {
 	ID string `json:"id"`
 }
 
+// CreateOrUpdateScheduleResponse is the response from CreateOrUpdateSchedule.
+type CreateOrUpdateScheduleResponse struct {
+	ID      string `json:"id"`
+	Created bool   `json:"created"`
+}
+
 // GetScheduleRequest is the request for getting a schedule.
 type GetScheduleRequest struct {
-	ID          string             `json:"id"`
+	ID          string             `json:"id" validate:"required"`
 	Environment *types.Environment `json:"environment,omitempty"`
 	ProjectID   *string            `json:"projectId,omitempty"`
 }
@@ -260,6 +431,7 @@ type ListSchedulesRequest struct {
 	Environment *types.Environment `json:"environment,omitempty"`
 	ProjectID   *string            `json:"projectId,omitempty"`
 	IsActive    *bool              `json:"isActive,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
 	Limit       *int               `json:"limit,omitempty"`
 	Cursor      *string            `json:"cursor,omitempty"`
 }
@@ -282,31 +454,121 @@ type ToggleResponse struct {
 
 // ExtractionUsage represents extraction usage stats.
 type ExtractionUsage struct {
-	PeriodStart              time.Time `json:"periodStart"`
-	PeriodEnd                time.Time `json:"periodEnd"`
-	ExtractionsTotal         int       `json:"extractionsTotal"`
-	ExtractionsSuccessful    int       `json:"extractionsSuccessful"`
-	ExtractionsFailed        int       `json:"extractionsFailed"`
-	ExtractionCreditsUsed    int       `json:"extractionCreditsUsed"`
-	ExtractionTokensUsed     int       `json:"extractionTokensUsed"`
+	PeriodStart           time.Time  `json:"periodStart"`
+	PeriodEnd             time.Time  `json:"periodEnd"`
+	ExtractionsTotal      int        `json:"extractionsTotal"`
+	ExtractionsSuccessful int        `json:"extractionsSuccessful"`
+	ExtractionsFailed     int        `json:"extractionsFailed"`
+	ExtractionCreditsUsed int        `json:"extractionCreditsUsed"`
+	ExtractionTokensUsed  int        `json:"extractionTokensUsed"`
+	ByTag                 []TagUsage `json:"byTag,omitempty"`
 }
 
-// GetUsageRequest is the request for getting usage stats.
+// TagUsage is usage attributed to a single request tag.
+type TagUsage struct {
+	Tag         string `json:"tag"`
+	Screenshots int    `json:"screenshots"`
+	Extractions int    `json:"extractions"`
+	CreditsUsed int    `json:"creditsUsed"`
+}
+
+// GetUsageRequest is the request for getting usage stats. Tags limits the
+// stats to requests carrying any of the given tags.
 type GetUsageRequest struct {
 	Environment *types.Environment `json:"environment,omitempty"`
 	PeriodStart *string            `json:"periodStart,omitempty"`
 	PeriodEnd   *string            `json:"periodEnd,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
 }
 
 // DailyUsageItem represents daily usage.
 type DailyUsageItem struct {
-	Date        string `json:"date"`
-	Screenshots int    `json:"screenshots"`
-	Extractions int    `json:"extractions"`
-	CreditsUsed int    `json:"creditsUsed"`
+	Date        string     `json:"date"`
+	Screenshots int        `json:"screenshots"`
+	Extractions int        `json:"extractions"`
+	CreditsUsed int        `json:"creditsUsed"`
+	ByTag       []TagUsage `json:"byTag,omitempty"`
 }
 
 // GetDailyUsageResponse is the response from getting daily usage.
 type GetDailyUsageResponse struct {
 	Days []DailyUsageItem `json:"days"`
 }
+
+// ListScheduleFailuresRequest is the request for listing schedule failures.
+type ListScheduleFailuresRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+	ScheduleID  *string            `json:"scheduleId,omitempty"`
+	Since       *time.Time         `json:"since,omitempty"`
+	Limit       *int               `json:"limit,omitempty"`
+	Cursor      *string            `json:"cursor,omitempty"`
+}
+
+// ScheduleFailuresResponse is the response from listing schedule failures.
+type ScheduleFailuresResponse struct {
+	Items      []types.ScheduleFailure `json:"items"`
+	NextCursor *string                 `json:"nextCursor,omitempty"`
+}
+
+// FieldCorrection reports a wrongly extracted field. Field is a dot-separated
+// path into ExtractedData, e.g. "price.amount", and Value is the correct
+// value; use nil when the field should not have been extracted at all.
+type FieldCorrection struct {
+	Field   string      `json:"field"`
+	Value   interface{} `json:"value"`
+	Comment *string     `json:"comment,omitempty"`
+}
+
+// SubmitFeedbackRequest is the request for submitting feedback on an
+// extraction. An empty Corrections list confirms the extraction was correct.
+type SubmitFeedbackRequest struct {
+	ID          string             `json:"id" validate:"required"`
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+	Corrections []FieldCorrection  `json:"corrections"`
+}
+
+// FeedbackResponse is the response from submitting feedback.
+type FeedbackResponse struct {
+	ID           string    `json:"id"`
+	ExtractionID string    `json:"extractionId"`
+	SchemaHash   *string   `json:"schemaHash,omitempty"`
+	Corrections  int       `json:"corrections"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// GetAccuracyReportRequest is the request for getting an accuracy report.
+// Set SchemaHash to report on a single schema.
+type GetAccuracyReportRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+	SchemaHash  *string            `json:"schemaHash,omitempty"`
+	PeriodStart *string            `json:"periodStart,omitempty"`
+	PeriodEnd   *string            `json:"periodEnd,omitempty"`
+}
+
+// FieldAccuracy is the accuracy of a single schema field. Accuracy is the
+// fraction of reviewed extractions in which the field was not corrected.
+type FieldAccuracy struct {
+	Field       string  `json:"field"`
+	Corrections int     `json:"corrections"`
+	Accuracy    float64 `json:"accuracy"`
+}
+
+// SchemaAccuracy is the accuracy of extractions using one schema, based on
+// the extractions that received feedback.
+type SchemaAccuracy struct {
+	SchemaHash  string          `json:"schemaHash"`
+	Extractions int             `json:"extractions"`
+	Reviewed    int             `json:"reviewed"`
+	Accuracy    float64         `json:"accuracy"`
+	Fields      []FieldAccuracy `json:"fields"`
+}
+
+// AccuracyReport is the response from getting an accuracy report.
+type AccuracyReport struct {
+	PeriodStart time.Time        `json:"periodStart"`
+	PeriodEnd   time.Time        `json:"periodEnd"`
+	Schemas     []SchemaAccuracy `json:"schemas"`
+}
@@ -0,0 +1,38 @@
+package extraction
+
+import "github.com/stack0/sdk-go/types"
+
+func init() {
+	types.RegisterEnum(ExtractionModeAuto, ExtractionModeSchema, ExtractionModeMarkdown, ExtractionModeRaw)
+	types.RegisterEnum(
+		ExtractionFieldURL,
+		ExtractionFieldMode,
+		ExtractionFieldStatus,
+		ExtractionFieldExtractedData,
+		ExtractionFieldMarkdown,
+		ExtractionFieldRawHTML,
+		ExtractionFieldPageMetadata,
+		ExtractionFieldSummary,
+		ExtractionFieldClassification,
+		ExtractionFieldError,
+		ExtractionFieldProcessingTimeMs,
+		ExtractionFieldTokensUsed,
+		ExtractionFieldTags,
+		ExtractionFieldMetadata,
+		ExtractionFieldCreatedAt,
+		ExtractionFieldCompletedAt,
+	)
+	types.RegisterEnum(
+		ExtractionStatusPending,
+		ExtractionStatusProcessing,
+		ExtractionStatusCompleted,
+		ExtractionStatusFailed,
+	)
+	types.RegisterEnum(
+		CrawlStatusPending,
+		CrawlStatusRunning,
+		CrawlStatusCompleted,
+		CrawlStatusFailed,
+		CrawlStatusCancelled,
+	)
+}
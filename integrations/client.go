@@ -0,0 +1,102 @@
+package integrations
+
+import (
+	"context"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
+)
+
+// Client manages Slack and Microsoft Teams notification integrations.
+type Client struct {
+	http *client.HTTPClient
+}
+
+// NewClient creates a new integrations client.
+func NewClient(http *client.HTTPClient) *Client {
+	return &Client{http: http}
+}
+
+// Create creates a new integration.
+func (c *Client) Create(ctx context.Context, req *CreateIntegrationRequest) (*Integration, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Integration
+	if err := c.http.Post(ctx, "/integrations", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves an integration by ID.
+func (c *Client) Get(ctx context.Context, id string) (*Integration, error) {
+	var resp Integration
+	if err := c.http.Get(ctx, "/integrations/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List lists integrations.
+func (c *Client) List(ctx context.Context, req *ListIntegrationsRequest) (*ListIntegrationsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.ProjectID != nil {
+			params.Set("projectId", *req.ProjectID)
+		}
+		if req.Provider != nil {
+			params.Set("provider", string(*req.Provider))
+		}
+	}
+
+	path := "/integrations"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListIntegrationsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update updates an integration.
+func (c *Client) Update(ctx context.Context, req *UpdateIntegrationRequest) (*Integration, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Integration
+	if err := c.http.Put(ctx, "/integrations/"+req.ID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes an integration.
+func (c *Client) Delete(ctx context.Context, id string) (*types.SuccessResponse, error) {
+	var resp types.SuccessResponse
+	if err := c.http.Delete(ctx, "/integrations/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Test sends a test notification to the integration's channel.
+func (c *Client) Test(ctx context.Context, id string) (*TestIntegrationResponse, error) {
+	var resp TestIntegrationResponse
+	if err := c.http.Post(ctx, "/integrations/"+id+"/test", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
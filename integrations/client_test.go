@@ -0,0 +1,154 @@
+package integrations
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupIntegrationsTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewClient(httpClient), server
+}
+
+func TestClient_Create(t *testing.T) {
+	integrationsClient, server := setupIntegrationsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/integrations", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "slack", body["provider"])
+		assert.Equal(t, "https://hooks.slack.com/services/T000/B000/XXX", body["webhookUrl"])
+		assert.Equal(t, []interface{}{"job.failed", "mail.deliverability_alert"}, body["events"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Integration{
+			ID:       "int-123",
+			Name:     "Ops alerts",
+			Provider: ProviderSlack,
+			Events:   []EventType{EventJobFailed, EventDeliverabilityAlert},
+			IsActive: true,
+		})
+	})
+	defer server.Close()
+
+	resp, err := integrationsClient.Create(context.Background(), &CreateIntegrationRequest{
+		Name:       "Ops alerts",
+		Provider:   ProviderSlack,
+		WebhookURL: "https://hooks.slack.com/services/T000/B000/XXX",
+		Events:     []EventType{EventJobFailed, EventDeliverabilityAlert},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "int-123", resp.ID)
+	assert.True(t, resp.IsActive)
+}
+
+func TestClient_Get(t *testing.T) {
+	integrationsClient, server := setupIntegrationsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/integrations/int-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Integration{ID: "int-123", Provider: ProviderTeams})
+	})
+	defer server.Close()
+
+	resp, err := integrationsClient.Get(context.Background(), "int-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, ProviderTeams, resp.Provider)
+}
+
+func TestClient_List(t *testing.T) {
+	integrationsClient, server := setupIntegrationsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/integrations", r.URL.Path)
+		assert.Equal(t, "teams", r.URL.Query().Get("provider"))
+		assert.Equal(t, "production", r.URL.Query().Get("environment"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListIntegrationsResponse{
+			Integrations: []Integration{{ID: "int-1"}, {ID: "int-2"}},
+		})
+	})
+	defer server.Close()
+
+	provider := ProviderTeams
+	env := types.EnvironmentProduction
+	resp, err := integrationsClient.List(context.Background(), &ListIntegrationsRequest{
+		Environment: &env,
+		Provider:    &provider,
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Integrations, 2)
+}
+
+func TestClient_Update(t *testing.T) {
+	integrationsClient, server := setupIntegrationsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/integrations/int-123", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, false, body["isActive"])
+		assert.NotContains(t, body, "id")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Integration{ID: "int-123", IsActive: false})
+	})
+	defer server.Close()
+
+	isActive := false
+	resp, err := integrationsClient.Update(context.Background(), &UpdateIntegrationRequest{
+		ID:       "int-123",
+		IsActive: &isActive,
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.IsActive)
+}
+
+func TestClient_Delete(t *testing.T) {
+	integrationsClient, server := setupIntegrationsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/integrations/int-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(types.SuccessResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := integrationsClient.Delete(context.Background(), "int-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestClient_Test(t *testing.T) {
+	integrationsClient, server := setupIntegrationsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/integrations/int-123/test", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(TestIntegrationResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := integrationsClient.Test(context.Background(), "int-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
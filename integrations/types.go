@@ -0,0 +1,83 @@
+package integrations
+
+import (
+	"time"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// Provider is a chat platform that notifications can be posted to.
+type Provider string
+
+const (
+	ProviderSlack Provider = "slack"
+	ProviderTeams Provider = "teams"
+)
+
+// EventType is an event that can trigger a notification.
+type EventType string
+
+const (
+	EventJobCompleted           EventType = "job.completed"
+	EventJobFailed              EventType = "job.failed"
+	EventScheduleFailed         EventType = "schedule.failed"
+	EventDeliverabilityAlert    EventType = "mail.deliverability_alert"
+	EventDomainVerificationLost EventType = "mail.domain_verification_lost"
+)
+
+// Integration is a configured Slack or Microsoft Teams destination.
+type Integration struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Provider    Provider          `json:"provider"`
+	Channel     *string           `json:"channel,omitempty"`
+	Events      []EventType       `json:"events"`
+	IsActive    bool              `json:"isActive"`
+	Environment types.Environment `json:"environment"`
+	ProjectID   *string           `json:"projectId,omitempty"`
+	LastSentAt  *time.Time        `json:"lastSentAt,omitempty"`
+	LastError   *string           `json:"lastError,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+}
+
+// CreateIntegrationRequest is the request to create an integration.
+// WebhookURL is the Slack incoming webhook or Teams workflow URL; it is
+// write-only and never returned by the API.
+type CreateIntegrationRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+	Name        string             `json:"name" validate:"required"`
+	Provider    Provider           `json:"provider" validate:"required"`
+	WebhookURL  string             `json:"webhookUrl" validate:"required"`
+	Channel     *string            `json:"channel,omitempty"`
+	Events      []EventType        `json:"events" validate:"required"`
+}
+
+// UpdateIntegrationRequest is the request to update an integration.
+type UpdateIntegrationRequest struct {
+	ID         string      `json:"-" validate:"required"`
+	Name       *string     `json:"name,omitempty"`
+	WebhookURL *string     `json:"webhookUrl,omitempty"`
+	Channel    *string     `json:"channel,omitempty"`
+	Events     []EventType `json:"events,omitempty"`
+	IsActive   *bool       `json:"isActive,omitempty"`
+}
+
+// ListIntegrationsRequest is the request to list integrations.
+type ListIntegrationsRequest struct {
+	Environment *types.Environment
+	ProjectID   *string
+	Provider    *Provider
+}
+
+// ListIntegrationsResponse is the response from listing integrations.
+type ListIntegrationsResponse struct {
+	Integrations []Integration `json:"integrations"`
+}
+
+// TestIntegrationResponse is the response from sending a test notification.
+type TestIntegrationResponse struct {
+	Success bool    `json:"success"`
+	Error   *string `json:"error,omitempty"`
+}
@@ -0,0 +1,14 @@
+package integrations
+
+import "github.com/stack0/sdk-go/types"
+
+func init() {
+	types.RegisterEnum(ProviderSlack, ProviderTeams)
+	types.RegisterEnum(
+		EventJobCompleted,
+		EventJobFailed,
+		EventScheduleFailed,
+		EventDeliverabilityAlert,
+		EventDomainVerificationLost,
+	)
+}
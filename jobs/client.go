@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// Client lists asynchronous jobs across all modules.
+type Client struct {
+	http *client.HTTPClient
+}
+
+// NewClient creates a new jobs client.
+func NewClient(http *client.HTTPClient) *Client {
+	return &Client{http: http}
+}
+
+// List lists jobs of every type, newest first. Use Types to restrict the
+// listing to specific job types.
+func (c *Client) List(ctx context.Context, req *ListJobsRequest) (*ListJobsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.ProjectID != nil {
+			params.Set("projectId", *req.ProjectID)
+		}
+		if len(req.Types) > 0 {
+			jobTypes := make([]string, len(req.Types))
+			for i, t := range req.Types {
+				jobTypes[i] = string(t)
+			}
+			params.Set("types", strings.Join(jobTypes, ","))
+		}
+		if req.Status != nil {
+			params.Set("status", string(*req.Status))
+		}
+		client.SetTime(params, "createdAfter", req.CreatedAfter)
+		client.SetTime(params, "createdBefore", req.CreatedBefore)
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Cursor != nil {
+			params.Set("cursor", *req.Cursor)
+		}
+	}
+
+	path := "/jobs"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListJobsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a job by its unified ID.
+func (c *Client) Get(ctx context.Context, id string) (*Job, error) {
+	var resp Job
+	if err := c.http.Get(ctx, "/jobs/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSummary retrieves job counts grouped by type and status.
+func (c *Client) GetSummary(ctx context.Context, req *GetJobSummaryRequest) (*JobSummaryResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.ProjectID != nil {
+			params.Set("projectId", *req.ProjectID)
+		}
+		client.SetTime(params, "since", req.Since)
+	}
+
+	path := "/jobs/summary"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp JobSummaryResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
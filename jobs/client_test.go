@@ -0,0 +1,108 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupJobsTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewClient(httpClient), server
+}
+
+func TestClient_List(t *testing.T) {
+	jobsClient, server := setupJobsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/jobs", r.URL.Path)
+		assert.Equal(t, "transcode,bundle", r.URL.Query().Get("types"))
+		assert.Equal(t, "failed", r.URL.Query().Get("status"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListJobsResponse{
+			Items: []Job{
+				{ID: "job-1", Type: JobTypeTranscode, Status: JobStatusFailed, SourceID: "tj-1"},
+				{ID: "job-2", Type: JobTypeBundle, Status: JobStatusFailed, SourceID: "bundle-1"},
+			},
+		})
+	})
+	defer server.Close()
+
+	status := JobStatusFailed
+	resp, err := jobsClient.List(context.Background(), &ListJobsRequest{
+		Types:  []JobType{JobTypeTranscode, JobTypeBundle},
+		Status: &status,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 2)
+	assert.Equal(t, JobTypeTranscode, resp.Items[0].Type)
+	assert.Equal(t, "tj-1", resp.Items[0].SourceID)
+}
+
+func TestClient_List_NoFilters(t *testing.T) {
+	jobsClient, server := setupJobsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/jobs", r.URL.Path)
+		assert.Empty(t, r.URL.RawQuery)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListJobsResponse{})
+	})
+	defer server.Close()
+
+	resp, err := jobsClient.List(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Items)
+}
+
+func TestClient_Get(t *testing.T) {
+	jobsClient, server := setupJobsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/jobs/job-1", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Job{ID: "job-1", Type: JobTypeExtractionBatch, Status: JobStatusCompleted})
+	})
+	defer server.Close()
+
+	resp, err := jobsClient.Get(context.Background(), "job-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, JobTypeExtractionBatch, resp.Type)
+	assert.True(t, resp.Status.IsTerminal())
+}
+
+func TestClient_GetSummary(t *testing.T) {
+	jobsClient, server := setupJobsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/jobs/summary", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(JobSummaryResponse{
+			Counts: []JobStatusCount{{Type: JobTypeMerge, Status: JobStatusProcessing, Count: 4}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := jobsClient.GetSummary(context.Background(), nil)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Counts, 1)
+	assert.Equal(t, 4, resp.Counts[0].Count)
+}
+
+func TestJobStatus_IsTerminal(t *testing.T) {
+	assert.False(t, JobStatusPending.IsTerminal())
+	assert.False(t, JobStatusProcessing.IsTerminal())
+	assert.True(t, JobStatusCompleted.IsTerminal())
+	assert.True(t, JobStatusFailed.IsTerminal())
+	assert.True(t, JobStatusCancelled.IsTerminal())
+}
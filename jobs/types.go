@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"time"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// JobType identifies the kind of asynchronous job.
+type JobType string
+
+const (
+	JobTypeExtractionBatch JobType = "extraction_batch"
+	JobTypeScreenshotBatch JobType = "screenshot_batch"
+	JobTypeTranscode       JobType = "transcode"
+	JobTypeMerge           JobType = "merge"
+	JobTypeBundle          JobType = "bundle"
+	JobTypeExport          JobType = "export"
+)
+
+// JobStatus is the status model shared by all job types.
+type JobStatus string
+
+const (
+	JobStatusPending    JobStatus = "pending"
+	JobStatusProcessing JobStatus = "processing"
+	JobStatusCompleted  JobStatus = "completed"
+	JobStatusFailed     JobStatus = "failed"
+	JobStatusCancelled  JobStatus = "cancelled"
+)
+
+// IsTerminal reports whether the job has stopped running.
+func (s JobStatus) IsTerminal() bool {
+	return s == JobStatusCompleted || s == JobStatusFailed || s == JobStatusCancelled
+}
+
+// Job is a normalized view of an asynchronous job from any module.
+// SourceID is the ID of the job in its owning module, e.g. a batch job ID
+// or a transcode job ID, and can be passed to that module's client.
+type Job struct {
+	ID          string                 `json:"id"`
+	Type        JobType                `json:"type"`
+	Status      JobStatus              `json:"status"`
+	SourceID    string                 `json:"sourceId"`
+	ProjectID   *string                `json:"projectId,omitempty"`
+	Environment types.Environment      `json:"environment"`
+	Progress    *int                   `json:"progress,omitempty"`
+	Error       *string                `json:"error,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt   time.Time              `json:"createdAt"`
+	StartedAt   *time.Time             `json:"startedAt,omitempty"`
+	CompletedAt *time.Time             `json:"completedAt,omitempty"`
+}
+
+// ListJobsRequest is the request for listing jobs across modules.
+type ListJobsRequest struct {
+	Environment   *types.Environment
+	ProjectID     *string
+	Types         []JobType
+	Status        *JobStatus
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Limit         *int
+	Cursor        *string
+}
+
+// ListJobsResponse is the response from listing jobs.
+type ListJobsResponse struct {
+	Items      []Job   `json:"items"`
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// JobStatusCount is the number of jobs of a type in a given status.
+type JobStatusCount struct {
+	Type   JobType   `json:"type"`
+	Status JobStatus `json:"status"`
+	Count  int       `json:"count"`
+}
+
+// GetJobSummaryRequest is the request for job counts.
+type GetJobSummaryRequest struct {
+	Environment *types.Environment
+	ProjectID   *string
+	Since       *time.Time
+}
+
+// JobSummaryResponse contains job counts grouped by type and status.
+type JobSummaryResponse struct {
+	Counts []JobStatusCount `json:"counts"`
+}
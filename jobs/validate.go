@@ -0,0 +1,21 @@
+package jobs
+
+import "github.com/stack0/sdk-go/types"
+
+func init() {
+	types.RegisterEnum(
+		JobTypeExtractionBatch,
+		JobTypeScreenshotBatch,
+		JobTypeTranscode,
+		JobTypeMerge,
+		JobTypeBundle,
+		JobTypeExport,
+	)
+	types.RegisterEnum(
+		JobStatusPending,
+		JobStatusProcessing,
+		JobStatusCompleted,
+		JobStatusFailed,
+		JobStatusCancelled,
+	)
+}
@@ -0,0 +1,88 @@
+package mail
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// AttachmentHash returns the SHA-256 hex digest used to reference stored
+// attachment content.
+func AttachmentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// UploadAttachment stores attachment content so later sends can reference it
+// by hash instead of including it. The API verifies the content against its
+// hash; uploading content that is already stored refreshes its expiry.
+func (c *Client) UploadAttachment(ctx context.Context, filename, contentType string, content []byte) (*StoredAttachment, error) {
+	var resp StoredAttachment
+	body := map[string]interface{}{
+		"hash":        AttachmentHash(content),
+		"filename":    filename,
+		"contentType": contentType,
+		"content":     base64.StdEncoding.EncodeToString(content),
+	}
+	if err := c.http.Post(ctx, "/mail/attachments", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetStoredAttachment retrieves stored attachment metadata by hash.
+func (c *Client) GetStoredAttachment(ctx context.Context, hash string) (*StoredAttachment, error) {
+	var resp StoredAttachment
+	if err := c.http.Get(ctx, "/mail/attachments/"+hash, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// EnsureAttachment uploads content unless it is already stored, and returns
+// an Attachment that references it by hash. Call it once before sending the
+// same file to many recipients and reuse the result in each request.
+func (c *Client) EnsureAttachment(ctx context.Context, filename, contentType string, content []byte) (Attachment, error) {
+	ref := Attachment{
+		Filename:    filename,
+		ContentType: contentType,
+		Hash:        AttachmentHash(content),
+	}
+
+	_, err := c.GetStoredAttachment(ctx, ref.Hash)
+	if err == nil {
+		return ref, nil
+	}
+	var apiErr *types.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		return Attachment{}, err
+	}
+
+	if _, err := c.UploadAttachment(ctx, filename, contentType, content); err != nil {
+		return Attachment{}, err
+	}
+	return ref, nil
+}
+
+// GetAttachments lists the attachments of a sent email as they were
+// delivered, including inline images and QR codes generated at send time.
+func (c *Client) GetAttachments(ctx context.Context, emailID string) (*EmailAttachmentsResponse, error) {
+	var resp EmailAttachmentsResponse
+	if err := c.http.Get(ctx, "/mail/"+emailID+"/attachments", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAttachment writes the content of an attachment of a sent email to w and
+// returns the number of bytes written. Attachment IDs are listed by
+// GetAttachments.
+func (c *Client) GetAttachment(ctx context.Context, emailID, attachmentID string, w io.Writer) (int64, error) {
+	return c.http.Download(ctx, "/mail/"+emailID+"/attachments/"+attachmentID+"/content", w)
+}
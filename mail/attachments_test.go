@@ -0,0 +1,161 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentHash(t *testing.T) {
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", AttachmentHash(nil))
+}
+
+func TestClient_EnsureAttachment(t *testing.T) {
+	content := []byte("%PDF-1.7 terms")
+	hash := AttachmentHash(content)
+
+	t.Run("uploads when missing", func(t *testing.T) {
+		var uploaded bool
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet:
+				assert.Equal(t, "/mail/attachments/"+hash, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(map[string]string{"code": ErrCodeAttachmentNotFound, "message": "not found"})
+			case http.MethodPost:
+				assert.Equal(t, "/mail/attachments", r.URL.Path)
+				var body map[string]string
+				err := json.NewDecoder(r.Body).Decode(&body)
+				require.NoError(t, err)
+				assert.Equal(t, hash, body["hash"])
+				assert.Equal(t, base64.StdEncoding.EncodeToString(content), body["content"])
+				assert.Equal(t, "terms.pdf", body["filename"])
+				uploaded = true
+				json.NewEncoder(w).Encode(StoredAttachment{Hash: hash, Size: int64(len(content))})
+			}
+		})
+		defer server.Close()
+
+		ref, err := mailClient.EnsureAttachment(context.Background(), "terms.pdf", "application/pdf", content)
+
+		require.NoError(t, err)
+		assert.True(t, uploaded)
+		assert.Equal(t, Attachment{Filename: "terms.pdf", ContentType: "application/pdf", Hash: hash}, ref)
+	})
+
+	t.Run("skips upload when stored", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			json.NewEncoder(w).Encode(StoredAttachment{Hash: hash})
+		})
+		defer server.Close()
+
+		ref, err := mailClient.EnsureAttachment(context.Background(), "terms.pdf", "application/pdf", content)
+
+		require.NoError(t, err)
+		assert.Equal(t, hash, ref.Hash)
+	})
+
+	t.Run("returns other errors", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"message": "invalid key"})
+		})
+		defer server.Close()
+
+		_, err := mailClient.EnsureAttachment(context.Background(), "terms.pdf", "application/pdf", content)
+
+		require.Error(t, err)
+	})
+}
+
+func TestClient_SendBatch_AttachmentHash(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Emails []struct {
+				Attachments []map[string]interface{} `json:"attachments"`
+			} `json:"emails"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		attachment := body.Emails[0].Attachments[0]
+		assert.Equal(t, "abc123", attachment["hash"])
+		assert.NotContains(t, attachment, "content")
+
+		json.NewEncoder(w).Encode(SendBatchEmailResponse{})
+	})
+	defer server.Close()
+
+	ref := Attachment{Filename: "terms.pdf", Hash: "abc123"}
+	_, err := mailClient.SendBatch(context.Background(), &SendBatchEmailRequest{
+		Emails: []SendEmailRequest{
+			{From: "a@example.com", To: "b@example.com", Subject: "Terms", Attachments: []Attachment{ref}},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func TestClient_GetAttachments(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/email-123/attachments", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(EmailAttachmentsResponse{Attachments: []EmailAttachment{
+			{ID: "att-1", Filename: "invoice.pdf", ContentType: "application/pdf", Size: 14},
+			{ID: "att-2", Filename: "logo.png", ContentType: "image/png", Size: 512, Inline: true, ContentID: ptr("logo")},
+		}})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.GetAttachments(context.Background(), "email-123")
+
+	require.NoError(t, err)
+	require.Len(t, resp.Attachments, 2)
+	assert.Equal(t, "invoice.pdf", resp.Attachments[0].Filename)
+	assert.True(t, resp.Attachments[1].Inline)
+	assert.Equal(t, "logo", *resp.Attachments[1].ContentID)
+}
+
+func TestClient_GetAttachment(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/email-123/attachments/att-1/content", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("%PDF-1.7 terms"))
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	n, err := mailClient.GetAttachment(context.Background(), "email-123", "att-1", &buf)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(14), n)
+	assert.Equal(t, "%PDF-1.7 terms", buf.String())
+}
+
+func TestClient_GetAttachment_NotFound(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"code": "NOT_FOUND", "message": "Attachment not found"})
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	_, err := mailClient.GetAttachment(context.Background(), "email-123", "att-9", &buf)
+
+	var apiErr *types.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Zero(t, buf.Len())
+}
@@ -0,0 +1,190 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// ExportContacts starts an asynchronous export of an audience's contacts.
+// Poll it with GetContactsExport, or use ExportContactsAndWait, and download
+// the file from DownloadURL once completed. Prefer this over paging through
+// very large audiences.
+func (c *AudiencesClient) ExportContacts(ctx context.Context, audienceID string, req *ExportAudienceContactsRequest) (*AudienceExport, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if req == nil {
+		req = &ExportAudienceContactsRequest{}
+	}
+
+	var resp AudienceExport
+	if err := c.http.Post(ctx, "/mail/audiences/"+audienceID+"/exports", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetContactsExport retrieves an audience export.
+func (c *AudiencesClient) GetContactsExport(ctx context.Context, audienceID, exportID string) (*AudienceExport, error) {
+	var resp AudienceExport
+	if err := c.http.Get(ctx, "/mail/audiences/"+audienceID+"/exports/"+exportID, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ExportContactsAndWaitOptions are options for ExportContactsAndWait.
+// Rate-limit and server errors while polling are retried up to
+// MaxTransientErrors times in a row; see client.PollOptions.
+type ExportContactsAndWaitOptions struct {
+	PollInterval       time.Duration
+	Timeout            time.Duration
+	MaxTransientErrors int
+}
+
+// ExportContactsAndWait starts an audience export and polls until it
+// completes, every 5 seconds for up to 30 minutes by default. It returns an
+// error if the export fails.
+func (c *AudiencesClient) ExportContactsAndWait(ctx context.Context, audienceID string, req *ExportAudienceContactsRequest, opts *ExportContactsAndWaitOptions) (*AudienceExport, error) {
+	pollInterval := 5 * time.Second
+	timeout := 30 * time.Minute
+	maxTransient := 0
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			pollInterval = opts.PollInterval
+		}
+		if opts.Timeout > 0 {
+			timeout = opts.Timeout
+		}
+		maxTransient = opts.MaxTransientErrors
+	}
+
+	started, err := c.ExportContacts(ctx, audienceID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Poll(ctx, client.PollOptions{
+		Interval:           pollInterval,
+		Timeout:            timeout,
+		MaxTransientErrors: maxTransient,
+		TimeoutMessage:     "Audience export timed out",
+	}, func(ctx context.Context) (*AudienceExport, bool, error) {
+		export, err := c.GetContactsExport(ctx, audienceID, started.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		if export.Status == AudienceExportStatusFailed {
+			errMsg := "Audience export failed"
+			if export.Error != nil {
+				errMsg += ": " + *export.Error
+			}
+			return nil, false, errors.New(errMsg)
+		}
+		return export, export.Status == AudienceExportStatusCompleted, nil
+	})
+}
+
+// AudienceContactIterator streams the contacts of an audience, fetching one
+// page at a time. Create one with AudiencesClient.IterateContacts:
+//
+//	it := client.Mail.Audiences.IterateContacts(ctx, &mail.ListAudienceContactsRequest{ID: "aud_123"})
+//	for it.Next() {
+//		contact := it.Contact()
+//		// ...
+//	}
+//	if err := it.Err(); err != nil {
+//		// ...
+//	}
+type AudienceContactIterator struct {
+	ctx     context.Context
+	c       *AudiencesClient
+	req     ListAudienceContactsRequest
+	limit   int
+	offset  int
+	cursor  *string
+	page    []AudienceContact
+	index   int
+	current *AudienceContact
+	done    bool
+	err     error
+}
+
+// IterateContacts returns an iterator over the contacts of the audience
+// req.ID matching req's filters. Pages are requested with cursors when the
+// API returns them, so iteration stays fast through very large audiences.
+// req.Limit sets the page size.
+func (c *AudiencesClient) IterateContacts(ctx context.Context, req *ListAudienceContactsRequest) *AudienceContactIterator {
+	it := &AudienceContactIterator{ctx: ctx, c: c, req: *req, limit: defaultExportPageSize}
+	if req.Limit != nil {
+		it.limit = *req.Limit
+	}
+	if req.Offset != nil {
+		it.offset = *req.Offset
+	}
+	it.cursor = req.Cursor
+	return it
+}
+
+// Next advances to the next contact, fetching the next page when needed. It
+// returns false when there are no more contacts or a request failed; check
+// Err to tell them apart.
+func (it *AudienceContactIterator) Next() bool {
+	if it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		if err := it.fetch(); err != nil {
+			it.err = err
+			return false
+		}
+		if len(it.page) == 0 {
+			return false
+		}
+	}
+	it.current = &it.page[it.index]
+	it.index++
+	return true
+}
+
+// Contact returns the current contact.
+func (it *AudienceContactIterator) Contact() *AudienceContact {
+	return it.current
+}
+
+// Err returns the error that stopped iteration, if any.
+func (it *AudienceContactIterator) Err() error {
+	return it.err
+}
+
+func (it *AudienceContactIterator) fetch() error {
+	req := it.req
+	req.Limit = &it.limit
+	if it.cursor != nil {
+		req.Cursor = it.cursor
+		req.Offset = nil
+	} else {
+		offset := it.offset
+		req.Offset = &offset
+	}
+
+	resp, err := it.c.ListContacts(it.ctx, &req)
+	if err != nil {
+		return err
+	}
+	it.page = resp.Contacts
+	it.index = 0
+	it.offset += len(resp.Contacts)
+
+	switch {
+	case resp.NextCursor != nil:
+		it.cursor = resp.NextCursor
+	case it.cursor != nil, len(resp.Contacts) < it.limit, it.offset >= resp.Total:
+		it.done = true
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudiencesClient_ExportContactsAndWait(t *testing.T) {
+	polls := 0
+	audiencesClient, server := setupAudiencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/mail/audiences/aud-123/exports":
+			var req ExportAudienceContactsRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, ExportFormatNDJSON, req.Format)
+			json.NewEncoder(w).Encode(AudienceExport{ID: "exp-1", Status: AudienceExportStatusPending})
+		case r.Method == http.MethodGet && r.URL.Path == "/mail/audiences/aud-123/exports/exp-1":
+			polls++
+			if polls < 2 {
+				json.NewEncoder(w).Encode(AudienceExport{ID: "exp-1", Status: AudienceExportStatusProcessing})
+				return
+			}
+			json.NewEncoder(w).Encode(AudienceExport{
+				ID:            "exp-1",
+				Status:        AudienceExportStatusCompleted,
+				TotalContacts: 5000000,
+				DownloadURL:   ptr("https://exports.example.com/exp-1.ndjson"),
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	resp, err := audiencesClient.ExportContactsAndWait(context.Background(), "aud-123",
+		&ExportAudienceContactsRequest{Format: ExportFormatNDJSON},
+		&ExportContactsAndWaitOptions{PollInterval: time.Millisecond, Timeout: time.Second})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, "https://exports.example.com/exp-1.ndjson", *resp.DownloadURL)
+}
+
+func TestAudiencesClient_ExportContactsAndWait_Failed(t *testing.T) {
+	audiencesClient, server := setupAudiencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			json.NewEncoder(w).Encode(AudienceExport{ID: "exp-1", Status: AudienceExportStatusPending})
+			return
+		}
+		json.NewEncoder(w).Encode(AudienceExport{ID: "exp-1", Status: AudienceExportStatusFailed, Error: ptr("storage unavailable")})
+	})
+	defer server.Close()
+
+	_, err := audiencesClient.ExportContactsAndWait(context.Background(), "aud-123", nil,
+		&ExportContactsAndWaitOptions{PollInterval: time.Millisecond, Timeout: time.Second})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "storage unavailable")
+}
+
+func TestAudiencesClient_IterateContacts(t *testing.T) {
+	t.Run("cursor", func(t *testing.T) {
+		var cursors []string
+		audiencesClient, server := setupAudiencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/mail/audiences/aud-123/contacts", r.URL.Path)
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			cursor := r.URL.Query().Get("cursor")
+			cursors = append(cursors, cursor)
+
+			resp := ListAudienceContactsResponse{Total: 3}
+			switch cursor {
+			case "":
+				assert.Equal(t, "0", r.URL.Query().Get("offset"))
+				resp.Contacts = []AudienceContact{{MailContact: MailContact{ID: "c1"}}, {MailContact: MailContact{ID: "c2"}}}
+				resp.NextCursor = ptr("next-1")
+			case "next-1":
+				assert.Empty(t, r.URL.Query().Get("offset"))
+				resp.Contacts = []AudienceContact{{MailContact: MailContact{ID: "c3"}}}
+			}
+			json.NewEncoder(w).Encode(resp)
+		})
+		defer server.Close()
+
+		it := audiencesClient.IterateContacts(context.Background(), &ListAudienceContactsRequest{ID: "aud-123", Limit: ptr(2)})
+		var ids []string
+		for it.Next() {
+			ids = append(ids, it.Contact().ID)
+		}
+
+		require.NoError(t, it.Err())
+		assert.Equal(t, []string{"c1", "c2", "c3"}, ids)
+		assert.Equal(t, []string{"", "next-1"}, cursors)
+	})
+
+	t.Run("offset fallback", func(t *testing.T) {
+		requests := 0
+		audiencesClient, server := setupAudiencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			var contacts []AudienceContact
+			for i := offset; i < 4 && i < offset+2; i++ {
+				contacts = append(contacts, AudienceContact{MailContact: MailContact{ID: "c" + strconv.Itoa(i)}})
+			}
+			json.NewEncoder(w).Encode(ListAudienceContactsResponse{Contacts: contacts, Total: 4})
+		})
+		defer server.Close()
+
+		it := audiencesClient.IterateContacts(context.Background(), &ListAudienceContactsRequest{ID: "aud-123", Limit: ptr(2)})
+		n := 0
+		for it.Next() {
+			n++
+		}
+
+		require.NoError(t, it.Err())
+		assert.Equal(t, 4, n)
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		audiencesClient, server := setupAudiencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error":"boom"}`))
+		})
+		defer server.Close()
+
+		it := audiencesClient.IterateContacts(context.Background(), &ListAudienceContactsRequest{ID: "aud-123"})
+
+		assert.False(t, it.Next())
+		assert.Error(t, it.Err())
+	})
+}
@@ -2,10 +2,10 @@ package mail
 
 import (
 	"context"
-	"net/url"
 	"strconv"
 
 	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
 )
 
 // AudiencesClient handles audience operations.
@@ -20,7 +20,11 @@ func NewAudiencesClient(http *client.HTTPClient) *AudiencesClient {
 
 // List lists all audiences.
 func (c *AudiencesClient) List(ctx context.Context, req *ListAudiencesRequest) (*ListAudiencesResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -59,6 +63,10 @@ func (c *AudiencesClient) Get(ctx context.Context, id string) (*Audience, error)
 
 // Create creates a new audience.
 func (c *AudiencesClient) Create(ctx context.Context, req *CreateAudienceRequest) (*Audience, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Audience
 	if err := c.http.Post(ctx, "/mail/audiences", req, &resp); err != nil {
 		return nil, err
@@ -68,6 +76,10 @@ func (c *AudiencesClient) Create(ctx context.Context, req *CreateAudienceRequest
 
 // Update updates an audience.
 func (c *AudiencesClient) Update(ctx context.Context, req *UpdateAudienceRequest) (*Audience, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Audience
 	if err := c.http.Put(ctx, "/mail/audiences/"+req.ID, req, &resp); err != nil {
 		return nil, err
@@ -84,9 +96,37 @@ func (c *AudiencesClient) Delete(ctx context.Context, id string) (*DeleteAudienc
 	return &resp, nil
 }
 
+// Duplicate copies an audience into targetEnvironment, e.g. to promote an
+// audience set up in sandbox to production. Pass req to rename the copy or
+// copy its contacts too; a nil req copies only the audience.
+func (c *AudiencesClient) Duplicate(ctx context.Context, id string, targetEnvironment types.Environment, req *DuplicateAudienceRequest) (*DuplicateAudienceResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{"targetEnvironment": targetEnvironment}
+	if req != nil {
+		if req.Name != nil {
+			body["name"] = *req.Name
+		}
+		if req.IncludeContacts != nil {
+			body["includeContacts"] = *req.IncludeContacts
+		}
+	}
+	var resp DuplicateAudienceResponse
+	if err := c.http.Post(ctx, "/mail/audiences/"+id+"/duplicate", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // ListContacts lists contacts in an audience.
 func (c *AudiencesClient) ListContacts(ctx context.Context, req *ListAudienceContactsRequest) (*ListAudienceContactsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -102,6 +142,9 @@ func (c *AudiencesClient) ListContacts(ctx context.Context, req *ListAudienceCon
 	if req.Status != nil {
 		params.Set("status", string(*req.Status))
 	}
+	if req.Cursor != nil {
+		params.Set("cursor", *req.Cursor)
+	}
 
 	path := "/mail/audiences/" + req.ID + "/contacts"
 	if len(params) > 0 {
@@ -117,6 +160,10 @@ func (c *AudiencesClient) ListContacts(ctx context.Context, req *ListAudienceCon
 
 // AddContacts adds contacts to an audience.
 func (c *AudiencesClient) AddContacts(ctx context.Context, req *AddContactsToAudienceRequest) (*AddContactsToAudienceResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp AddContactsToAudienceResponse
 	body := map[string]interface{}{"contactIds": req.ContactIDs}
 	if err := c.http.Post(ctx, "/mail/audiences/"+req.ID+"/contacts", body, &resp); err != nil {
@@ -127,6 +174,10 @@ func (c *AudiencesClient) AddContacts(ctx context.Context, req *AddContactsToAud
 
 // RemoveContacts removes contacts from an audience.
 func (c *AudiencesClient) RemoveContacts(ctx context.Context, req *RemoveContactsFromAudienceRequest) (*RemoveContactsFromAudienceResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp RemoveContactsFromAudienceResponse
 	body := map[string]interface{}{"contactIds": req.ContactIDs}
 	if err := c.http.DeleteWithBody(ctx, "/mail/audiences/"+req.ID+"/contacts", body, &resp); err != nil {
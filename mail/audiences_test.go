@@ -168,6 +168,36 @@ func TestAudiencesClient_Delete(t *testing.T) {
 	assert.True(t, resp.Success)
 }
 
+func TestAudiencesClient_Duplicate(t *testing.T) {
+	audiencesClient, server := setupAudiencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/audiences/aud-123/duplicate", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "production", body["targetEnvironment"])
+		assert.Equal(t, true, body["includeContacts"])
+		assert.NotContains(t, body, "name")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DuplicateAudienceResponse{
+			Audience:       Audience{ID: "aud-456", Environment: "production", Name: "Newsletter"},
+			ContactsCopied: 42,
+		})
+	})
+	defer server.Close()
+
+	includeContacts := true
+	resp, err := audiencesClient.Duplicate(context.Background(), "aud-123", types.EnvironmentProduction, &DuplicateAudienceRequest{
+		IncludeContacts: &includeContacts,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "aud-456", resp.Audience.ID)
+	assert.Equal(t, "production", resp.Audience.Environment)
+	assert.Equal(t, 42, resp.ContactsCopied)
+}
+
 func TestAudiencesClient_ListContacts(t *testing.T) {
 	audienceID := "aud-123"
 	audiencesClient, server := setupAudiencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,83 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// CalendarClient handles sending calendar operations.
+type CalendarClient struct {
+	http *client.HTTPClient
+}
+
+// NewCalendarClient creates a new sending calendar client.
+func NewCalendarClient(http *client.HTTPClient) *CalendarClient {
+	return &CalendarClient{http: http}
+}
+
+// Get retrieves the sending calendar for a project.
+func (c *CalendarClient) Get(ctx context.Context, req *GetSendingCalendarRequest) (*SendingCalendar, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.ProjectSlug != nil {
+			params.Set("projectSlug", *req.ProjectSlug)
+		}
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+	}
+
+	path := "/mail/calendar"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp SendingCalendar
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update updates the sending calendar for a project.
+func (c *CalendarClient) Update(ctx context.Context, req *UpdateSendingCalendarRequest) (*SendingCalendar, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SendingCalendar
+	if err := c.http.Put(ctx, "/mail/calendar", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CheckWindow reports whether an email may be sent at the given time.
+func (c *CalendarClient) CheckWindow(ctx context.Context, req *CheckSendingWindowRequest) (*CheckSendingWindowResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("at", client.FormatTime(req.At))
+	if req.ProjectSlug != nil {
+		params.Set("projectSlug", *req.ProjectSlug)
+	}
+	if req.Environment != nil {
+		params.Set("environment", string(*req.Environment))
+	}
+	if req.Region != nil {
+		params.Set("region", *req.Region)
+	}
+
+	var resp CheckSendingWindowResponse
+	if err := c.http.Get(ctx, "/mail/calendar/check?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
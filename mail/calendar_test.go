@@ -0,0 +1,105 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCalendarTestClient(t *testing.T, handler http.HandlerFunc) (*CalendarClient, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewCalendarClient(httpClient), server
+}
+
+func TestCalendarClient_Get(t *testing.T) {
+	calendarClient, server := setupCalendarTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/calendar", r.URL.Path)
+		assert.Equal(t, "my-project", r.URL.Query().Get("projectSlug"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SendingCalendar{
+			ProjectSlug:     "my-project",
+			Enabled:         true,
+			Timezone:        "Europe/London",
+			AllowedWeekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			AllowedHours:    &SendingHours{Start: "09:00", End: "17:00"},
+			HolidayRegions:  []string{"GB"},
+		})
+	})
+	defer server.Close()
+
+	resp, err := calendarClient.Get(context.Background(), &GetSendingCalendarRequest{
+		ProjectSlug: ptr("my-project"),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Enabled)
+	assert.Len(t, resp.AllowedWeekdays, 5)
+	assert.Equal(t, "09:00", resp.AllowedHours.Start)
+}
+
+func TestCalendarClient_Update(t *testing.T) {
+	calendarClient, server := setupCalendarTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/calendar", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{float64(1), float64(5)}, body["allowedWeekdays"])
+		assert.Equal(t, []interface{}{"US"}, body["holidayRegions"])
+		blackouts := body["blackoutDates"].([]interface{})
+		require.Len(t, blackouts, 1)
+		assert.Equal(t, "2024-12-25", blackouts[0].(map[string]interface{})["date"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SendingCalendar{Enabled: true})
+	})
+	defer server.Close()
+
+	resp, err := calendarClient.Update(context.Background(), &UpdateSendingCalendarRequest{
+		Enabled:         ptr(true),
+		AllowedWeekdays: []time.Weekday{time.Monday, time.Friday},
+		BlackoutDates:   []BlackoutDate{{Date: "2024-12-25", Name: ptr("Christmas")}},
+		HolidayRegions:  []string{"US"},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Enabled)
+}
+
+func TestCalendarClient_CheckWindow(t *testing.T) {
+	nextAllowed := time.Date(2024, 12, 26, 9, 0, 0, 0, time.UTC)
+	calendarClient, server := setupCalendarTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/calendar/check", r.URL.Path)
+		assert.Equal(t, "2024-12-25T10:00:00Z", r.URL.Query().Get("at"))
+		assert.Equal(t, "GB", r.URL.Query().Get("region"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CheckSendingWindowResponse{
+			Allowed:       false,
+			Reason:        ptr("blackout_date"),
+			NextAllowedAt: &nextAllowed,
+		})
+	})
+	defer server.Close()
+
+	resp, err := calendarClient.CheckWindow(context.Background(), &CheckSendingWindowRequest{
+		At:     time.Date(2024, 12, 25, 10, 0, 0, 0, time.UTC),
+		Region: ptr("GB"),
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Allowed)
+	assert.True(t, nextAllowed.Equal(*resp.NextAllowedAt))
+}
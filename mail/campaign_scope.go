@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// CampaignScope is a handle to a single campaign, returned by
+// CampaignsClient.For. Like SequenceScope, it fills in the campaign ID on
+// every call without modifying the caller's request.
+type CampaignScope struct {
+	c  *CampaignsClient
+	id string
+}
+
+// For returns a handle to the campaign with the given ID.
+func (c *CampaignsClient) For(id string) *CampaignScope {
+	return &CampaignScope{c: c, id: id}
+}
+
+// ID returns the ID of the campaign.
+func (s *CampaignScope) ID() string {
+	return s.id
+}
+
+// Get gets the campaign, expanding any requested references.
+func (s *CampaignScope) Get(ctx context.Context, expand ...CampaignExpand) (*Campaign, error) {
+	return s.c.Get(ctx, s.id, expand...)
+}
+
+// Update updates the campaign.
+func (s *CampaignScope) Update(ctx context.Context, req *UpdateCampaignRequest) (*Campaign, error) {
+	return s.c.Update(ctx, types.WithID(req, func(r *UpdateCampaignRequest) { r.ID = s.id }))
+}
+
+// Delete deletes the campaign.
+func (s *CampaignScope) Delete(ctx context.Context) (*DeleteCampaignResponse, error) {
+	return s.c.Delete(ctx, s.id)
+}
+
+// Send sends or schedules the campaign.
+func (s *CampaignScope) Send(ctx context.Context, req *SendCampaignRequest) (*SendCampaignResponse, error) {
+	return s.c.Send(ctx, types.WithID(req, func(r *SendCampaignRequest) { r.ID = s.id }))
+}
+
+// SendTest delivers the campaign to the given reviewers.
+func (s *CampaignScope) SendTest(ctx context.Context, recipients []string, variables map[string]interface{}) (*SendCampaignTestResponse, error) {
+	return s.c.SendTest(ctx, s.id, recipients, variables)
+}
+
+// Pause pauses the campaign.
+func (s *CampaignScope) Pause(ctx context.Context) (*PauseCampaignResponse, error) {
+	return s.c.Pause(ctx, s.id)
+}
+
+// Resume resumes the campaign.
+func (s *CampaignScope) Resume(ctx context.Context) (*ResumeCampaignResponse, error) {
+	return s.c.Resume(ctx, s.id)
+}
+
+// Cancel cancels the campaign.
+func (s *CampaignScope) Cancel(ctx context.Context) (*CancelCampaignResponse, error) {
+	return s.c.Cancel(ctx, s.id)
+}
+
+// Duplicate duplicates the campaign as-is.
+func (s *CampaignScope) Duplicate(ctx context.Context) (*Campaign, error) {
+	return s.c.Duplicate(ctx, s.id)
+}
+
+// DuplicateWithOverrides duplicates the campaign, overriding fields of the
+// copy with req.
+func (s *CampaignScope) DuplicateWithOverrides(ctx context.Context, req *DuplicateCampaignRequest) (*Campaign, error) {
+	return s.c.DuplicateWithOverrides(ctx, s.id, req)
+}
+
+// ListRecipients lists the recipients of the campaign.
+func (s *CampaignScope) ListRecipients(ctx context.Context, req *ListCampaignRecipientsRequest) (*ListCampaignRecipientsResponse, error) {
+	return s.c.ListRecipients(ctx, s.id, req)
+}
+
+// GetStats retrieves the campaign's statistics.
+func (s *CampaignScope) GetStats(ctx context.Context) (*CampaignStatsResponse, error) {
+	return s.c.GetStats(ctx, s.id)
+}
@@ -0,0 +1,45 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCampaignScope_Send(t *testing.T) {
+	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/campaigns/camp-123/send", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SendCampaignResponse{Success: true})
+	})
+	defer server.Close()
+
+	campaign := campaignsClient.For("camp-123")
+	assert.Equal(t, "camp-123", campaign.ID())
+
+	resp, err := campaign.Send(context.Background(), &SendCampaignRequest{SendNow: ptr(true)})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestCampaignScope_GetStats(t *testing.T) {
+	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/campaigns/camp-123/stats", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	_, err := campaignsClient.For("camp-123").GetStats(context.Background())
+
+	require.NoError(t, err)
+}
@@ -20,7 +20,11 @@ func NewCampaignsClient(http *client.HTTPClient) *CampaignsClient {
 
 // List lists all campaigns.
 func (c *CampaignsClient) List(ctx context.Context, req *ListCampaignsRequest) (*ListCampaignsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -52,9 +56,9 @@ func (c *CampaignsClient) List(ctx context.Context, req *ListCampaignsRequest) (
 }
 
 // Get retrieves a campaign by ID.
-func (c *CampaignsClient) Get(ctx context.Context, id string) (*Campaign, error) {
+func (c *CampaignsClient) Get(ctx context.Context, id string, expand ...CampaignExpand) (*Campaign, error) {
 	var resp Campaign
-	if err := c.http.Get(ctx, "/mail/campaigns/"+id, &resp); err != nil {
+	if err := c.http.Get(ctx, client.WithExpand("/mail/campaigns/"+id, expand), &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -62,6 +66,10 @@ func (c *CampaignsClient) Get(ctx context.Context, id string) (*Campaign, error)
 
 // Create creates a new campaign.
 func (c *CampaignsClient) Create(ctx context.Context, req *CreateCampaignRequest) (*Campaign, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Campaign
 	if err := c.http.Post(ctx, "/mail/campaigns", req, &resp); err != nil {
 		return nil, err
@@ -71,6 +79,10 @@ func (c *CampaignsClient) Create(ctx context.Context, req *CreateCampaignRequest
 
 // Update updates a campaign.
 func (c *CampaignsClient) Update(ctx context.Context, req *UpdateCampaignRequest) (*Campaign, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Campaign
 	if err := c.http.Put(ctx, "/mail/campaigns/"+req.ID, req, &resp); err != nil {
 		return nil, err
@@ -89,6 +101,10 @@ func (c *CampaignsClient) Delete(ctx context.Context, id string) (*DeleteCampaig
 
 // Send sends a campaign.
 func (c *CampaignsClient) Send(ctx context.Context, req *SendCampaignRequest) (*SendCampaignResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SendCampaignResponse
 	body := map[string]interface{}{}
 	if req.SendNow != nil {
@@ -97,12 +113,45 @@ func (c *CampaignsClient) Send(ctx context.Context, req *SendCampaignRequest) (*
 	if req.ScheduledAt != nil {
 		body["scheduledAt"] = req.ScheduledAt.Format("2006-01-02T15:04:05Z07:00")
 	}
+	if req.IgnoreSendingCalendar != nil {
+		body["ignoreSendingCalendar"] = *req.IgnoreSendingCalendar
+	}
+	if req.SendRate != nil {
+		body["sendRate"] = req.SendRate
+	}
+	if req.RampUp != nil {
+		body["rampUp"] = req.RampUp
+	}
+	if req.SendAtLocalTime != nil {
+		body["sendAtLocalTime"] = *req.SendAtLocalTime
+	}
+	if req.TimezoneResolution != nil {
+		body["timezoneResolution"] = *req.TimezoneResolution
+	}
+	if req.FallbackTimezone != nil {
+		body["fallbackTimezone"] = *req.FallbackTimezone
+	}
 	if err := c.http.Post(ctx, "/mail/campaigns/"+req.ID+"/send", body, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
+// SendTest renders the campaign and delivers it to the given reviewers.
+// Test sends are excluded from campaign stats and do not change audience or
+// contact state. variables override the template variables for the render.
+func (c *CampaignsClient) SendTest(ctx context.Context, campaignID string, recipients []string, variables map[string]interface{}) (*SendCampaignTestResponse, error) {
+	var resp SendCampaignTestResponse
+	body := map[string]interface{}{"recipients": recipients}
+	if variables != nil {
+		body["variables"] = variables
+	}
+	if err := c.http.Post(ctx, "/mail/campaigns/"+campaignID+"/send-test", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Pause pauses a sending campaign.
 func (c *CampaignsClient) Pause(ctx context.Context, id string) (*PauseCampaignResponse, error) {
 	var resp PauseCampaignResponse
@@ -112,6 +161,16 @@ func (c *CampaignsClient) Pause(ctx context.Context, id string) (*PauseCampaignR
 	return &resp, nil
 }
 
+// Resume resumes a paused campaign, continuing with recipients that have not
+// been sent to yet.
+func (c *CampaignsClient) Resume(ctx context.Context, id string) (*ResumeCampaignResponse, error) {
+	var resp ResumeCampaignResponse
+	if err := c.http.Post(ctx, "/mail/campaigns/"+id+"/resume", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Cancel cancels a campaign.
 func (c *CampaignsClient) Cancel(ctx context.Context, id string) (*CancelCampaignResponse, error) {
 	var resp CancelCampaignResponse
@@ -121,10 +180,64 @@ func (c *CampaignsClient) Cancel(ctx context.Context, id string) (*CancelCampaig
 	return &resp, nil
 }
 
-// Duplicate duplicates a campaign.
+// Duplicate duplicates a campaign as-is.
 func (c *CampaignsClient) Duplicate(ctx context.Context, id string) (*Campaign, error) {
+	return c.DuplicateWithOverrides(ctx, id, nil)
+}
+
+// DuplicateWithOverrides duplicates a campaign, overriding fields of the copy
+// with req, e.g. a new name and schedule when cloning a recurring newsletter.
+// A nil req copies the campaign as-is.
+func (c *CampaignsClient) DuplicateWithOverrides(ctx context.Context, id string, req *DuplicateCampaignRequest) (*Campaign, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var body interface{} = map[string]interface{}{}
+	if req != nil {
+		body = req
+	}
 	var resp Campaign
-	if err := c.http.Post(ctx, "/mail/campaigns/"+id+"/duplicate", map[string]interface{}{}, &resp); err != nil {
+	if err := c.http.Post(ctx, "/mail/campaigns/"+id+"/duplicate", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListRecipients lists the recipients of a campaign with the status and
+// timestamps of each recipient's email. Filter by Email or ContactID to check
+// whether a specific contact received the campaign.
+func (c *CampaignsClient) ListRecipients(ctx context.Context, campaignID string, req *ListCampaignRecipientsRequest) (*ListCampaignRecipientsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req != nil {
+		if req.Email != nil {
+			params.Set("email", *req.Email)
+		}
+		if req.ContactID != nil {
+			params.Set("contactId", *req.ContactID)
+		}
+		if req.Status != nil {
+			params.Set("status", string(*req.Status))
+		}
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+	}
+
+	path := "/mail/campaigns/" + campaignID + "/recipients"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListCampaignRecipientsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
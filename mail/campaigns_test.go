@@ -92,6 +92,55 @@ func TestCampaignsClient_Get(t *testing.T) {
 	assert.Equal(t, 1000, resp.TotalRecipients)
 }
 
+func TestCampaignsClient_Get_Expand(t *testing.T) {
+	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/campaigns/camp-123", r.URL.Path)
+		assert.Equal(t, "audience,template", r.URL.Query().Get("expand"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Campaign{
+			ID:       "camp-123",
+			Audience: &Audience{ID: "aud-1", Name: "Newsletter"},
+			Template: &Template{ID: "tpl-1", Name: "Monthly"},
+		})
+	})
+	defer server.Close()
+
+	resp, err := campaignsClient.Get(context.Background(), "camp-123", CampaignExpandAudience, CampaignExpandTemplate)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Newsletter", resp.Audience.Name)
+	assert.Equal(t, "Monthly", resp.Template.Name)
+}
+
+func TestCampaignsClient_Get_ResolvedContent(t *testing.T) {
+	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "resolvedContent", r.URL.Query().Get("expand"))
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "camp-123",
+			"templateId": "tpl-1",
+			"subject": "Spring sale",
+			"resolvedContent": {
+				"subject": "Spring sale",
+				"html": "<p>Hi {{firstName}}, 20% off</p>",
+				"templateId": "tpl-1",
+				"templateVersion": 4,
+				"resolvedAt": "2024-03-01T10:00:00Z"
+			}
+		}`))
+	})
+	defer server.Close()
+
+	resp, err := campaignsClient.Get(context.Background(), "camp-123", CampaignExpandResolvedContent)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.ResolvedContent)
+	assert.Equal(t, "<p>Hi {{firstName}}, 20% off</p>", resp.ResolvedContent.HTML)
+	assert.Equal(t, 4, *resp.ResolvedContent.TemplateVersion)
+}
+
 func TestCampaignsClient_Create(t *testing.T) {
 	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
@@ -226,6 +275,151 @@ func TestCampaignsClient_Send(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, resp.Success)
 	})
+
+	t.Run("ignore sending calendar", func(t *testing.T) {
+		campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, true, body["ignoreSendingCalendar"])
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SendCampaignResponse{Success: true})
+		})
+		defer server.Close()
+
+		_, err := campaignsClient.Send(context.Background(), &SendCampaignRequest{
+			ID:                    "camp-123",
+			SendNow:               ptr(true),
+			IgnoreSendingCalendar: ptr(true),
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("send at local time", func(t *testing.T) {
+		campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, "2024-03-04T09:00:00Z", body["scheduledAt"])
+			assert.Equal(t, true, body["sendAtLocalTime"])
+			assert.Equal(t, "inferred", body["timezoneResolution"])
+			assert.Equal(t, "America/New_York", body["fallbackTimezone"])
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SendCampaignResponse{Success: true})
+		})
+		defer server.Close()
+
+		scheduledAt := time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC)
+		resolution := TimezoneResolutionInferred
+		_, err := campaignsClient.Send(context.Background(), &SendCampaignRequest{
+			ID:                 "camp-123",
+			ScheduledAt:        &scheduledAt,
+			SendAtLocalTime:    ptr(true),
+			TimezoneResolution: &resolution,
+			FallbackTimezone:   ptr("America/New_York"),
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("throttled with ramp-up", func(t *testing.T) {
+		campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			sendRate := body["sendRate"].(map[string]interface{})
+			assert.Equal(t, float64(5000), sendRate["maxPerHour"])
+			assert.NotContains(t, sendRate, "maxPerDay")
+			steps := body["rampUp"].(map[string]interface{})["steps"].([]interface{})
+			require.Len(t, steps, 2)
+			assert.Equal(t, map[string]interface{}{"hours": float64(24), "maxPerHour": float64(500)}, steps[0])
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SendCampaignResponse{Success: true})
+		})
+		defer server.Close()
+
+		_, err := campaignsClient.Send(context.Background(), &SendCampaignRequest{
+			ID:       "camp-123",
+			SendNow:  ptr(true),
+			SendRate: &CampaignSendRate{MaxPerHour: ptr(5000)},
+			RampUp: &CampaignRampUp{Steps: []RampUpStep{
+				{Hours: 24, MaxPerHour: 500},
+				{Hours: 24, MaxPerHour: 2000},
+			}},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestCampaignsClient_SendTest(t *testing.T) {
+	campaignID := "camp-123"
+	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/campaigns/"+campaignID+"/send-test", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"qa@example.com", "marketing@example.com"}, body["recipients"])
+		assert.Equal(t, "Alice", body["variables"].(map[string]interface{})["firstName"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SendCampaignTestResponse{
+			Success: true,
+			Results: []BatchEmailResult{
+				{ID: "email-1", Success: true},
+				{ID: "email-2", Success: true},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := campaignsClient.SendTest(context.Background(), campaignID,
+		[]string{"qa@example.com", "marketing@example.com"},
+		map[string]interface{}{"firstName": "Alice"},
+	)
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Len(t, resp.Results, 2)
+}
+
+func TestCampaignsClient_ListRecipients(t *testing.T) {
+	campaignID := "camp-123"
+	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/campaigns/"+campaignID+"/recipients", r.URL.Path)
+		assert.Equal(t, "user@example.com", r.URL.Query().Get("email"))
+
+		deliveredAt := time.Date(2024, 3, 1, 10, 0, 5, 0, time.UTC)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListCampaignRecipientsResponse{
+			Recipients: []CampaignRecipient{{
+				ContactID:   "contact-1",
+				Email:       "user@example.com",
+				EmailID:     ptr("email-1"),
+				Status:      EmailStatusDelivered,
+				DeliveredAt: &deliveredAt,
+			}},
+			Total: 1,
+		})
+	})
+	defer server.Close()
+
+	resp, err := campaignsClient.ListRecipients(context.Background(), campaignID, &ListCampaignRecipientsRequest{
+		Email: ptr("user@example.com"),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Recipients, 1)
+	assert.Equal(t, EmailStatusDelivered, resp.Recipients[0].Status)
+	assert.NotNil(t, resp.Recipients[0].DeliveredAt)
+	assert.Nil(t, resp.Recipients[0].OpenedAt)
 }
 
 func TestCampaignsClient_Pause(t *testing.T) {
@@ -245,6 +439,30 @@ func TestCampaignsClient_Pause(t *testing.T) {
 	assert.True(t, resp.Success)
 }
 
+func TestCampaignsClient_Resume(t *testing.T) {
+	campaignID := "camp-123"
+	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/campaigns/"+campaignID+"/resume", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ResumeCampaignResponse{
+			Success:             true,
+			Status:              CampaignStatusSending,
+			TotalRecipients:     1000,
+			SentCount:           400,
+			RemainingRecipients: 600,
+		})
+	})
+	defer server.Close()
+
+	resp, err := campaignsClient.Resume(context.Background(), campaignID)
+
+	require.NoError(t, err)
+	assert.Equal(t, CampaignStatusSending, resp.Status)
+	assert.Equal(t, 600, resp.RemainingRecipients)
+}
+
 func TestCampaignsClient_Cancel(t *testing.T) {
 	campaignID := "camp-123"
 	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -284,6 +502,43 @@ func TestCampaignsClient_Duplicate(t *testing.T) {
 	assert.Equal(t, "draft", resp.Status)
 }
 
+func TestCampaignsClient_Duplicate_WithOverrides(t *testing.T) {
+	campaignID := "camp-123"
+	scheduledAt := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/campaigns/"+campaignID+"/duplicate", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "November Newsletter", body["name"])
+		assert.Equal(t, "aud-456", body["audienceId"])
+		assert.Equal(t, "2026-11-01T09:00:00Z", body["scheduledAt"])
+		assert.Equal(t, true, body["clearStats"])
+		assert.NotContains(t, body, "subject")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Campaign{
+			ID:     "camp-456",
+			Name:   "November Newsletter",
+			Status: "scheduled",
+		})
+	})
+	defer server.Close()
+
+	name := "November Newsletter"
+	audienceID := "aud-456"
+	clearStats := true
+	resp, err := campaignsClient.DuplicateWithOverrides(context.Background(), campaignID, &DuplicateCampaignRequest{
+		Name:        &name,
+		AudienceID:  &audienceID,
+		ScheduledAt: &scheduledAt,
+		ClearStats:  &clearStats,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "November Newsletter", resp.Name)
+}
+
 func TestCampaignsClient_GetStats(t *testing.T) {
 	campaignID := "camp-123"
 	campaignsClient, server := setupCampaignsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/stack0/sdk-go/client"
 )
@@ -14,11 +16,16 @@ type Client struct {
 	http      *client.HTTPClient
 	Domains   *DomainsClient
 	Templates *TemplatesClient
+	Partials  *PartialsClient
 	Audiences *AudiencesClient
+	Segments  *SegmentsClient
 	Contacts  *ContactsClient
 	Campaigns *CampaignsClient
 	Sequences *SequencesClient
 	Events    *EventsClient
+	Calendar  *CalendarClient
+	Webhooks  *WebhooksClient
+	Providers *ProvidersClient
 }
 
 // New creates a new mail client.
@@ -27,16 +34,25 @@ func New(http *client.HTTPClient) *Client {
 		http:      http,
 		Domains:   NewDomainsClient(http),
 		Templates: NewTemplatesClient(http),
+		Partials:  NewPartialsClient(http),
 		Audiences: NewAudiencesClient(http),
+		Segments:  NewSegmentsClient(http),
 		Contacts:  NewContactsClient(http),
 		Campaigns: NewCampaignsClient(http),
 		Sequences: NewSequencesClient(http),
 		Events:    NewEventsClient(http),
+		Calendar:  NewCalendarClient(http),
+		Webhooks:  NewWebhooksClient(http),
+		Providers: NewProvidersClient(http),
 	}
 }
 
 // Send sends a single email.
 func (c *Client) Send(ctx context.Context, req *SendEmailRequest) (*SendEmailResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SendEmailResponse
 	if err := c.http.Post(ctx, "/mail/send", req, &resp); err != nil {
 		return nil, err
@@ -46,6 +62,10 @@ func (c *Client) Send(ctx context.Context, req *SendEmailRequest) (*SendEmailRes
 
 // SendBatch sends multiple emails in a batch.
 func (c *Client) SendBatch(ctx context.Context, req *SendBatchEmailRequest) (*SendBatchEmailResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SendBatchEmailResponse
 	if err := c.http.Post(ctx, "/mail/send/batch", req, &resp); err != nil {
 		return nil, err
@@ -55,6 +75,10 @@ func (c *Client) SendBatch(ctx context.Context, req *SendBatchEmailRequest) (*Se
 
 // SendBroadcast sends a broadcast email to multiple recipients.
 func (c *Client) SendBroadcast(ctx context.Context, req *SendBroadcastEmailRequest) (*SendBroadcastEmailResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SendBroadcastEmailResponse
 	if err := c.http.Post(ctx, "/mail/send/broadcast", req, &resp); err != nil {
 		return nil, err
@@ -71,9 +95,23 @@ func (c *Client) Get(ctx context.Context, id string) (*GetEmailResponse, error)
 	return &resp, nil
 }
 
+// GetMany retrieves multiple emails by ID in one request, e.g. to hydrate a
+// batch of webhook events. Emails are returned in the order of ids.
+func (c *Client) GetMany(ctx context.Context, ids []string) (*GetManyEmailsResponse, error) {
+	var resp GetManyEmailsResponse
+	if err := c.http.Post(ctx, "/mail/get-many", map[string][]string{"ids": ids}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // List lists emails with optional filters.
 func (c *Client) List(ctx context.Context, req *ListEmailsRequest) (*ListEmailsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.ProjectSlug != nil {
 			params.Set("projectSlug", *req.ProjectSlug)
@@ -102,18 +140,15 @@ func (c *Client) List(ctx context.Context, req *ListEmailsRequest) (*ListEmailsR
 		if req.Tag != nil {
 			params.Set("tag", *req.Tag)
 		}
-		if req.StartDate != nil {
-			params.Set("startDate", req.StartDate.Format("2006-01-02T15:04:05Z07:00"))
-		}
-		if req.EndDate != nil {
-			params.Set("endDate", req.EndDate.Format("2006-01-02T15:04:05Z07:00"))
-		}
+		client.SetTime(params, "startDate", req.StartDate)
+		client.SetTime(params, "endDate", req.EndDate)
 		if req.SortBy != nil {
 			params.Set("sortBy", *req.SortBy)
 		}
 		if req.SortOrder != nil {
 			params.Set("sortOrder", *req.SortOrder)
 		}
+		client.SetMetadataFilter(params, req.Metadata)
 	}
 
 	path := "/mail"
@@ -128,6 +163,52 @@ func (c *Client) List(ctx context.Context, req *ListEmailsRequest) (*ListEmailsR
 	return &resp, nil
 }
 
+// Search performs a full-text search over sent emails, matching the query
+// against subject, body and recipient. Results are ordered by relevance and
+// paginated with a cursor.
+func (c *Client) Search(ctx context.Context, query string, filters *SearchEmailsFilters) (*SearchEmailsResponse, error) {
+	params := c.http.EnvironmentQuery()
+	params.Set("q", query)
+	if filters != nil {
+		if filters.ProjectSlug != nil {
+			params.Set("projectSlug", *filters.ProjectSlug)
+		}
+		if filters.Environment != nil {
+			params.Set("environment", string(*filters.Environment))
+		}
+		if len(filters.Fields) > 0 {
+			fields := make([]string, len(filters.Fields))
+			for i, f := range filters.Fields {
+				fields[i] = string(f)
+			}
+			params.Set("fields", strings.Join(fields, ","))
+		}
+		if filters.Status != nil {
+			params.Set("status", string(*filters.Status))
+		}
+		if filters.From != nil {
+			params.Set("from", *filters.From)
+		}
+		if filters.Tag != nil {
+			params.Set("tag", *filters.Tag)
+		}
+		client.SetTime(params, "startDate", filters.StartDate)
+		client.SetTime(params, "endDate", filters.EndDate)
+		if filters.Limit != nil {
+			params.Set("limit", strconv.Itoa(*filters.Limit))
+		}
+		if filters.Cursor != nil {
+			params.Set("cursor", *filters.Cursor)
+		}
+	}
+
+	var resp SearchEmailsResponse
+	if err := c.http.Get(ctx, "/mail/search?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Resend resends an email by ID.
 func (c *Client) Resend(ctx context.Context, id string) (*ResendEmailResponse, error) {
 	var resp ResendEmailResponse
@@ -146,10 +227,180 @@ func (c *Client) Cancel(ctx context.Context, id string) (*CancelEmailResponse, e
 	return &resp, nil
 }
 
-// GetAnalytics retrieves overall email analytics.
+// GetAnalytics retrieves email analytics across all emails.
 func (c *Client) GetAnalytics(ctx context.Context) (*EmailAnalyticsResponse, error) {
+	return c.GetAnalyticsFiltered(ctx, nil)
+}
+
+// GetAnalyticsFiltered retrieves email analytics filtered by tag, sender,
+// domain, template, campaign and date range.
+func (c *Client) GetAnalyticsFiltered(ctx context.Context, req *EmailAnalyticsRequest) (*EmailAnalyticsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.ProjectSlug != nil {
+			params.Set("projectSlug", *req.ProjectSlug)
+		}
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.Tag != nil {
+			params.Set("tag", *req.Tag)
+		}
+		if req.From != nil {
+			params.Set("from", *req.From)
+		}
+		if req.Domain != nil {
+			params.Set("domain", *req.Domain)
+		}
+		if req.TemplateID != nil {
+			params.Set("templateId", *req.TemplateID)
+		}
+		if req.CampaignID != nil {
+			params.Set("campaignId", *req.CampaignID)
+		}
+		client.SetTime(params, "startDate", req.StartDate)
+		client.SetTime(params, "endDate", req.EndDate)
+	}
+
+	path := "/mail/analytics"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
 	var resp EmailAnalyticsResponse
-	if err := c.http.Get(ctx, "/mail/analytics", &resp); err != nil {
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetAnalyticsComparison retrieves analytics for two periods, such as this
+// week and last week, together with the change in each metric. An optional
+// scope selects the project and environment.
+func (c *Client) GetAnalyticsComparison(ctx context.Context, current, previous AnalyticsDateRange, scope ...AnalyticsScope) (*AnalyticsComparisonResponse, error) {
+	params := c.analyticsQuery(scope)
+	params.Set("currentStart", client.FormatTime(current.Start))
+	params.Set("currentEnd", client.FormatTime(current.End))
+	params.Set("previousStart", client.FormatTime(previous.Start))
+	params.Set("previousEnd", client.FormatTime(previous.End))
+
+	var resp AnalyticsComparisonResponse
+	if err := c.http.Get(ctx, "/mail/analytics/compare?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// analyticsQuery returns the initial query parameters of an analytics request
+// that takes an optional AnalyticsScope.
+func (c *Client) analyticsQuery(scope []AnalyticsScope) url.Values {
+	params := c.http.EnvironmentQuery()
+	for _, s := range scope {
+		if s.ProjectSlug != nil {
+			params.Set("projectSlug", *s.ProjectSlug)
+		}
+		if s.Environment != nil {
+			params.Set("environment", string(*s.Environment))
+		}
+	}
+	return params
+}
+
+// GetDeliverabilityReport retrieves delivery, bounce and spam-folder rates
+// per mailbox provider, along with reputation signals for each sending domain.
+func (c *Client) GetDeliverabilityReport(ctx context.Context, req *DeliverabilityReportRequest) (*DeliverabilityReportResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.ProjectSlug != nil {
+			params.Set("projectSlug", *req.ProjectSlug)
+		}
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.Domain != nil {
+			params.Set("domain", *req.Domain)
+		}
+		client.SetTime(params, "startDate", req.StartDate)
+		client.SetTime(params, "endDate", req.EndDate)
+	}
+
+	path := "/mail/deliverability"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp DeliverabilityReportResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreatePlacementTest sends a message to seed mailboxes across providers to
+// check where it lands before a campaign goes out.
+func (c *Client) CreatePlacementTest(ctx context.Context, req *CreatePlacementTestRequest) (*PlacementTest, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp PlacementTest
+	if err := c.http.Post(ctx, "/mail/placement-tests", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPlacementTest retrieves a placement test and its per-mailbox results.
+func (c *Client) GetPlacementTest(ctx context.Context, id string) (*PlacementTest, error) {
+	var resp PlacementTest
+	if err := c.http.Get(ctx, "/mail/placement-tests/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CheckSpamScore scores a message with SpamAssassin-style rules without
+// sending it, so content can be fixed before a campaign goes out. Either html
+// or text may be empty; fromDomain is the domain the message will be sent
+// from and is used for authentication checks.
+func (c *Client) CheckSpamScore(ctx context.Context, html, text, subject, fromDomain string) (*SpamScoreResponse, error) {
+	body := map[string]interface{}{
+		"subject":    subject,
+		"fromDomain": fromDomain,
+	}
+	if html != "" {
+		body["html"] = html
+	}
+	if text != "" {
+		body["text"] = text
+	}
+
+	var resp SpamScoreResponse
+	if err := c.http.Post(ctx, "/mail/spam-check", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetSnapshot retrieves email totals for [start, end) broken down by status,
+// tag, template, domain and campaign in a single response. It is intended for
+// periodic export into a data warehouse. An optional scope selects the
+// project and environment.
+func (c *Client) GetSnapshot(ctx context.Context, start, end time.Time, scope ...AnalyticsScope) (*MetricsSnapshot, error) {
+	params := c.analyticsQuery(scope)
+	params.Set("start", client.FormatTime(start))
+	params.Set("end", client.FormatTime(end))
+
+	var resp MetricsSnapshot
+	if err := c.http.Get(ctx, "/mail/analytics/snapshot?"+params.Encode(), &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -178,9 +429,30 @@ func (c *Client) GetHourlyAnalytics(ctx context.Context) (*HourlyAnalyticsRespon
 	return &resp, nil
 }
 
+// GetUsage retrieves the plan's sending limits and how much of the current
+// billing period's quota has been used. Sends beyond the remaining quota are
+// rejected, and SendBroadcast reports LimitedByQuota when it truncates a
+// broadcast. An optional scope selects the project and environment.
+func (c *Client) GetUsage(ctx context.Context, scope ...AnalyticsScope) (*MailUsage, error) {
+	path := "/mail/usage"
+	if params := c.analyticsQuery(scope); len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp MailUsage
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // ListSenders lists unique senders with statistics.
 func (c *Client) ListSenders(ctx context.Context, req *ListSendersRequest) (*ListSendersResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.ProjectSlug != nil {
 			params.Set("projectSlug", *req.ProjectSlug)
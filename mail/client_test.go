@@ -58,6 +58,93 @@ func TestClient_Send(t *testing.T) {
 		assert.Equal(t, "pending", resp.Status)
 	})
 
+	t.Run("reply to a previous email", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, "email-122", body["replyToEmailId"])
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id":"email-123","messageId":"<email-123@mail.stack0.dev>","status":"pending"}`))
+		})
+		defer server.Close()
+
+		resp, err := mailClient.Send(context.Background(), &SendEmailRequest{
+			From:           "support@example.com",
+			To:             "customer@example.com",
+			Subject:        "Re: Order #42",
+			Text:           ptr("Your order has shipped."),
+			ReplyToEmailID: ptr("email-122"),
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, resp.MessageID)
+		assert.Equal(t, "<email-123@mail.stack0.dev>", *resp.MessageID)
+	})
+
+	t.Run("attachments by CDN reference", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+
+			attachments := body["attachments"].([]interface{})
+			require.Len(t, attachments, 2)
+			asset := attachments[0].(map[string]interface{})
+			assert.Equal(t, "asset-123", asset["assetId"])
+			assert.NotContains(t, asset, "content")
+			private := attachments[1].(map[string]interface{})
+			assert.Equal(t, "file-456", private["privateFileId"])
+			assert.Equal(t, "contract.pdf", private["filename"])
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SendEmailResponse{ID: "email-123"})
+		})
+		defer server.Close()
+
+		_, err := mailClient.Send(context.Background(), &SendEmailRequest{
+			From:    "sender@example.com",
+			To:      "recipient@example.com",
+			Subject: "Hello",
+			Attachments: []Attachment{
+				{AssetID: "asset-123"},
+				{PrivateFileID: "file-456", Filename: "contract.pdf"},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("inline QR codes", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var req SendEmailRequest
+			err := json.NewDecoder(r.Body).Decode(&req)
+			require.NoError(t, err)
+			require.Len(t, req.QRCodes, 1)
+			assert.Equal(t, "ticket", req.QRCodes[0].CID)
+			assert.Equal(t, "https://example.com/tickets/{{ticketCode}}", req.QRCodes[0].Data)
+			assert.Equal(t, 300, *req.QRCodes[0].Size)
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SendEmailResponse{ID: "email-123"})
+		})
+		defer server.Close()
+
+		_, err := mailClient.Send(context.Background(), &SendEmailRequest{
+			From:              "sender@example.com",
+			To:                "recipient@example.com",
+			Subject:           "Your ticket",
+			HTML:              ptr(`<img src="cid:ticket" alt="Ticket">`),
+			TemplateVariables: map[string]interface{}{"ticketCode": "T-1001"},
+			QRCodes: []InlineQRCode{
+				{CID: "ticket", Data: "https://example.com/tickets/{{ticketCode}}", Size: ptr(300)},
+			},
+		})
+
+		require.NoError(t, err)
+	})
+
 	t.Run("error response", func(t *testing.T) {
 		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusBadRequest)
@@ -83,6 +170,112 @@ func TestClient_Send(t *testing.T) {
 	})
 }
 
+func TestClient_Send_MissingVariables(t *testing.T) {
+	t.Run("reports missing variables", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "empty", body["missingVariables"])
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SendEmailResponse{
+				ID:               "email-123",
+				Status:           "pending",
+				MissingVariables: []string{"firstName"},
+			})
+		})
+		defer server.Close()
+
+		mode := MissingVariableEmpty
+		resp, err := mailClient.Send(context.Background(), &SendEmailRequest{
+			From:              "sender@example.com",
+			To:                "recipient@example.com",
+			Subject:           "Hello",
+			TemplateID:        ptr("tpl-123"),
+			TemplateVariables: map[string]interface{}{"lastName": "Doe"},
+			MissingVariables:  &mode,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"firstName"}, resp.MissingVariables)
+	})
+
+	t.Run("error mode", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(types.ErrorResponse{
+				Message: "Missing template variables: firstName",
+				Code:    ErrCodeMissingVariables,
+			})
+		})
+		defer server.Close()
+
+		mode := MissingVariableError
+		_, err := mailClient.Send(context.Background(), &SendEmailRequest{
+			From:             "sender@example.com",
+			To:               "recipient@example.com",
+			Subject:          "Hello",
+			TemplateID:       ptr("tpl-123"),
+			MissingVariables: &mode,
+		})
+
+		apiErr, ok := err.(*types.APIError)
+		require.True(t, ok)
+		assert.Equal(t, ErrCodeMissingVariables, apiErr.Code)
+	})
+}
+
+func TestClient_GetMany(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/get-many", r.URL.Path)
+
+		var body map[string][]string
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, []string{"email-1", "email-2", "email-3"}, body["ids"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(GetManyEmailsResponse{
+			Emails: []GetEmailResponse{
+				{ID: "email-1", Status: "delivered"},
+				{ID: "email-3", Status: "opened"},
+			},
+			NotFound: []string{"email-2"},
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.GetMany(context.Background(), []string{"email-1", "email-2", "email-3"})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Emails, 2)
+	assert.Equal(t, "email-3", resp.Emails[1].ID)
+	assert.Equal(t, []string{"email-2"}, resp.NotFound)
+}
+
+func TestClient_Send_TemplateSlugAlias(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req SendEmailRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "welcome", *req.TemplateSlug)
+		assert.Nil(t, req.TemplateID)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"email-1","status":"queued","deprecatedTemplateSlug":"welcome"}`))
+	})
+	defer server.Close()
+
+	resp, err := mailClient.Send(context.Background(), &SendEmailRequest{
+		From:         "sender@example.com",
+		To:           "recipient@example.com",
+		TemplateSlug: ptr("welcome"),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.DeprecatedTemplateSlug)
+	assert.Equal(t, "welcome", *resp.DeprecatedTemplateSlug)
+}
+
 func TestClient_SendBatch(t *testing.T) {
 	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
@@ -140,6 +333,52 @@ func TestClient_SendBroadcast(t *testing.T) {
 	assert.Equal(t, 100, resp.Count)
 }
 
+func TestClient_SendBroadcast_Simulate(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		simulate := body["simulate"].(map[string]interface{})
+		assert.Equal(t, 0.4, simulate["openRate"])
+		assert.Equal(t, float64(60), simulate["spreadMinutes"])
+		assert.NotContains(t, simulate, "bounceRate")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SendBroadcastEmailResponse{Success: true, Count: 2})
+	})
+	defer server.Close()
+
+	openRate := 0.4
+	spread := 60
+	resp, err := mailClient.SendBroadcast(context.Background(), &SendBroadcastEmailRequest{
+		From:     "sender@example.com",
+		To:       []interface{}{"user1@example.com", "user2@example.com"},
+		Subject:  "Load test",
+		Simulate: &SimulateOptions{OpenRate: &openRate, SpreadMinutes: &spread},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Count)
+}
+
+func TestClient_SendBatch_SimulateDefaults(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{}, body["simulate"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SendBatchEmailResponse{Success: true})
+	})
+	defer server.Close()
+
+	_, err := mailClient.SendBatch(context.Background(), &SendBatchEmailRequest{
+		Emails:   []SendEmailRequest{{From: "sender@example.com", To: "user1@example.com", Subject: "Hi"}},
+		Simulate: &SimulateOptions{},
+	})
+
+	require.NoError(t, err)
+}
+
 func TestClient_Get(t *testing.T) {
 	emailID := "email-123"
 	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -211,6 +450,82 @@ func TestClient_List(t *testing.T) {
 		require.NoError(t, err)
 		assert.Len(t, resp.Emails, 1)
 	})
+
+	t.Run("with dates", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			// Offsets are normalized to UTC so no "+" reaches the query string.
+			assert.Contains(t, r.URL.RawQuery, "startDate=2024-01-31T23%3A00%3A00Z")
+			assert.Equal(t, "2024-02-01T12:00:00Z", r.URL.Query().Get("endDate"))
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ListEmailsResponse{})
+		})
+		defer server.Close()
+
+		cet := time.FixedZone("CET", 3600)
+		start := time.Date(2024, 2, 1, 0, 0, 0, 0, cet)
+		end := time.Date(2024, 2, 1, 13, 0, 0, 0, cet)
+		_, err := mailClient.List(context.Background(), &ListEmailsRequest{StartDate: &start, EndDate: &end})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_Search(t *testing.T) {
+	t.Run("query only", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/mail/search", r.URL.Path)
+			assert.Equal(t, "invoice overdue", r.URL.Query().Get("q"))
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SearchEmailsResponse{
+				Results: []EmailSearchResult{
+					{Email: Email{ID: "email-1"}, Score: 0.92},
+					{Email: Email{ID: "email-2"}, Score: 0.41},
+				},
+				Total: 2,
+			})
+		})
+		defer server.Close()
+
+		resp, err := mailClient.Search(context.Background(), "invoice overdue", nil)
+
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "email-1", resp.Results[0].ID)
+		assert.Equal(t, 0.92, resp.Results[0].Score)
+	})
+
+	t.Run("with filters", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			assert.Equal(t, "welcome", query.Get("q"))
+			assert.Equal(t, "subject,recipient", query.Get("fields"))
+			assert.Equal(t, "delivered", query.Get("status"))
+			assert.Equal(t, "cursor-abc", query.Get("cursor"))
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SearchEmailsResponse{
+				Results:    []EmailSearchResult{{Email: Email{ID: "email-3"}}},
+				Total:      30,
+				NextCursor: ptr("cursor-def"),
+			})
+		})
+		defer server.Close()
+
+		status := EmailStatusDelivered
+		resp, err := mailClient.Search(context.Background(), "welcome", &SearchEmailsFilters{
+			Fields: []SearchField{SearchFieldSubject, SearchFieldRecipient},
+			Status: &status,
+			Cursor: ptr("cursor-abc"),
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, resp.Results, 1)
+		require.NotNil(t, resp.NextCursor)
+		assert.Equal(t, "cursor-def", *resp.NextCursor)
+	})
 }
 
 func TestClient_Resend(t *testing.T) {
@@ -283,6 +598,293 @@ func TestClient_GetAnalytics(t *testing.T) {
 	assert.Equal(t, 0.95, resp.DeliveryRate)
 }
 
+func TestClient_GetAnalytics_WithFilters(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/analytics", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "password-reset", query.Get("tag"))
+		assert.Equal(t, "example.com", query.Get("domain"))
+		assert.Equal(t, "camp-1", query.Get("campaignId"))
+		assert.Equal(t, "2024-01-01T00:00:00Z", query.Get("startDate"))
+		assert.Equal(t, "2024-01-31T00:00:00Z", query.Get("endDate"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(EmailAnalyticsResponse{Total: 42, OpenRate: 0.5})
+	})
+	defer server.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	resp, err := mailClient.GetAnalyticsFiltered(context.Background(), &EmailAnalyticsRequest{
+		Tag:        ptr("password-reset"),
+		Domain:     ptr("example.com"),
+		CampaignID: ptr("camp-1"),
+		StartDate:  &start,
+		EndDate:    &end,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, resp.Total)
+}
+
+func TestClient_GetAnalyticsComparison(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/analytics/compare", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "2024-01-08T00:00:00Z", query.Get("currentStart"))
+		assert.Equal(t, "2024-01-14T23:59:59Z", query.Get("currentEnd"))
+		assert.Equal(t, "2024-01-01T00:00:00Z", query.Get("previousStart"))
+		assert.Equal(t, "2024-01-07T23:59:59Z", query.Get("previousEnd"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(AnalyticsComparisonResponse{
+			Current:  EmailAnalyticsResponse{Sent: 1200, OpenRate: 0.30},
+			Previous: EmailAnalyticsResponse{Sent: 1000, OpenRate: 0.25},
+			Sent:     AnalyticsDelta{Current: 1200, Previous: 1000, Change: 200, PercentChange: ptr(20.0)},
+			OpenRate: AnalyticsDelta{Current: 0.30, Previous: 0.25, Change: 0.05, PercentChange: ptr(20.0)},
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.GetAnalyticsComparison(context.Background(),
+		AnalyticsDateRange{
+			Start: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 14, 23, 59, 59, 0, time.UTC),
+		},
+		AnalyticsDateRange{
+			Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 7, 23, 59, 59, 0, time.UTC),
+		},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1200, resp.Current.Sent)
+	assert.Equal(t, float64(200), resp.Sent.Change)
+	require.NotNil(t, resp.OpenRate.PercentChange)
+	assert.Equal(t, 20.0, *resp.OpenRate.PercentChange)
+}
+
+func TestClient_GetDeliverabilityReport(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/deliverability", r.URL.Path)
+		assert.Equal(t, "mail.example.com", r.URL.Query().Get("domain"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DeliverabilityReportResponse{
+			Providers: []ProviderDeliverability{
+				{Provider: MailboxProviderGmail, Sent: 1000, Delivered: 980, SpamFolder: 20, DeliveryRate: 0.98, SpamRate: 0.02},
+				{Provider: MailboxProviderOutlook, Sent: 500, Delivered: 450, Bounced: 50, BounceRate: 0.1},
+			},
+			Domains: []DomainReputation{
+				{Domain: "mail.example.com", Reputation: DomainReputationHigh, SPFAligned: true, DKIMAligned: true, DMARCAligned: true},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.GetDeliverabilityReport(context.Background(), &DeliverabilityReportRequest{
+		Domain: ptr("mail.example.com"),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Providers, 2)
+	assert.Equal(t, MailboxProviderGmail, resp.Providers[0].Provider)
+	assert.Equal(t, 0.02, resp.Providers[0].SpamRate)
+	require.Len(t, resp.Domains, 1)
+	assert.Equal(t, DomainReputationHigh, resp.Domains[0].Reputation)
+}
+
+func TestClient_CreatePlacementTest(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/placement-tests", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "tmpl-123", body["templateId"])
+		assert.NotContains(t, body, "html")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PlacementTest{ID: "pt-123", Status: PlacementTestStatusPending})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.CreatePlacementTest(context.Background(), &CreatePlacementTestRequest{
+		From:       "news@example.com",
+		Subject:    "Spring sale",
+		TemplateID: ptr("tmpl-123"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pt-123", resp.ID)
+	assert.Equal(t, PlacementTestStatusPending, resp.Status)
+}
+
+func TestClient_GetPlacementTest(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/placement-tests/pt-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PlacementTest{
+			ID:      "pt-123",
+			Status:  PlacementTestStatusCompleted,
+			Summary: PlacementSummary{Inbox: 1, Promotions: 1},
+			Results: []PlacementResult{
+				{Provider: MailboxProviderGmail, SeedAddress: "seed1@gmail.com", Placement: PlacementPromotions},
+				{Provider: MailboxProviderOutlook, SeedAddress: "seed1@outlook.com", Placement: PlacementInbox},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.GetPlacementTest(context.Background(), "pt-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, resp.Summary.Promotions)
+	require.Len(t, resp.Results, 2)
+	assert.Equal(t, PlacementPromotions, resp.Results[0].Placement)
+}
+
+func TestClient_GetAnalyticsComparison_Scoped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/analytics/compare", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "acme", query.Get("projectSlug"))
+		assert.Equal(t, "sandbox", query.Get("environment"))
+		assert.Equal(t, "2024-01-08T00:00:00Z", query.Get("currentStart"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(AnalyticsComparisonResponse{})
+	}))
+	defer server.Close()
+	mailClient := New(client.New("test-api-key", server.URL).WithEnvironment(types.EnvironmentSandbox))
+
+	_, err := mailClient.GetAnalyticsComparison(context.Background(),
+		AnalyticsDateRange{Start: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC)},
+		AnalyticsDateRange{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+		AnalyticsScope{ProjectSlug: ptr("acme")},
+	)
+
+	require.NoError(t, err)
+}
+
+func TestClient_GetUsage(t *testing.T) {
+	exhaustion := time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC)
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/usage", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(MailUsage{
+			Plan:                  "pro",
+			MonthlyLimit:          50000,
+			SentThisPeriod:        42000,
+			Remaining:             8000,
+			ProjectedExhaustionAt: &exhaustion,
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.GetUsage(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "pro", resp.Plan)
+	assert.Equal(t, 8000, resp.Remaining)
+	assert.Nil(t, resp.DailyLimit)
+	require.NotNil(t, resp.ProjectedExhaustionAt)
+	assert.True(t, exhaustion.Equal(*resp.ProjectedExhaustionAt))
+}
+
+func TestClient_GetUsage_Scoped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/usage", r.URL.Path)
+		assert.Equal(t, "acme", r.URL.Query().Get("projectSlug"))
+		assert.Equal(t, "sandbox", r.URL.Query().Get("environment"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(MailUsage{Plan: "pro"})
+	}))
+	defer server.Close()
+	mailClient := New(client.New("test-api-key", server.URL).WithEnvironment(types.EnvironmentSandbox))
+
+	resp, err := mailClient.GetUsage(context.Background(), AnalyticsScope{ProjectSlug: ptr("acme")})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pro", resp.Plan)
+}
+
+func TestClient_CheckSpamScore(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/spam-check", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "<p>FREE MONEY</p>", body["html"])
+		assert.NotContains(t, body, "text")
+		assert.Equal(t, "Act now", body["subject"])
+		assert.Equal(t, "example.com", body["fromDomain"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SpamScoreResponse{
+			Score:     6.2,
+			Threshold: 5,
+			IsSpam:    true,
+			Rules: []SpamRuleHit{
+				{Name: "HTML_ONLY", Description: "Message has no text part", Score: 1.1},
+				{Name: "MONEY_FREE", Description: "Mentions free money", Score: 5.1},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.CheckSpamScore(context.Background(), "<p>FREE MONEY</p>", "", "Act now", "example.com")
+
+	require.NoError(t, err)
+	assert.True(t, resp.IsSpam)
+	assert.Equal(t, 6.2, resp.Score)
+	require.Len(t, resp.Rules, 2)
+	assert.Equal(t, "MONEY_FREE", resp.Rules[1].Name)
+}
+
+func TestClient_GetSnapshot(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/analytics/snapshot", r.URL.Path)
+		assert.Equal(t, "2024-03-01T00:00:00Z", r.URL.Query().Get("start"))
+		assert.Equal(t, "2024-03-02T00:00:00Z", r.URL.Query().Get("end"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(MetricsSnapshot{
+			Totals:   SnapshotCounts{Total: 150, Delivered: 140, Bounced: 10},
+			ByStatus: map[EmailStatus]int{EmailStatusDelivered: 140, EmailStatusBounced: 10},
+			ByTag: []SnapshotBreakdown{
+				{Key: "receipts", SnapshotCounts: SnapshotCounts{Total: 100}},
+				{Key: "welcome", SnapshotCounts: SnapshotCounts{Total: 50}},
+			},
+			ByCampaign: []SnapshotBreakdown{
+				{Key: "camp-1", Name: ptr("March newsletter"), SnapshotCounts: SnapshotCounts{Total: 20}},
+			},
+		})
+	})
+	defer server.Close()
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	resp, err := mailClient.GetSnapshot(context.Background(), start, start.AddDate(0, 0, 1))
+
+	require.NoError(t, err)
+	assert.Equal(t, 150, resp.Totals.Total)
+	assert.Equal(t, 10, resp.ByStatus[EmailStatusBounced])
+	require.Len(t, resp.ByTag, 2)
+	assert.Equal(t, 100, resp.ByTag[0].Total)
+	assert.Equal(t, "March newsletter", *resp.ByCampaign[0].Name)
+}
+
 func TestClient_GetTimeSeriesAnalytics(t *testing.T) {
 	t.Run("without days parameter", func(t *testing.T) {
 		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -366,6 +968,7 @@ func TestNewClient(t *testing.T) {
 	assert.NotNil(t, mailClient.Domains)
 	assert.NotNil(t, mailClient.Templates)
 	assert.NotNil(t, mailClient.Audiences)
+	assert.NotNil(t, mailClient.Segments)
 	assert.NotNil(t, mailClient.Contacts)
 	assert.NotNil(t, mailClient.Campaigns)
 	assert.NotNil(t, mailClient.Sequences)
@@ -376,3 +979,70 @@ func TestNewClient(t *testing.T) {
 func ptr[T any](v T) *T {
 	return &v
 }
+
+func TestClient_List_WithMetadata(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail", r.URL.Path)
+		assert.Equal(t, "ord_123", r.URL.Query().Get("metadata[orderId]"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListEmailsResponse{})
+	})
+	defer server.Close()
+
+	_, err := mailClient.List(context.Background(), &ListEmailsRequest{
+		Metadata: map[string]string{"orderId": "ord_123"},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestClient_MetadataIndex(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/metadata-index", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPut:
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []interface{}{"orderId", "customerId"}, body["keys"])
+		case http.MethodGet:
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(types.MetadataIndex{Keys: []string{"orderId", "customerId"}, MaxKeys: 10})
+	})
+	defer server.Close()
+
+	index, err := mailClient.SetMetadataIndex(context.Background(), []string{"orderId", "customerId"})
+	require.NoError(t, err)
+	assert.Equal(t, 10, index.MaxKeys)
+
+	index, err = mailClient.GetMetadataIndex(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orderId", "customerId"}, index.Keys)
+}
+
+func TestClient_GetSnapshot_Scoped(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/analytics/snapshot", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, "acme", query.Get("projectSlug"))
+		assert.Equal(t, "production", query.Get("environment"))
+		assert.Equal(t, "2024-03-01T00:00:00Z", query.Get("start"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(MetricsSnapshot{})
+	}))
+	defer server.Close()
+	mailClient := New(client.New("test-api-key", server.URL).WithEnvironment(types.EnvironmentSandbox))
+
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	production := types.EnvironmentProduction
+	_, err := mailClient.GetSnapshot(context.Background(), start, start.AddDate(0, 0, 1),
+		AnalyticsScope{ProjectSlug: ptr("acme"), Environment: &production})
+
+	require.NoError(t, err)
+}
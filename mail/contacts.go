@@ -2,7 +2,6 @@ package mail
 
 import (
 	"context"
-	"net/url"
 	"strconv"
 
 	"github.com/stack0/sdk-go/client"
@@ -20,7 +19,11 @@ func NewContactsClient(http *client.HTTPClient) *ContactsClient {
 
 // List lists all contacts.
 func (c *ContactsClient) List(ctx context.Context, req *ListContactsRequest) (*ListContactsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -37,6 +40,9 @@ func (c *ContactsClient) List(ctx context.Context, req *ListContactsRequest) (*L
 		if req.Status != nil {
 			params.Set("status", string(*req.Status))
 		}
+		if len(req.Expand) > 0 {
+			params.Set("expand", client.JoinExpand(req.Expand))
+		}
 	}
 
 	path := "/mail/contacts"
@@ -51,10 +57,37 @@ func (c *ContactsClient) List(ctx context.Context, req *ListContactsRequest) (*L
 	return &resp, nil
 }
 
-// Get retrieves a contact by ID.
-func (c *ContactsClient) Get(ctx context.Context, id string) (*MailContact, error) {
+// Get retrieves a contact by ID. Pass ContactExpandEngagement to include
+// engagement totals.
+func (c *ContactsClient) Get(ctx context.Context, id string, expand ...ContactExpand) (*MailContact, error) {
 	var resp MailContact
-	if err := c.http.Get(ctx, "/mail/contacts/"+id, &resp); err != nil {
+	if err := c.http.Get(ctx, client.WithExpand("/mail/contacts/"+id, expand), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetMany retrieves multiple contacts by ID in one request. Contacts are
+// returned in the order of ids.
+func (c *ContactsClient) GetMany(ctx context.Context, ids []string, expand ...ContactExpand) (*GetManyContactsResponse, error) {
+	body := map[string]interface{}{"ids": ids}
+	if len(expand) > 0 {
+		body["expand"] = expand
+	}
+	var resp GetManyContactsResponse
+	if err := c.http.Post(ctx, "/mail/contacts/get-many", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// UpsertMany creates or updates contacts by email in one request. Rows are
+// processed independently, so a failed row does not affect the others;
+// check each result's Action.
+func (c *ContactsClient) UpsertMany(ctx context.Context, contacts []UpsertContactInput) (*UpsertContactsResponse, error) {
+	var resp UpsertContactsResponse
+	body := map[string]interface{}{"contacts": contacts}
+	if err := c.http.Post(ctx, "/mail/contacts/upsert-many", body, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -62,6 +95,10 @@ func (c *ContactsClient) Get(ctx context.Context, id string) (*MailContact, erro
 
 // Create creates a new contact.
 func (c *ContactsClient) Create(ctx context.Context, req *CreateContactRequest) (*MailContact, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp MailContact
 	if err := c.http.Post(ctx, "/mail/contacts", req, &resp); err != nil {
 		return nil, err
@@ -71,6 +108,10 @@ func (c *ContactsClient) Create(ctx context.Context, req *CreateContactRequest)
 
 // Update updates a contact.
 func (c *ContactsClient) Update(ctx context.Context, req *UpdateContactRequest) (*MailContact, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp MailContact
 	if err := c.http.Put(ctx, "/mail/contacts/"+req.ID, req, &resp); err != nil {
 		return nil, err
@@ -87,8 +128,46 @@ func (c *ContactsClient) Delete(ctx context.Context, id string) (*DeleteContactR
 	return &resp, nil
 }
 
+// ExportPersonalData returns all personal data stored for a contact,
+// looked up by ID or email.
+func (c *ContactsClient) ExportPersonalData(ctx context.Context, req *ExportPersonalDataRequest) (*PersonalDataExport, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp PersonalDataExport
+	if err := c.http.Post(ctx, "/mail/contacts/personal-data/export", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Erase permanently deletes a contact together with its email bodies, event
+// occurrences and sequence history, and returns a receipt of the deletion.
+// This cannot be undone.
+func (c *ContactsClient) Erase(ctx context.Context, contactID string) (*ErasureReceipt, error) {
+	var resp ErasureReceipt
+	if err := c.http.Post(ctx, "/mail/contacts/"+contactID+"/erase", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetErasureReceipt retrieves the receipt of an earlier erasure.
+func (c *ContactsClient) GetErasureReceipt(ctx context.Context, receiptID string) (*ErasureReceipt, error) {
+	var resp ErasureReceipt
+	if err := c.http.Get(ctx, "/mail/contacts/erasures/"+receiptID, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Import imports contacts in bulk.
 func (c *ContactsClient) Import(ctx context.Context, req *ImportContactsRequest) (*ImportContactsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp ImportContactsResponse
 	if err := c.http.Post(ctx, "/mail/contacts/import", req, &resp); err != nil {
 		return nil, err
@@ -101,6 +101,194 @@ func TestContactsClient_Get(t *testing.T) {
 	assert.Equal(t, "Doe", *resp.LastName)
 }
 
+func TestContactsClient_List_ExpandEngagement(t *testing.T) {
+	lastOpened := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "engagement", r.URL.Query().Get("expand"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListContactsResponse{
+			Contacts: []MailContact{{
+				ID:           "contact-1",
+				Email:        "user1@example.com",
+				LastOpenedAt: &lastOpened,
+				TotalSent:    ptr(12),
+				TotalOpened:  ptr(7),
+				TotalClicked: ptr(2),
+			}},
+			Total: 1,
+		})
+	})
+	defer server.Close()
+
+	resp, err := contactsClient.List(context.Background(), &ListContactsRequest{
+		Expand: []ContactExpand{ContactExpandEngagement},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Contacts, 1)
+	contact := resp.Contacts[0]
+	assert.True(t, lastOpened.Equal(*contact.LastOpenedAt))
+	assert.Nil(t, contact.LastClickedAt)
+	assert.Equal(t, 12, *contact.TotalSent)
+	assert.Equal(t, 7, *contact.TotalOpened)
+	assert.Equal(t, 2, *contact.TotalClicked)
+}
+
+func TestContactsClient_Get_ExpandEngagement(t *testing.T) {
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/contacts/contact-123", r.URL.Path)
+		assert.Equal(t, "engagement", r.URL.Query().Get("expand"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(MailContact{ID: "contact-123", TotalSent: ptr(3)})
+	})
+	defer server.Close()
+
+	resp, err := contactsClient.Get(context.Background(), "contact-123", ContactExpandEngagement)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, *resp.TotalSent)
+}
+
+func TestContactsClient_GetMany(t *testing.T) {
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/contacts/get-many", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, []interface{}{"contact-1", "contact-2"}, body["ids"])
+		assert.Equal(t, []interface{}{"engagement"}, body["expand"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(GetManyContactsResponse{
+			Contacts: []MailContact{{ID: "contact-1"}, {ID: "contact-2"}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := contactsClient.GetMany(context.Background(), []string{"contact-1", "contact-2"}, ContactExpandEngagement)
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Contacts, 2)
+	assert.Empty(t, resp.NotFound)
+}
+
+func TestContactsClient_ExportPersonalData(t *testing.T) {
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/contacts/personal-data/export", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "ada@example.com", body["email"])
+		assert.NotContains(t, body, "contactId")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PersonalDataExport{
+			Contact:          MailContact{ID: "contact-1", Email: "ada@example.com"},
+			Emails:           []GetEmailResponse{{ID: "email-1", Subject: "Welcome"}},
+			EventOccurrences: []EventOccurrence{{ID: "occ-1", ContactID: "contact-1"}},
+			GeneratedAt:      time.Now(),
+		})
+	})
+	defer server.Close()
+
+	resp, err := contactsClient.ExportPersonalData(context.Background(), &ExportPersonalDataRequest{
+		Email: ptr("ada@example.com"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "contact-1", resp.Contact.ID)
+	assert.Len(t, resp.Emails, 1)
+	assert.Len(t, resp.EventOccurrences, 1)
+}
+
+func TestContactsClient_Erase(t *testing.T) {
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/contacts/contact-1/erase", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ErasureReceipt{
+			ID:        "erasure-1",
+			ContactID: "contact-1",
+			EmailHash: "3f1c...",
+			Deleted:   ErasureCounts{Emails: 12, EventOccurrences: 40, SequenceEntries: 2, AudienceMemberships: 3},
+			ErasedAt:  time.Now(),
+		})
+	})
+	defer server.Close()
+
+	resp, err := contactsClient.Erase(context.Background(), "contact-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "erasure-1", resp.ID)
+	assert.Equal(t, 12, resp.Deleted.Emails)
+	assert.Equal(t, 40, resp.Deleted.EventOccurrences)
+}
+
+func TestContactsClient_GetErasureReceipt(t *testing.T) {
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/contacts/erasures/erasure-1", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ErasureReceipt{ID: "erasure-1", ContactID: "contact-1"})
+	})
+	defer server.Close()
+
+	resp, err := contactsClient.GetErasureReceipt(context.Background(), "erasure-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "contact-1", resp.ContactID)
+}
+
+func TestContactsClient_UpsertMany(t *testing.T) {
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/contacts/upsert-many", r.URL.Path)
+
+		var body map[string][]map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Len(t, body["contacts"], 3)
+		assert.Equal(t, "new@example.com", body["contacts"][0]["email"])
+		assert.Equal(t, "Ada", body["contacts"][0]["firstName"])
+		assert.NotContains(t, body["contacts"][1], "firstName")
+
+		reason := "invalid email address"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(UpsertContactsResponse{
+			Results: []UpsertContactResult{
+				{Index: 0, Email: "new@example.com", Action: UpsertActionCreated, Contact: &MailContact{ID: "contact-1"}},
+				{Index: 1, Email: "existing@example.com", Action: UpsertActionUpdated, Contact: &MailContact{ID: "contact-2"}},
+				{Index: 2, Email: "not-an-email", Action: UpsertActionFailed, Error: &reason},
+			},
+			Created: 1,
+			Updated: 1,
+			Failed:  1,
+		})
+	})
+	defer server.Close()
+
+	resp, err := contactsClient.UpsertMany(context.Background(), []UpsertContactInput{
+		{Email: "new@example.com", FirstName: ptr("Ada")},
+		{Email: "existing@example.com", Metadata: map[string]interface{}{"plan": "pro"}},
+		{Email: "not-an-email"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	assert.Equal(t, UpsertActionCreated, resp.Results[0].Action)
+	assert.Equal(t, "contact-2", resp.Results[1].Contact.ID)
+	assert.Nil(t, resp.Results[2].Contact)
+	assert.Equal(t, "invalid email address", *resp.Results[2].Error)
+	assert.Equal(t, 1, resp.Failed)
+}
+
 func TestContactsClient_Create(t *testing.T) {
 	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, http.MethodPost, r.Method)
@@ -0,0 +1,178 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// dnsResolver is the subset of net.Resolver used by CheckDNS.
+type dnsResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// CheckDNS looks up the DNS records a domain needs (DKIM, SPF, DMARC, the
+// SES verification record and any return-path and tracking records) with the
+// local resolver and reports which are missing or don't have the expected
+// value. Use it to find configuration mistakes before calling Verify; the
+// result reflects the local resolver's view, which may lag behind or differ
+// from the server's.
+//
+// An SPF record matches if it includes every mechanism of the expected one,
+// so existing SPF records merged with ours are accepted. Any DMARC record
+// matches, since the policy is up to the domain owner.
+func (c *DomainsClient) CheckDNS(ctx context.Context, domainID string) (*DNSCheckResult, error) {
+	records, err := c.GetDNSRecords(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := c.resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	expected := append([]DNSRecord{}, records.DKIMRecords...)
+	expected = append(expected, records.ReturnPathRecords...)
+	for _, r := range []*DNSRecord{records.SPFRecord, records.DMARCRecord, records.SESVerificationRecord, records.TrackingRecord} {
+		if r != nil {
+			expected = append(expected, *r)
+		}
+	}
+
+	result := &DNSCheckResult{Domain: records.Domain, Ready: true}
+	for _, record := range expected {
+		check := checkDNSRecord(ctx, resolver, records.Domain, record)
+		if check.Status != DNSCheckStatusOK {
+			result.Ready = false
+		}
+		result.Records = append(result.Records, check)
+	}
+	return result, nil
+}
+
+// Problems returns the checks of records that are missing, mismatched or
+// could not be looked up.
+func (r *DNSCheckResult) Problems() []DNSRecordCheck {
+	var problems []DNSRecordCheck
+	for _, check := range r.Records {
+		if check.Status != DNSCheckStatusOK {
+			problems = append(problems, check)
+		}
+	}
+	return problems
+}
+
+func checkDNSRecord(ctx context.Context, resolver dnsResolver, domain string, record DNSRecord) DNSRecordCheck {
+	check := DNSRecordCheck{Record: record}
+	name := recordFQDN(record.Name, domain)
+
+	var err error
+	switch strings.ToUpper(record.Type) {
+	case "CNAME":
+		var target string
+		target, err = resolver.LookupCNAME(ctx, name)
+		// LookupCNAME returns name itself when it has no CNAME record.
+		if err == nil && !strings.EqualFold(normalizeHost(target), normalizeHost(name)) {
+			check.Found = []string{normalizeHost(target)}
+		}
+	case "MX":
+		var mxs []*net.MX
+		mxs, err = resolver.LookupMX(ctx, name)
+		for _, mx := range mxs {
+			check.Found = append(check.Found, strconv.Itoa(int(mx.Pref))+" "+normalizeHost(mx.Host))
+		}
+	default:
+		check.Found, err = resolver.LookupTXT(ctx, name)
+	}
+
+	var dnsErr *net.DNSError
+	switch {
+	case err != nil && !(errors.As(err, &dnsErr) && dnsErr.IsNotFound):
+		check.Status = DNSCheckStatusError
+		check.Error = err.Error()
+	case len(check.Found) == 0:
+		check.Status = DNSCheckStatusMissing
+	case dnsRecordMatches(record, check.Found):
+		check.Status = DNSCheckStatusOK
+	default:
+		check.Status = DNSCheckStatusMismatch
+	}
+	return check
+}
+
+// dnsRecordMatches reports whether any of the found values satisfies record.
+func dnsRecordMatches(record DNSRecord, found []string) bool {
+	want := strings.TrimSpace(record.Value)
+	for _, value := range found {
+		value = strings.TrimSpace(value)
+		switch strings.ToUpper(record.Type) {
+		case "CNAME":
+			if value == normalizeHost(want) {
+				return true
+			}
+		case "MX":
+			pref, host, _ := strings.Cut(value, " ")
+			if host == normalizeHost(want) && (record.Priority == nil || pref == strconv.Itoa(*record.Priority)) {
+				return true
+			}
+		default:
+			if value == want || spfIncludes(value, want) || isDMARC(want) && isDMARC(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// spfIncludes reports whether SPF record have contains every mechanism of
+// want other than the final "all".
+func spfIncludes(have, want string) bool {
+	if !isSPF(have) || !isSPF(want) {
+		return false
+	}
+	mechanisms := make(map[string]bool)
+	for _, m := range strings.Fields(have) {
+		mechanisms[strings.ToLower(m)] = true
+	}
+	for _, m := range strings.Fields(want)[1:] {
+		if strings.HasSuffix(m, "all") {
+			continue
+		}
+		if !mechanisms[strings.ToLower(m)] {
+			return false
+		}
+	}
+	return true
+}
+
+func isSPF(value string) bool {
+	return strings.HasPrefix(strings.ToLower(value), "v=spf1")
+}
+
+func isDMARC(value string) bool {
+	return strings.HasPrefix(strings.ToUpper(value), "V=DMARC1")
+}
+
+// recordFQDN returns the fully qualified name of a record name given either
+// relative to domain, as "@", or already qualified.
+func recordFQDN(name, domain string) string {
+	name = normalizeHost(name)
+	domain = normalizeHost(domain)
+	switch {
+	case name == "" || name == "@":
+		return domain
+	case name == domain || strings.HasSuffix(name, "."+domain):
+		return name
+	default:
+		return name + "." + domain
+	}
+}
+
+func normalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(host), "."))
+}
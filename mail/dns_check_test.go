@@ -0,0 +1,166 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResolver struct {
+	txt   map[string][]string
+	cname map[string]string
+	mx    map[string][]*net.MX
+	err   error
+}
+
+func notFound(name string) error {
+	return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if v, ok := r.txt[name]; ok {
+		return v, nil
+	}
+	return nil, notFound(name)
+}
+
+func (r *fakeResolver) LookupCNAME(ctx context.Context, host string) (string, error) {
+	if v, ok := r.cname[host]; ok {
+		return v, nil
+	}
+	return "", notFound(host)
+}
+
+func (r *fakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if v, ok := r.mx[name]; ok {
+		return v, nil
+	}
+	return nil, notFound(name)
+}
+
+func setupDNSCheck(t *testing.T, resolver dnsResolver) (*DomainsClient, func()) {
+	domainsClient, server := setupDomainsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/domains/domain-123/dns", r.URL.Path)
+		json.NewEncoder(w).Encode(GetDNSRecordsResponse{
+			Domain: "example.com",
+			DKIMRecords: []DNSRecord{
+				{Type: "CNAME", Name: "abc._domainkey", Value: "abc.dkim.amazonses.com"},
+				{Type: "CNAME", Name: "def._domainkey.example.com", Value: "def.dkim.amazonses.com"},
+			},
+			SPFRecord:   &DNSRecord{Type: "TXT", Name: "@", Value: "v=spf1 include:amazonses.com ~all"},
+			DMARCRecord: &DNSRecord{Type: "TXT", Name: "_dmarc", Value: "v=DMARC1; p=none"},
+		})
+	})
+	domainsClient.resolver = resolver
+	return domainsClient, server.Close
+}
+
+func TestDomainsClient_CheckDNS_Ready(t *testing.T) {
+	domainsClient, done := setupDNSCheck(t, &fakeResolver{
+		cname: map[string]string{
+			"abc._domainkey.example.com": "abc.dkim.amazonses.com.",
+			"def._domainkey.example.com": "DEF.dkim.amazonses.com.",
+		},
+		txt: map[string][]string{
+			// An existing SPF record merged with ours still matches.
+			"example.com":        {"google-site-verification=xyz", "v=spf1 include:_spf.google.com include:amazonses.com -all"},
+			"_dmarc.example.com": {"v=DMARC1; p=quarantine; rua=mailto:dmarc@example.com"},
+		},
+	})
+	defer done()
+
+	result, err := domainsClient.CheckDNS(context.Background(), "domain-123")
+
+	require.NoError(t, err)
+	assert.True(t, result.Ready)
+	assert.Equal(t, "example.com", result.Domain)
+	assert.Len(t, result.Records, 4)
+	assert.Empty(t, result.Problems())
+}
+
+func TestDomainsClient_CheckDNS_Problems(t *testing.T) {
+	domainsClient, done := setupDNSCheck(t, &fakeResolver{
+		cname: map[string]string{
+			"abc._domainkey.example.com": "abc.dkim.other.com.",
+		},
+		txt: map[string][]string{
+			"example.com": {"v=spf1 include:_spf.google.com ~all"},
+		},
+	})
+	defer done()
+
+	result, err := domainsClient.CheckDNS(context.Background(), "domain-123")
+
+	require.NoError(t, err)
+	assert.False(t, result.Ready)
+	problems := result.Problems()
+	require.Len(t, problems, 4)
+	assert.Equal(t, DNSCheckStatusMismatch, problems[0].Status)
+	assert.Equal(t, []string{"abc.dkim.other.com"}, problems[0].Found)
+	assert.Equal(t, DNSCheckStatusMissing, problems[1].Status)
+	assert.Equal(t, "def._domainkey.example.com", problems[1].Record.Name)
+	assert.Equal(t, DNSCheckStatusMismatch, problems[2].Status)
+	assert.Equal(t, DNSCheckStatusMissing, problems[3].Status)
+}
+
+func TestDomainsClient_CheckDNS_LookupError(t *testing.T) {
+	domainsClient, done := setupDNSCheck(t, &fakeResolver{err: errors.New("server misbehaving")})
+	defer done()
+
+	result, err := domainsClient.CheckDNS(context.Background(), "domain-123")
+
+	require.NoError(t, err)
+	assert.False(t, result.Ready)
+	assert.Equal(t, DNSCheckStatusError, result.Records[2].Status)
+	assert.Equal(t, "server misbehaving", result.Records[2].Error)
+}
+
+func TestDomainsClient_CheckDNS_ReturnPathAndTracking(t *testing.T) {
+	domainsClient, server := setupDomainsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(GetDNSRecordsResponse{
+			Domain: "example.com",
+			ReturnPathRecords: []DNSRecord{
+				{Type: "MX", Name: "bounce", Value: "feedback-smtp.us-east-1.amazonses.com", Priority: ptr(10)},
+				{Type: "TXT", Name: "bounce", Value: "v=spf1 include:amazonses.com ~all"},
+			},
+			TrackingRecord: &DNSRecord{Type: "CNAME", Name: "links", Value: "track.stack0.dev"},
+		})
+	})
+	defer server.Close()
+	domainsClient.resolver = &fakeResolver{
+		mx: map[string][]*net.MX{
+			"bounce.example.com": {{Host: "feedback-smtp.us-east-1.amazonses.com.", Pref: 20}},
+		},
+		txt: map[string][]string{
+			"bounce.example.com": {"v=spf1 include:amazonses.com ~all"},
+		},
+		cname: map[string]string{
+			"links.example.com": "track.stack0.dev.",
+		},
+	}
+
+	result, err := domainsClient.CheckDNS(context.Background(), "domain-123")
+
+	require.NoError(t, err)
+	require.Len(t, result.Records, 3)
+	problems := result.Problems()
+	require.Len(t, problems, 1)
+	assert.Equal(t, "MX", problems[0].Record.Type)
+	assert.Equal(t, DNSCheckStatusMismatch, problems[0].Status)
+	assert.Equal(t, []string{"20 feedback-smtp.us-east-1.amazonses.com"}, problems[0].Found)
+}
+
+func TestRecordFQDN(t *testing.T) {
+	assert.Equal(t, "example.com", recordFQDN("@", "example.com"))
+	assert.Equal(t, "_dmarc.example.com", recordFQDN("_dmarc", "example.com"))
+	assert.Equal(t, "_dmarc.example.com", recordFQDN("_dmarc.example.com.", "example.com"))
+}
@@ -2,14 +2,16 @@ package mail
 
 import (
 	"context"
-	"net/url"
+	"errors"
+	"time"
 
 	"github.com/stack0/sdk-go/client"
 )
 
 // DomainsClient handles domain operations.
 type DomainsClient struct {
-	http *client.HTTPClient
+	http     *client.HTTPClient
+	resolver dnsResolver // nil uses net.DefaultResolver
 }
 
 // NewDomainsClient creates a new domains client.
@@ -19,7 +21,11 @@ func NewDomainsClient(http *client.HTTPClient) *DomainsClient {
 
 // List lists all domains.
 func (c *DomainsClient) List(ctx context.Context, req *ListDomainsRequest) ([]Domain, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	params.Set("projectSlug", req.ProjectSlug)
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
@@ -34,6 +40,10 @@ func (c *DomainsClient) List(ctx context.Context, req *ListDomainsRequest) ([]Do
 
 // Add adds a new domain.
 func (c *DomainsClient) Add(ctx context.Context, req *AddDomainRequest) (*AddDomainResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp AddDomainResponse
 	if err := c.http.Post(ctx, "/mail/domains", req, &resp); err != nil {
 		return nil, err
@@ -41,6 +51,19 @@ func (c *DomainsClient) Add(ctx context.Context, req *AddDomainRequest) (*AddDom
 	return &resp, nil
 }
 
+// Update updates a domain's return-path and tracking settings.
+func (c *DomainsClient) Update(ctx context.Context, req *UpdateDomainRequest) (*Domain, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Domain
+	if err := c.http.Put(ctx, "/mail/domains/"+req.ID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetDNSRecords retrieves DNS records for a domain.
 func (c *DomainsClient) GetDNSRecords(ctx context.Context, domainID string) (*GetDNSRecordsResponse, error) {
 	var resp GetDNSRecordsResponse
@@ -59,6 +82,69 @@ func (c *DomainsClient) Verify(ctx context.Context, domainID string) (*VerifyDom
 	return &resp, nil
 }
 
+// VerifyAndWaitOptions are options for VerifyAndWait. The wait between checks
+// starts at PollInterval and doubles up to MaxInterval. Rate-limit and server
+// errors while polling are retried up to MaxTransientErrors times in a row;
+// see client.PollOptions.
+type VerifyAndWaitOptions struct {
+	PollInterval       time.Duration
+	MaxInterval        time.Duration
+	Timeout            time.Duration
+	MaxTransientErrors int
+}
+
+// VerifyAndWait triggers verification of a domain and polls until both the
+// domain and its DKIM records are verified. DNS changes can take a while to
+// propagate, so by default it checks after 15 seconds, backing off to every
+// 2 minutes, for up to 10 minutes. It returns an error if verification fails.
+func (c *DomainsClient) VerifyAndWait(ctx context.Context, domainID string, opts *VerifyAndWaitOptions) (*GetDNSRecordsResponse, error) {
+	pollInterval := 15 * time.Second
+	maxInterval := 2 * time.Minute
+	timeout := 10 * time.Minute
+	maxTransient := 0
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			pollInterval = opts.PollInterval
+		}
+		if opts.MaxInterval > 0 {
+			maxInterval = opts.MaxInterval
+		}
+		if opts.Timeout > 0 {
+			timeout = opts.Timeout
+		}
+		maxTransient = opts.MaxTransientErrors
+	}
+
+	return client.Poll(ctx, client.PollOptions{
+		Interval:           pollInterval,
+		MaxInterval:        maxInterval,
+		Timeout:            timeout,
+		MaxTransientErrors: maxTransient,
+		TimeoutMessage:     "Domain verification timed out",
+	}, func(ctx context.Context) (*GetDNSRecordsResponse, bool, error) {
+		if _, err := c.Verify(ctx, domainID); err != nil {
+			return nil, false, err
+		}
+		records, err := c.GetDNSRecords(ctx, domainID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if records.Status == DomainStatusFailed {
+			errMsg := "Domain verification failed"
+			if d := records.VerificationDetails; d != nil && d.VerificationStatus != "" {
+				errMsg += ": " + d.VerificationStatus
+			}
+			return nil, false, errors.New(errMsg)
+		}
+		verified := records.Status == DomainStatusVerified
+		if d := records.VerificationDetails; d != nil {
+			verified = verified && d.DomainVerified && d.DKIMVerified
+		}
+		return records, verified, nil
+	})
+}
+
 // Delete deletes a domain.
 func (c *DomainsClient) Delete(ctx context.Context, domainID string) (*DeleteDomainResponse, error) {
 	var resp DeleteDomainResponse
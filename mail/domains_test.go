@@ -179,6 +179,44 @@ func TestDomainsClient_Verify_Failed(t *testing.T) {
 	assert.Contains(t, resp.Message, "DNS records not found")
 }
 
+func TestDomainsClient_Update(t *testing.T) {
+	domainID := "domain-123"
+	domainsClient, server := setupDomainsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/domains/"+domainID, r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "bounce", body["returnPathSubdomain"])
+		assert.Equal(t, "links", body["trackingSubdomain"])
+		assert.Equal(t, false, body["openTracking"])
+		assert.NotContains(t, body, "clickTracking")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Domain{
+			ID:               domainID,
+			Domain:           "example.com",
+			ReturnPathDomain: ptr("bounce.example.com"),
+			TrackingDomain:   ptr("links.example.com"),
+			ClickTracking:    true,
+		})
+	})
+	defer server.Close()
+
+	resp, err := domainsClient.Update(context.Background(), &UpdateDomainRequest{
+		ID:                  domainID,
+		ReturnPathSubdomain: ptr("bounce"),
+		TrackingSubdomain:   ptr("links"),
+		OpenTracking:        ptr(false),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "bounce.example.com", *resp.ReturnPathDomain)
+	assert.Equal(t, "links.example.com", *resp.TrackingDomain)
+	assert.False(t, resp.OpenTracking)
+	assert.True(t, resp.ClickTracking)
+}
+
 func TestDomainsClient_Delete(t *testing.T) {
 	domainID := "domain-123"
 	domainsClient, server := setupDomainsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -217,6 +255,106 @@ func TestDomainsClient_SetDefault(t *testing.T) {
 	assert.True(t, resp.IsDefault)
 }
 
+func TestDomainsClient_VerifyAndWait(t *testing.T) {
+	domainID := "domain-123"
+	checks := 0
+	domainsClient, server := setupDomainsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mail/domains/" + domainID + "/verify":
+			assert.Equal(t, http.MethodPost, r.Method)
+			json.NewEncoder(w).Encode(VerifyDomainResponse{Verified: checks > 0})
+		case "/mail/domains/" + domainID + "/dns":
+			checks++
+			if checks < 3 {
+				// Domain verified before DKIM is still pending.
+				w.Write([]byte(`{"domain":"example.com","status":"verified","verificationDetails":{"domainVerified":true,"dkimVerified":false}}`))
+				return
+			}
+			w.Write([]byte(`{"domain":"example.com","status":"verified","verificationDetails":{"domainVerified":true,"dkimVerified":true}}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	resp, err := domainsClient.VerifyAndWait(context.Background(), domainID, &VerifyAndWaitOptions{
+		PollInterval: time.Millisecond,
+		Timeout:      time.Second,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, checks)
+	assert.Equal(t, DomainStatusVerified, resp.Status)
+	assert.True(t, resp.VerificationDetails.DKIMVerified)
+}
+
+func TestDomainsClient_VerifyAndWait_Backoff(t *testing.T) {
+	var checks []time.Time
+	domainsClient, server := setupDomainsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			json.NewEncoder(w).Encode(VerifyDomainResponse{})
+			return
+		}
+		checks = append(checks, time.Now())
+		if len(checks) < 4 {
+			json.NewEncoder(w).Encode(GetDNSRecordsResponse{Status: DomainStatusPending})
+			return
+		}
+		json.NewEncoder(w).Encode(GetDNSRecordsResponse{Status: DomainStatusVerified})
+	})
+	defer server.Close()
+
+	_, err := domainsClient.VerifyAndWait(context.Background(), "domain-123", &VerifyAndWaitOptions{
+		PollInterval: 10 * time.Millisecond,
+		MaxInterval:  40 * time.Millisecond,
+		Timeout:      time.Second,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, checks, 4)
+	assert.GreaterOrEqual(t, checks[2].Sub(checks[1]), 20*time.Millisecond)
+	assert.GreaterOrEqual(t, checks[3].Sub(checks[2]), 40*time.Millisecond)
+}
+
+func TestDomainsClient_VerifyAndWait_Failed(t *testing.T) {
+	domainsClient, server := setupDomainsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			json.NewEncoder(w).Encode(VerifyDomainResponse{})
+			return
+		}
+		w.Write([]byte(`{"domain":"example.com","status":"failed","verificationDetails":{"verificationStatus":"TemporaryFailure"}}`))
+	})
+	defer server.Close()
+
+	_, err := domainsClient.VerifyAndWait(context.Background(), "domain-123", &VerifyAndWaitOptions{
+		PollInterval: time.Millisecond,
+		Timeout:      time.Second,
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "TemporaryFailure")
+}
+
+func TestDomainsClient_VerifyAndWait_Timeout(t *testing.T) {
+	domainsClient, server := setupDomainsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			json.NewEncoder(w).Encode(VerifyDomainResponse{})
+			return
+		}
+		json.NewEncoder(w).Encode(GetDNSRecordsResponse{Status: DomainStatusPending})
+	})
+	defer server.Close()
+
+	_, err := domainsClient.VerifyAndWait(context.Background(), "domain-123", &VerifyAndWaitOptions{
+		PollInterval: 5 * time.Millisecond,
+		Timeout:      20 * time.Millisecond,
+	})
+
+	require.Error(t, err)
+	var timeoutErr *types.TimeoutError
+	assert.ErrorAs(t, err, &timeoutErr)
+}
+
 func TestDomainStatus_Constants(t *testing.T) {
 	assert.Equal(t, DomainStatus("pending"), DomainStatusPending)
 	assert.Equal(t, DomainStatus("verified"), DomainStatusVerified)
@@ -2,6 +2,7 @@ package mail
 
 import (
 	"context"
+	"errors"
 	"net/url"
 	"strconv"
 
@@ -11,6 +12,9 @@ import (
 // EventsClient handles event operations.
 type EventsClient struct {
 	http *client.HTTPClient
+
+	// schemas caches event properties schemas by event name for TrackTyped.
+	schemas schemaCache[*EventPropertiesSchema]
 }
 
 // NewEventsClient creates a new events client.
@@ -20,7 +24,11 @@ func NewEventsClient(http *client.HTTPClient) *EventsClient {
 
 // List lists all event definitions.
 func (c *EventsClient) List(ctx context.Context, req *ListEventsRequest) (*ListEventsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.ProjectSlug != nil {
 			params.Set("projectSlug", *req.ProjectSlug)
@@ -62,6 +70,10 @@ func (c *EventsClient) Get(ctx context.Context, id string) (*MailEvent, error) {
 
 // Create creates a new event definition.
 func (c *EventsClient) Create(ctx context.Context, req *CreateEventRequest) (*MailEvent, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp MailEvent
 	if err := c.http.Post(ctx, "/mail/events", req, &resp); err != nil {
 		return nil, err
@@ -71,6 +83,10 @@ func (c *EventsClient) Create(ctx context.Context, req *CreateEventRequest) (*Ma
 
 // Update updates an event definition.
 func (c *EventsClient) Update(ctx context.Context, req *UpdateEventRequest) (*MailEvent, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp MailEvent
 	if err := c.http.Put(ctx, "/mail/events/"+req.ID, req, &resp); err != nil {
 		return nil, err
@@ -89,6 +105,10 @@ func (c *EventsClient) Delete(ctx context.Context, id string) (*DeleteEventRespo
 
 // Track tracks a single event.
 func (c *EventsClient) Track(ctx context.Context, req *TrackEventRequest) (*TrackEventResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp TrackEventResponse
 	if err := c.http.Post(ctx, "/mail/events/track", req, &resp); err != nil {
 		return nil, err
@@ -98,6 +118,10 @@ func (c *EventsClient) Track(ctx context.Context, req *TrackEventRequest) (*Trac
 
 // TrackBatch tracks multiple events in a batch.
 func (c *EventsClient) TrackBatch(ctx context.Context, req *BatchTrackEventsRequest) (*BatchTrackEventsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp BatchTrackEventsResponse
 	if err := c.http.Post(ctx, "/mail/events/track/batch", req, &resp); err != nil {
 		return nil, err
@@ -107,6 +131,10 @@ func (c *EventsClient) TrackBatch(ctx context.Context, req *BatchTrackEventsRequ
 
 // ListOccurrences lists event occurrences.
 func (c *EventsClient) ListOccurrences(ctx context.Context, req *ListEventOccurrencesRequest) (*ListEventOccurrencesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	if req != nil {
 		if req.EventID != nil {
@@ -121,12 +149,8 @@ func (c *EventsClient) ListOccurrences(ctx context.Context, req *ListEventOccurr
 		if req.Offset != nil {
 			params.Set("offset", strconv.Itoa(*req.Offset))
 		}
-		if req.StartDate != nil {
-			params.Set("startDate", req.StartDate.Format("2006-01-02T15:04:05Z07:00"))
-		}
-		if req.EndDate != nil {
-			params.Set("endDate", req.EndDate.Format("2006-01-02T15:04:05Z07:00"))
-		}
+		client.SetTime(params, "startDate", req.StartDate)
+		client.SetTime(params, "endDate", req.EndDate)
 	}
 
 	path := "/mail/events/occurrences"
@@ -141,6 +165,25 @@ func (c *EventsClient) ListOccurrences(ctx context.Context, req *ListEventOccurr
 	return &resp, nil
 }
 
+// DeleteOccurrences permanently deletes the event occurrences matching req,
+// e.g. everything older than a retention cutoff. At least one filter must be
+// set, so a zero request can't delete every occurrence.
+func (c *EventsClient) DeleteOccurrences(ctx context.Context, req *DeleteEventOccurrencesRequest) (*DeleteEventOccurrencesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	if req == nil || (req.EventID == nil && req.ContactID == nil && req.StartDate == nil && req.EndDate == nil) {
+		return nil, errors.New("DeleteOccurrences requires at least one filter")
+	}
+
+	var resp DeleteEventOccurrencesResponse
+	if err := c.http.DeleteWithBody(ctx, "/mail/events/occurrences", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetAnalytics retrieves analytics for an event.
 func (c *EventsClient) GetAnalytics(ctx context.Context, id string) (*EventAnalyticsResponse, error) {
 	var resp EventAnalyticsResponse
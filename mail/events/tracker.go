@@ -0,0 +1,290 @@
+// Package events provides a buffered background tracker for mail events.
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/stack0/sdk-go/mail"
+	"github.com/stack0/sdk-go/types"
+)
+
+const (
+	defaultTrackerBatchSize     = 100
+	defaultTrackerFlushInterval = 5 * time.Second
+	defaultTrackerBufferSize    = 10000
+	defaultTrackerMaxRetries    = 3
+	defaultTrackerRetryBackoff  = time.Second
+)
+
+// ErrTrackerClosed is returned by Tracker.Track and Tracker.Flush after the
+// tracker has been closed.
+var ErrTrackerClosed = errors.New("tracker is closed")
+
+// TrackerOptions configure a Tracker. Zero values use the defaults.
+//
+// Events are sent once BatchSize (default 100) have been buffered or every
+// FlushInterval (default 5s). BufferSize (default 10000) bounds the number of
+// events waiting to be sent; Track blocks while the buffer is full.
+//
+// Batches failing with a rate-limit (429) or server (5xx) error are retried
+// up to MaxRetries times (default 3; negative disables retries), waiting
+// RetryBackoff (default 1s) doubled after each attempt, or the error's
+// RetryAfter if longer.
+//
+// OnError is called from the tracker's goroutine with the events of a batch
+// that could not be sent, or with the events the API rejected. Without it,
+// these errors are only reported by Flush and Close when they send the batch.
+type TrackerOptions struct {
+	Environment   *types.Environment
+	BatchSize     int
+	FlushInterval time.Duration
+	BufferSize    int
+	MaxRetries    int
+	RetryBackoff  time.Duration
+	OnError       func(events []mail.BatchTrackEventInput, err error)
+}
+
+// Tracker buffers events and sends them in the background with TrackBatch,
+// so that tracking an event doesn't cost a request. A Tracker is safe for
+// concurrent use. Call Close before exiting to send the buffered events.
+type Tracker struct {
+	client *mail.EventsClient
+	opts   TrackerOptions
+
+	// ctx scopes the requests and retry waits of the background goroutine;
+	// cancel aborts them when Close gives up waiting.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu guards closed. Tracks that passed the closed check are counted in
+	// pending, so that the final drain waits for their events.
+	mu      sync.Mutex
+	closed  bool
+	pending sync.WaitGroup
+
+	queue   chan mail.BatchTrackEventInput
+	flush   chan chan error
+	closing chan struct{}
+	done    chan struct{}
+}
+
+// NewTracker creates a Tracker and starts its background goroutine.
+func NewTracker(client *mail.EventsClient, opts *TrackerOptions) *Tracker {
+	t := &Tracker{client: client}
+	if opts != nil {
+		t.opts = *opts
+	}
+	if t.opts.BatchSize <= 0 {
+		t.opts.BatchSize = defaultTrackerBatchSize
+	}
+	if t.opts.FlushInterval <= 0 {
+		t.opts.FlushInterval = defaultTrackerFlushInterval
+	}
+	if t.opts.BufferSize <= 0 {
+		t.opts.BufferSize = defaultTrackerBufferSize
+	}
+	if t.opts.MaxRetries == 0 {
+		t.opts.MaxRetries = defaultTrackerMaxRetries
+	}
+	if t.opts.RetryBackoff <= 0 {
+		t.opts.RetryBackoff = defaultTrackerRetryBackoff
+	}
+
+	t.ctx, t.cancel = context.WithCancel(context.Background())
+	t.queue = make(chan mail.BatchTrackEventInput, t.opts.BufferSize)
+	t.flush = make(chan chan error)
+	t.closing = make(chan struct{})
+	t.done = make(chan struct{})
+	go t.run()
+	return t
+}
+
+// Track adds an event to the buffer. Timestamp is set to the current time
+// when nil, so the event keeps the time it happened rather than the time it
+// was sent. Track only blocks while the buffer is full, and returns ctx's
+// error if ctx is done first, or ErrTrackerClosed if the tracker is closed.
+func (t *Tracker) Track(ctx context.Context, event mail.BatchTrackEventInput) error {
+	if event.Timestamp == nil {
+		now := time.Now()
+		event.Timestamp = &now
+	}
+
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return ErrTrackerClosed
+	}
+	t.pending.Add(1)
+	t.mu.Unlock()
+	defer t.pending.Done()
+
+	select {
+	case t.queue <- event:
+		return nil
+	case <-t.closing:
+		return ErrTrackerClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Flush sends every buffered event and waits for the requests to complete.
+// It returns the first error encountered while sending them.
+func (t *Tracker) Flush(ctx context.Context) error {
+	reply := make(chan error, 1)
+	select {
+	case t.flush <- reply:
+	case <-t.closing:
+		return ErrTrackerClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case err := <-reply:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting events, sends those still buffered and waits for the
+// background goroutine to exit. If ctx is done first, Close cancels the
+// requests in flight and returns ctx's error; the events not yet sent are
+// reported to OnError. Calling Close more than once is safe.
+func (t *Tracker) Close(ctx context.Context) error {
+	t.mu.Lock()
+	if !t.closed {
+		t.closed = true
+		close(t.closing)
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-t.done:
+		return nil
+	case <-ctx.Done():
+		t.cancel()
+		return ctx.Err()
+	}
+}
+
+func (t *Tracker) run() {
+	defer close(t.done)
+	defer t.cancel()
+
+	ticker := time.NewTicker(t.opts.FlushInterval)
+	defer ticker.Stop()
+
+	var batch []mail.BatchTrackEventInput
+	send := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := t.send(batch)
+		batch = nil
+		return err
+	}
+	// drain sends every buffered event and returns the first error.
+	drain := func() error {
+		var firstErr error
+		for n := len(t.queue); n > 0; n-- {
+			batch = append(batch, <-t.queue)
+			if len(batch) >= t.opts.BatchSize {
+				if err := send(); err != nil && firstErr == nil {
+					firstErr = err
+				}
+			}
+		}
+		if err := send(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		return firstErr
+	}
+
+	for {
+		select {
+		case event := <-t.queue:
+			batch = append(batch, event)
+			if len(batch) >= t.opts.BatchSize {
+				send()
+			}
+		case <-ticker.C:
+			send()
+		case reply := <-t.flush:
+			reply <- drain()
+		case <-t.closing:
+			// Wait for the Tracks already under way, then send everything
+			// they buffered.
+			t.pending.Wait()
+			drain()
+			return
+		}
+	}
+}
+
+// send sends a batch, retrying transient errors, and reports failures to
+// OnError.
+func (t *Tracker) send(events []mail.BatchTrackEventInput) error {
+	backoff := t.opts.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		resp, err := t.client.TrackBatch(t.ctx, &mail.BatchTrackEventsRequest{
+			Environment: t.opts.Environment,
+			Events:      events,
+		})
+		if err == nil {
+			return t.rejected(events, resp)
+		}
+
+		var apiErr *types.APIError
+		if !errors.As(err, &apiErr) || !apiErr.Transient() || attempt >= t.opts.MaxRetries {
+			if t.opts.OnError != nil {
+				t.opts.OnError(events, err)
+			}
+			return err
+		}
+		wait := backoff
+		if apiErr.RetryAfter > wait {
+			wait = apiErr.RetryAfter
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-t.ctx.Done():
+			timer.Stop()
+			if t.opts.OnError != nil {
+				t.opts.OnError(events, t.ctx.Err())
+			}
+			return t.ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// rejected reports the events the API did not accept, which are not retried.
+func (t *Tracker) rejected(events []mail.BatchTrackEventInput, resp *mail.BatchTrackEventsResponse) error {
+	if resp.TotalFailed == 0 {
+		return nil
+	}
+
+	var failed []mail.BatchTrackEventInput
+	var message string
+	for i, result := range resp.Results {
+		if result.Success || i >= len(events) {
+			continue
+		}
+		failed = append(failed, events[i])
+		if message == "" && result.Error != nil {
+			message = *result.Error
+		}
+	}
+	err := fmt.Errorf("%d of %d events rejected: %s", resp.TotalFailed, len(events), message)
+	if t.opts.OnError != nil {
+		t.opts.OnError(failed, err)
+	}
+	return err
+}
@@ -0,0 +1,235 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupEventsTestClient(t *testing.T, handler http.HandlerFunc) (*mail.EventsClient, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return mail.NewEventsClient(httpClient), server
+}
+
+func trackerTestHandler(t *testing.T, batches chan<- []mail.BatchTrackEventInput) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/events/track/batch", r.URL.Path)
+
+		var req mail.BatchTrackEventsRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		batches <- req.Events
+
+		results := make([]mail.BatchTrackEventResult, len(req.Events))
+		for i := range results {
+			results[i].Success = true
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(mail.BatchTrackEventsResponse{Success: true, Results: results, TotalProcessed: len(results)})
+	}
+}
+
+func TestTracker_BatchSize(t *testing.T) {
+	batches := make(chan []mail.BatchTrackEventInput, 10)
+	eventsClient, server := setupEventsTestClient(t, trackerTestHandler(t, batches))
+	defer server.Close()
+
+	tracker := NewTracker(eventsClient, &TrackerOptions{BatchSize: 2, FlushInterval: time.Hour})
+	ctx := context.Background()
+	for _, name := range []string{"a", "b", "c"} {
+		require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: name}))
+	}
+
+	batch := <-batches
+	require.Len(t, batch, 2)
+	assert.Equal(t, "a", batch[0].EventName)
+	assert.NotNil(t, batch[0].Timestamp)
+
+	require.NoError(t, tracker.Close(ctx))
+	assert.Len(t, <-batches, 1)
+}
+
+func TestTracker_FlushInterval(t *testing.T) {
+	batches := make(chan []mail.BatchTrackEventInput, 10)
+	eventsClient, server := setupEventsTestClient(t, trackerTestHandler(t, batches))
+	defer server.Close()
+
+	tracker := NewTracker(eventsClient, &TrackerOptions{FlushInterval: 10 * time.Millisecond})
+	defer tracker.Close(context.Background())
+	require.NoError(t, tracker.Track(context.Background(), mail.BatchTrackEventInput{EventName: "page_viewed"}))
+
+	select {
+	case batch := <-batches:
+		assert.Len(t, batch, 1)
+	case <-time.After(time.Second):
+		t.Fatal("batch was not sent")
+	}
+}
+
+func TestTracker_Flush(t *testing.T) {
+	batches := make(chan []mail.BatchTrackEventInput, 10)
+	eventsClient, server := setupEventsTestClient(t, trackerTestHandler(t, batches))
+	defer server.Close()
+
+	tracker := NewTracker(eventsClient, &TrackerOptions{FlushInterval: time.Hour})
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "signup"}))
+	}
+
+	require.NoError(t, tracker.Flush(ctx))
+	assert.Len(t, <-batches, 5)
+
+	require.NoError(t, tracker.Close(ctx))
+	assert.ErrorIs(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "late"}), ErrTrackerClosed)
+	assert.ErrorIs(t, tracker.Flush(ctx), ErrTrackerClosed)
+	assert.NoError(t, tracker.Close(ctx))
+}
+
+func TestTracker_RetriesTransientErrors(t *testing.T) {
+	var requests int32
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			json.NewEncoder(w).Encode(map[string]string{"message": "unavailable"})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(mail.BatchTrackEventsResponse{Success: true, Results: []mail.BatchTrackEventResult{{Success: true}}})
+	})
+	defer server.Close()
+
+	tracker := NewTracker(eventsClient, &TrackerOptions{FlushInterval: time.Hour, RetryBackoff: time.Millisecond})
+	ctx := context.Background()
+	require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "signup"}))
+
+	require.NoError(t, tracker.Flush(ctx))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	require.NoError(t, tracker.Close(ctx))
+}
+
+func TestTracker_OnError(t *testing.T) {
+	t.Run("request error", func(t *testing.T) {
+		var requests int32
+		eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&requests, 1)
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"message": "invalid event"})
+		})
+		defer server.Close()
+
+		var mu sync.Mutex
+		var failed []mail.BatchTrackEventInput
+		tracker := NewTracker(eventsClient, &TrackerOptions{
+			FlushInterval: time.Hour,
+			OnError: func(events []mail.BatchTrackEventInput, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				failed = append(failed, events...)
+			},
+		})
+		ctx := context.Background()
+		require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "signup"}))
+
+		assert.Error(t, tracker.Flush(ctx))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+		mu.Lock()
+		assert.Len(t, failed, 1)
+		mu.Unlock()
+		require.NoError(t, tracker.Close(ctx))
+	})
+
+	t.Run("rejected events", func(t *testing.T) {
+		eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			msg := "unknown contact"
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(mail.BatchTrackEventsResponse{
+				Results:     []mail.BatchTrackEventResult{{Success: true}, {Success: false, Error: &msg}},
+				TotalFailed: 1,
+			})
+		})
+		defer server.Close()
+
+		var failed []mail.BatchTrackEventInput
+		tracker := NewTracker(eventsClient, &TrackerOptions{
+			FlushInterval: time.Hour,
+			OnError: func(events []mail.BatchTrackEventInput, err error) {
+				failed = events
+			},
+		})
+		ctx := context.Background()
+		require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "ok"}))
+		require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "bad"}))
+
+		err := tracker.Flush(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unknown contact")
+		require.NoError(t, tracker.Close(ctx))
+		require.Len(t, failed, 1)
+		assert.Equal(t, "bad", failed[0].EventName)
+	})
+}
+
+func TestTracker_CloseWhileTrackBlocked(t *testing.T) {
+	release := make(chan struct{})
+	received := make(chan struct{}, 1)
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+		<-release
+	})
+	defer server.Close()
+	defer close(release)
+
+	tracker := NewTracker(eventsClient, &TrackerOptions{BatchSize: 1, BufferSize: 1, FlushInterval: time.Hour})
+	ctx := context.Background()
+	require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "first"}))
+	<-received
+	require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "buffered"}))
+
+	blocked := make(chan error, 1)
+	go func() {
+		blocked <- tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "blocked"})
+	}()
+
+	closeCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, tracker.Close(closeCtx), context.DeadlineExceeded)
+	assert.ErrorIs(t, <-blocked, ErrTrackerClosed)
+	assert.ErrorIs(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "late"}), ErrTrackerClosed)
+}
+
+func TestTracker_CloseCancelsRetries(t *testing.T) {
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		json.NewEncoder(w).Encode(map[string]string{"message": "unavailable"})
+	})
+	defer server.Close()
+
+	failed := make(chan error, 1)
+	tracker := NewTracker(eventsClient, &TrackerOptions{
+		FlushInterval: time.Hour,
+		RetryBackoff:  time.Hour,
+		OnError: func(events []mail.BatchTrackEventInput, err error) {
+			failed <- err
+		},
+	})
+	ctx := context.Background()
+	require.NoError(t, tracker.Track(ctx, mail.BatchTrackEventInput{EventName: "signup"}))
+
+	closeCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, tracker.Close(closeCtx), context.DeadlineExceeded)
+	assert.ErrorIs(t, <-failed, context.Canceled)
+}
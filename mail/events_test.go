@@ -226,6 +226,25 @@ func TestEventsClient_Track(t *testing.T) {
 	assert.NotNil(t, resp.EventOccurrenceID)
 }
 
+func TestEventsClient_Track_TriggeredSequences(t *testing.T) {
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"success": true,
+			"eventOccurrenceId": "occ-123",
+			"triggeredSequences": [{"sequenceId": "seq-1", "entryId": "entry-1"}],
+			"suppressedSequences": [{"sequenceId": "seq-2", "frequency": "once"}]
+		}`))
+	})
+	defer server.Close()
+
+	resp, err := eventsClient.Track(context.Background(), &TrackEventRequest{EventName: "purchase", ContactID: ptr("contact-123")})
+
+	require.NoError(t, err)
+	assert.Equal(t, []TriggeredSequence{{SequenceID: "seq-1", EntryID: "entry-1"}}, resp.TriggeredSequences)
+	assert.Equal(t, []SuppressedSequence{{SequenceID: "seq-2", Frequency: SequenceTriggerOnce}}, resp.SuppressedSequences)
+}
+
 func TestEventsClient_Track_WithContactID(t *testing.T) {
 	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
 		var req TrackEventRequest
@@ -333,6 +352,46 @@ func TestEventsClient_ListOccurrences_WithFilters(t *testing.T) {
 	assert.NotNil(t, resp)
 }
 
+func TestEventsClient_DeleteOccurrences(t *testing.T) {
+	cutoff := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/mail/events/occurrences", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "evt-123", body["eventId"])
+		assert.Equal(t, "2025-10-01T00:00:00Z", body["endDate"])
+		assert.NotContains(t, body, "contactId")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DeleteEventOccurrencesResponse{Success: true, Deleted: 1200})
+	})
+	defer server.Close()
+
+	eventID := "evt-123"
+	resp, err := eventsClient.DeleteOccurrences(context.Background(), &DeleteEventOccurrencesRequest{
+		EventID: &eventID,
+		EndDate: &cutoff,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1200, resp.Deleted)
+}
+
+func TestEventsClient_DeleteOccurrences_RequiresFilter(t *testing.T) {
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("unexpected request")
+	})
+	defer server.Close()
+
+	_, err := eventsClient.DeleteOccurrences(context.Background(), &DeleteEventOccurrencesRequest{})
+	assert.Error(t, err)
+
+	_, err = eventsClient.DeleteOccurrences(context.Background(), nil)
+	assert.Error(t, err)
+}
+
 func TestEventsClient_GetAnalytics(t *testing.T) {
 	eventID := "evt-123"
 	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
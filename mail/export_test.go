@@ -0,0 +1,167 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Export(t *testing.T) {
+	pages := map[string][]Email{
+		"0": {{ID: "email-1", To: "a@example.com", Tags: []string{"welcome", "onboarding"}}, {ID: "email-2", To: "b@example.com"}},
+		"2": {{ID: "email-3", To: "c@example.com"}},
+	}
+
+	t.Run("ndjson follows pagination", func(t *testing.T) {
+		var requests int
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			assert.Equal(t, "/mail", r.URL.Path)
+			assert.Equal(t, "2", r.URL.Query().Get("limit"))
+			assert.Equal(t, "sent", r.URL.Query().Get("status"))
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ListEmailsResponse{
+				Emails: pages[r.URL.Query().Get("offset")],
+				Total:  3,
+			})
+		})
+		defer server.Close()
+
+		var buf bytes.Buffer
+		status := EmailStatusSent
+		n, err := mailClient.Export(context.Background(), &ListEmailsRequest{
+			Limit:  ptr(2),
+			Status: &status,
+		}, &buf, ExportFormatNDJSON)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+		assert.Equal(t, 2, requests)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 3)
+		var email Email
+		require.NoError(t, json.Unmarshal([]byte(lines[2]), &email))
+		assert.Equal(t, "email-3", email.ID)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ListEmailsResponse{
+				Emails: pages[r.URL.Query().Get("offset")],
+				Total:  3,
+			})
+		})
+		defer server.Close()
+
+		var buf bytes.Buffer
+		n, err := mailClient.Export(context.Background(), &ListEmailsRequest{Limit: ptr(2)}, &buf, ExportFormatCSV)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		assert.Equal(t, "id", records[0][0])
+		assert.Equal(t, "email-1", records[1][0])
+		assert.Equal(t, "welcome;onboarding", records[1][8])
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("unexpected request")
+		})
+		defer server.Close()
+
+		_, err := mailClient.Export(context.Background(), nil, &bytes.Buffer{}, ExportFormat("xml"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAudiencesClient_Export(t *testing.T) {
+	first := "Alice"
+	contacts := make([]AudienceContact, defaultExportPageSize+1)
+	for i := range contacts {
+		contacts[i] = AudienceContact{MailContact: MailContact{ID: "contact-" + strconv.Itoa(i), Status: "subscribed"}}
+	}
+	contacts[0].Email = "alice@example.com"
+	contacts[0].FirstName = &first
+	contacts[0].Metadata = map[string]interface{}{"plan": "pro"}
+	contacts[1].Status = "unsubscribed"
+
+	handler := func(t *testing.T) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/mail/audiences/aud-123/contacts", r.URL.Path)
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+			end := offset + limit
+			if end > len(contacts) {
+				end = len(contacts)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ListAudienceContactsResponse{
+				Contacts: contacts[offset:end],
+				Total:    len(contacts),
+			})
+		}
+	}
+
+	t.Run("ndjson follows pagination", func(t *testing.T) {
+		audiencesClient, server := setupAudiencesTestClient(t, handler(t))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		n, err := audiencesClient.Export(context.Background(), "aud-123", &buf, ExportFormatNDJSON)
+
+		require.NoError(t, err)
+		assert.Equal(t, len(contacts), n)
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, len(contacts))
+		var contact AudienceContact
+		require.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &contact))
+		assert.Equal(t, "contact-100", contact.ID)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		audiencesClient, server := setupAudiencesTestClient(t, handler(t))
+		defer server.Close()
+
+		var buf bytes.Buffer
+		n, err := audiencesClient.Export(context.Background(), "aud-123", &buf, ExportFormatCSV)
+
+		require.NoError(t, err)
+		assert.Equal(t, len(contacts), n)
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, len(contacts)+1)
+		assert.Equal(t, audienceExportCSVHeader, records[0])
+		assert.Equal(t, "alice@example.com", records[1][1])
+		assert.Equal(t, "Alice", records[1][2])
+		assert.Equal(t, `{"plan":"pro"}`, records[1][10])
+		assert.Equal(t, "unsubscribed", records[2][6])
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		audiencesClient, server := setupAudiencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			t.Fatal("unexpected request")
+		})
+		defer server.Close()
+
+		_, err := audiencesClient.Export(context.Background(), "aud-123", &bytes.Buffer{}, ExportFormat("xml"))
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,72 @@
+package mail
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// GetFallbackProvider retrieves the fallback sending provider configuration.
+func (c *Client) GetFallbackProvider(ctx context.Context) (*FallbackProvider, error) {
+	var resp FallbackProvider
+	if err := c.http.Get(ctx, "/mail/fallback-provider", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetFallbackProvider configures the provider used when the primary provider
+// keeps deferring an email, replacing any existing configuration.
+func (c *Client) SetFallbackProvider(ctx context.Context, req *SetFallbackProviderRequest) (*FallbackProvider, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp FallbackProvider
+	if err := c.http.Put(ctx, "/mail/fallback-provider", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteFallbackProvider removes the fallback provider, so deferred emails
+// are only retried through the primary provider.
+func (c *Client) DeleteFallbackProvider(ctx context.Context) (*DeleteFallbackProviderResponse, error) {
+	var resp DeleteFallbackProviderResponse
+	if err := c.http.Delete(ctx, "/mail/fallback-provider", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListFailovers lists emails that were sent through the fallback provider.
+func (c *Client) ListFailovers(ctx context.Context, req *ListFailoversRequest) (*ListFailoversResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req != nil {
+		client.SetTime(params, "startDate", req.StartDate)
+		client.SetTime(params, "endDate", req.EndDate)
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+	}
+
+	path := "/mail/analytics/failovers"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListFailoversResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
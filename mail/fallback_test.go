@@ -0,0 +1,115 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SetFallbackProvider(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/fallback-provider", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "smtp", body["type"])
+		assert.Equal(t, float64(30), body["deferThresholdMinutes"])
+		assert.NotContains(t, body, "apiKey")
+		smtp := body["smtp"].(map[string]interface{})
+		assert.Equal(t, "smtp.example.com", smtp["host"])
+		assert.Equal(t, float64(587), smtp["port"])
+		assert.Equal(t, "secret", smtp["password"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(FallbackProvider{
+			Type:                  FallbackProviderSMTP,
+			Enabled:               true,
+			SMTP:                  &SMTPServer{Host: "smtp.example.com", Port: 587, Username: "relay"},
+			DeferThresholdMinutes: 30,
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.SetFallbackProvider(context.Background(), &SetFallbackProviderRequest{
+		Type:                  FallbackProviderSMTP,
+		SMTP:                  &SMTPServer{Host: "smtp.example.com", Port: 587, Username: "relay", Password: "secret"},
+		DeferThresholdMinutes: 30,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Enabled)
+	assert.Equal(t, "smtp.example.com", resp.SMTP.Host)
+	assert.Empty(t, resp.SMTP.Password)
+}
+
+func TestClient_GetFallbackProvider(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/fallback-provider", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"type":                  "postmark",
+			"enabled":               true,
+			"apiKeyLast4":           "a1b2",
+			"deferThresholdMinutes": 15,
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.GetFallbackProvider(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, FallbackProviderPostmark, resp.Type)
+	assert.Equal(t, "a1b2", *resp.APIKeyLast4)
+	assert.Nil(t, resp.SMTP)
+}
+
+func TestClient_DeleteFallbackProvider(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/mail/fallback-provider", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DeleteFallbackProviderResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.DeleteFallbackProvider(context.Background())
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestClient_ListFailovers(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/analytics/failovers", r.URL.Path)
+		assert.Equal(t, "2026-10-01T00:00:00Z", r.URL.Query().Get("startDate"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListFailoversResponse{
+			Failovers: []Failover{{ID: "fo-1", EmailID: "email-1", Provider: FallbackProviderSMTP, DeferredSeconds: 1800, Delivered: true}},
+			Total:     1,
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.ListFailovers(context.Background(), &ListFailoversRequest{
+		StartDate: &start,
+		Limit:     ptr(10),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Failovers, 1)
+	assert.Equal(t, "email-1", resp.Failovers[0].EmailID)
+	assert.True(t, resp.Failovers[0].Delivered)
+}
@@ -0,0 +1,291 @@
+package mail
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+const (
+	defaultImportCSVChunkSize   = 500
+	defaultImportCSVConcurrency = 4
+)
+
+// CSVMapping maps CSV header names to contact fields. Header names are
+// matched case-insensitively. Email defaults to "email"; other fields are
+// only read when set. Metadata maps a column name to the metadata key it is
+// stored under.
+type CSVMapping struct {
+	Email     string
+	FirstName string
+	LastName  string
+	Metadata  map[string]string
+}
+
+// ImportCSVOptions are options for ImportCSV. ChunkSize is the number of rows
+// sent per Import call (default 500) and Concurrency the number of calls in
+// flight at once (default 4).
+type ImportCSVOptions struct {
+	Environment *types.Environment
+	AudienceID  *string
+	ChunkSize   int
+	Concurrency int
+}
+
+// CSVImportError is a row that could not be imported. Row is the 1-based
+// line number where the row starts in the file, counting the header as
+// line 1.
+type CSVImportError struct {
+	Row   int
+	Email string
+	Error string
+}
+
+// ImportCSVResult aggregates the results of every chunk of an ImportCSV.
+// Errors are sorted by row.
+type ImportCSVResult struct {
+	Rows     int
+	Imported int
+	Skipped  int
+	Errors   []CSVImportError
+}
+
+type csvChunk struct {
+	contacts []ImportContactInput
+	rows     []int
+}
+
+// ImportCSV reads contacts from a CSV file with a header row and imports
+// them in chunks, with up to opts.Concurrency chunks in flight. The file is
+// streamed, so only the chunks being sent are held in memory. Rows without
+// an email are reported as errors without being sent, and errors returned by
+// the API are mapped back to their row numbers.
+//
+// If reading the CSV fails, no further chunks are sent, the chunks already in
+// flight are allowed to finish, and the error is returned together with their
+// results. If an Import call fails, the calls still in flight are cancelled.
+func (c *ContactsClient) ImportCSV(ctx context.Context, r io.Reader, mapping CSVMapping, opts *ImportCSVOptions) (*ImportCSVResult, error) {
+	chunkSize := defaultImportCSVChunkSize
+	concurrency := defaultImportCSVConcurrency
+	var environment *types.Environment
+	var audienceID *string
+	if opts != nil {
+		if opts.ChunkSize > 0 {
+			chunkSize = opts.ChunkSize
+		}
+		if opts.Concurrency > 0 {
+			concurrency = opts.Concurrency
+		}
+		environment = opts.Environment
+		audienceID = opts.AudienceID
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return &ImportCSVResult{}, nil
+		}
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	columns, err := mapping.columns(header)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result := &ImportCSVResult{}
+	var (
+		mu       sync.Mutex
+		firstErr error
+		readErr  error
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, concurrency)
+	fail := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+		mu.Unlock()
+	}
+	send := func(chunk csvChunk) {
+		defer wg.Done()
+		defer func() { <-sem }()
+
+		resp, err := c.Import(ctx, &ImportContactsRequest{
+			Environment: environment,
+			AudienceID:  audienceID,
+			Contacts:    chunk.contacts,
+		})
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		// Match API errors back to rows by email, in order, so repeated
+		// addresses within a chunk map to successive rows.
+		rowsByEmail := make(map[string][]int)
+		for i, contact := range chunk.contacts {
+			key := strings.ToLower(contact.Email)
+			rowsByEmail[key] = append(rowsByEmail[key], chunk.rows[i])
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		result.Imported += resp.Imported
+		result.Skipped += resp.Skipped
+		for _, e := range resp.Errors {
+			key := strings.ToLower(e.Email)
+			row := 0
+			if rows := rowsByEmail[key]; len(rows) > 0 {
+				row, rowsByEmail[key] = rows[0], rows[1:]
+			}
+			result.Errors = append(result.Errors, CSVImportError{Row: row, Email: e.Email, Error: e.Error})
+		}
+	}
+	dispatch := func(chunk csvChunk) bool {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return false
+		}
+		wg.Add(1)
+		go send(chunk)
+		return true
+	}
+
+	chunk := csvChunk{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			readErr = fmt.Errorf("failed to read CSV: %w", err)
+			break
+		}
+
+		result.Rows++
+		line, _ := cr.FieldPos(0)
+		contact, ok := columns.contact(record)
+		if !ok {
+			mu.Lock()
+			result.Errors = append(result.Errors, CSVImportError{Row: line, Error: "missing email"})
+			mu.Unlock()
+			continue
+		}
+		chunk.contacts = append(chunk.contacts, contact)
+		chunk.rows = append(chunk.rows, line)
+		if len(chunk.contacts) == chunkSize {
+			if !dispatch(chunk) {
+				break
+			}
+			chunk = csvChunk{}
+		}
+	}
+	if len(chunk.contacts) > 0 && readErr == nil && ctx.Err() == nil {
+		dispatch(chunk)
+	}
+	wg.Wait()
+
+	sort.SliceStable(result.Errors, func(i, j int) bool { return result.Errors[i].Row < result.Errors[j].Row })
+	if firstErr != nil {
+		return result, firstErr
+	}
+	if readErr != nil {
+		return result, readErr
+	}
+	return result, ctx.Err()
+}
+
+type csvColumns struct {
+	email     int
+	firstName int
+	lastName  int
+	metadata  map[int]string
+}
+
+func (m CSVMapping) columns(header []string) (*csvColumns, error) {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			// Spreadsheet exports often start with a UTF-8 byte order mark.
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	lookup := func(name string) (int, error) {
+		if name == "" {
+			return -1, nil
+		}
+		i, ok := index[strings.ToLower(name)]
+		if !ok {
+			return -1, fmt.Errorf("CSV has no %q column", name)
+		}
+		return i, nil
+	}
+
+	emailColumn := m.Email
+	if emailColumn == "" {
+		emailColumn = "email"
+	}
+	cols := &csvColumns{metadata: make(map[int]string, len(m.Metadata))}
+	var err error
+	if cols.email, err = lookup(emailColumn); err != nil {
+		return nil, err
+	}
+	if cols.firstName, err = lookup(m.FirstName); err != nil {
+		return nil, err
+	}
+	if cols.lastName, err = lookup(m.LastName); err != nil {
+		return nil, err
+	}
+	for column, key := range m.Metadata {
+		i, err := lookup(column)
+		if err != nil {
+			return nil, err
+		}
+		cols.metadata[i] = key
+	}
+	return cols, nil
+}
+
+func (c *csvColumns) contact(record []string) (ImportContactInput, bool) {
+	field := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	contact := ImportContactInput{Email: field(c.email)}
+	if contact.Email == "" {
+		return contact, false
+	}
+	if v := field(c.firstName); v != "" {
+		contact.FirstName = &v
+	}
+	if v := field(c.lastName); v != "" {
+		contact.LastName = &v
+	}
+	for i, key := range c.metadata {
+		if v := field(i); v != "" {
+			if contact.Metadata == nil {
+				contact.Metadata = make(map[string]interface{}, len(c.metadata))
+			}
+			contact.Metadata[key] = v
+		}
+	}
+	return contact, true
+}
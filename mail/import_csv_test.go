@@ -0,0 +1,202 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactsClient_ImportCSV(t *testing.T) {
+	csvData := strings.Join([]string{
+		"Email,First Name,Plan,Ignored",
+		"a@example.com,Ada,pro,x",
+		",Nobody,free,x",
+		"b@example.com,,\"free\nplan\",x",
+		"not-an-email,Bad,free,x",
+		"c@example.com,Cy,,x",
+	}, "\n")
+
+	var mu sync.Mutex
+	var received [][]ImportContactInput
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/contacts/import", r.URL.Path)
+
+		var body ImportContactsRequest
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "aud-1", *body.AudienceID)
+		mu.Lock()
+		received = append(received, body.Contacts)
+		mu.Unlock()
+
+		resp := ImportContactsResponse{Success: true}
+		for _, c := range body.Contacts {
+			if c.Email == "not-an-email" {
+				resp.Errors = append(resp.Errors, ImportContactError{Email: c.Email, Error: "invalid email"})
+			} else {
+				resp.Imported++
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	})
+	defer server.Close()
+
+	result, err := contactsClient.ImportCSV(context.Background(), strings.NewReader(csvData), CSVMapping{
+		FirstName: "first name",
+		Metadata:  map[string]string{"Plan": "plan"},
+	}, &ImportCSVOptions{AudienceID: ptr("aud-1"), ChunkSize: 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Rows)
+	assert.Equal(t, 3, result.Imported)
+	assert.Equal(t, []CSVImportError{
+		{Row: 3, Error: "missing email"},
+		{Row: 6, Email: "not-an-email", Error: "invalid email"},
+	}, result.Errors)
+
+	require.Len(t, received, 2)
+	var all []ImportContactInput
+	for _, chunk := range received {
+		all = append(all, chunk...)
+	}
+	require.Len(t, all, 4)
+	for _, c := range all {
+		if c.Email == "a@example.com" {
+			assert.Equal(t, "Ada", *c.FirstName)
+			assert.Equal(t, "pro", c.Metadata["plan"])
+		}
+		if c.Email == "c@example.com" {
+			assert.Nil(t, c.Metadata)
+		}
+	}
+}
+
+func TestContactsClient_ImportCSV_BoundedConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	release := make(chan struct{})
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		<-release
+		atomic.AddInt32(&inFlight, -1)
+		json.NewEncoder(w).Encode(ImportContactsResponse{Imported: 1})
+	})
+	defer server.Close()
+
+	rows := []string{"email"}
+	for i := 0; i < 10; i++ {
+		rows = append(rows, "user"+string(rune('a'+i))+"@example.com")
+	}
+	go func() {
+		for i := 0; i < 10; i++ {
+			release <- struct{}{}
+		}
+	}()
+
+	result, err := contactsClient.ImportCSV(context.Background(), strings.NewReader(strings.Join(rows, "\n")), CSVMapping{},
+		&ImportCSVOptions{ChunkSize: 1, Concurrency: 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, 10, result.Imported)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+}
+
+func TestContactsClient_ImportCSV_MissingColumn(t *testing.T) {
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected")
+	})
+	defer server.Close()
+
+	_, err := contactsClient.ImportCSV(context.Background(), strings.NewReader("mail,name\na@example.com,Ada"), CSVMapping{}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"email"`)
+}
+
+func TestContactsClient_ImportCSV_ByteOrderMark(t *testing.T) {
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req ImportContactsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Len(t, req.Contacts, 1)
+		assert.Equal(t, "a@example.com", req.Contacts[0].Email)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ImportContactsResponse{Imported: 1})
+	})
+	defer server.Close()
+
+	result, err := contactsClient.ImportCSV(context.Background(), strings.NewReader("\ufeffEmail,name\na@example.com,Ada"), CSVMapping{}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Imported)
+}
+
+func TestContactsClient_ImportCSV_StopsOnAPIError(t *testing.T) {
+	var calls int32
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"message": "audience not found", "code": "NOT_FOUND"})
+	})
+	defer server.Close()
+
+	csvData := "email\na@example.com\nb@example.com\nc@example.com\nd@example.com"
+	_, err := contactsClient.ImportCSV(context.Background(), strings.NewReader(csvData), CSVMapping{},
+		&ImportCSVOptions{ChunkSize: 1, Concurrency: 1})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "audience not found")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+// blockingErrReader fails once started is closed, so the read error happens
+// while an Import call is in flight.
+type blockingErrReader struct {
+	started <-chan struct{}
+}
+
+func (r blockingErrReader) Read([]byte) (int, error) {
+	<-r.started
+	return 0, errors.New("connection reset")
+}
+
+func TestContactsClient_ImportCSV_ReadErrorKeepsInFlightImports(t *testing.T) {
+	var calls int32
+	started := make(chan struct{})
+	contactsClient, server := setupContactsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		close(started)
+		// Give ImportCSV time to hit the read error before responding.
+		time.Sleep(50 * time.Millisecond)
+		json.NewEncoder(w).Encode(ImportContactsResponse{Imported: 2})
+	})
+	defer server.Close()
+
+	r := io.MultiReader(strings.NewReader("email\na@example.com\nb@example.com\nc@example.com\n"), blockingErrReader{started: started})
+	result, err := contactsClient.ImportCSV(context.Background(), r, CSVMapping{},
+		&ImportCSVOptions{ChunkSize: 2})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "connection reset")
+	assert.Equal(t, 3, result.Rows)
+	assert.Equal(t, 2, result.Imported)
+	// The partial chunk read before the error is not sent.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,278 @@
+package mail
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateStyle selects the length of a formatted date.
+type DateStyle string
+
+const (
+	DateStyleShort  DateStyle = "short"
+	DateStyleMedium DateStyle = "medium"
+	DateStyleLong   DateStyle = "long"
+)
+
+// PluralForms are the message variants passed to Plural. Only Other is
+// required; languages that use a form that is empty fall back to Other.
+type PluralForms struct {
+	Zero  string
+	One   string
+	Few   string
+	Many  string
+	Other string
+}
+
+// nbsp separates digit groups and currency symbols so they don't wrap.
+const nbsp = "\u00a0"
+
+type localeFormat struct {
+	decimal        string
+	group          string
+	currencyPrefix bool
+	date           func(t time.Time, style DateStyle) string
+	plural         func(n int) string
+}
+
+var (
+	monthsDE = []string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"}
+	monthsFR = []string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"}
+	monthsES = []string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"}
+)
+
+func pluralOneOther(n int) string {
+	if n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+func pluralFrench(n int) string {
+	if n == 0 || n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+func pluralPolish(n int) string {
+	switch {
+	case n == 1:
+		return "one"
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return "few"
+	default:
+		return "many"
+	}
+}
+
+func monthName(months []string, t time.Time, abbreviate int) string {
+	name := months[t.Month()-1]
+	if abbreviate > 0 && len([]rune(name)) > abbreviate+1 {
+		name = string([]rune(name)[:abbreviate]) + "."
+	}
+	return name
+}
+
+var localeFormats = map[string]localeFormat{
+	"en": {
+		decimal: ".", group: ",", currencyPrefix: true, plural: pluralOneOther,
+		date: func(t time.Time, style DateStyle) string {
+			switch style {
+			case DateStyleShort:
+				return t.Format("1/2/2006")
+			case DateStyleMedium:
+				return t.Format("Jan 2, 2006")
+			default:
+				return t.Format("January 2, 2006")
+			}
+		},
+	},
+	"en-gb": {
+		decimal: ".", group: ",", currencyPrefix: true, plural: pluralOneOther,
+		date: func(t time.Time, style DateStyle) string {
+			switch style {
+			case DateStyleShort:
+				return t.Format("02/01/2006")
+			case DateStyleMedium:
+				return t.Format("2 Jan 2006")
+			default:
+				return t.Format("2 January 2006")
+			}
+		},
+	},
+	"de": {
+		decimal: ",", group: ".", plural: pluralOneOther,
+		date: func(t time.Time, style DateStyle) string {
+			if style == DateStyleLong {
+				return t.Format("2. ") + monthName(monthsDE, t, 0) + t.Format(" 2006")
+			}
+			return t.Format("02.01.2006")
+		},
+	},
+	"fr": {
+		decimal: ",", group: nbsp, plural: pluralFrench,
+		date: func(t time.Time, style DateStyle) string {
+			switch style {
+			case DateStyleShort:
+				return t.Format("02/01/2006")
+			case DateStyleMedium:
+				return t.Format("2 ") + monthName(monthsFR, t, 4) + t.Format(" 2006")
+			default:
+				return t.Format("2 ") + monthName(monthsFR, t, 0) + t.Format(" 2006")
+			}
+		},
+	},
+	"es": {
+		decimal: ",", group: ".", plural: pluralOneOther,
+		date: func(t time.Time, style DateStyle) string {
+			switch style {
+			case DateStyleShort:
+				return t.Format("2/1/2006")
+			case DateStyleMedium:
+				return t.Format("2 ") + monthName(monthsES, t, 3) + t.Format(" 2006")
+			default:
+				return t.Format("2 de ") + monthName(monthsES, t, 0) + t.Format(" de 2006")
+			}
+		},
+	},
+	"pl": {
+		decimal: ",", group: nbsp, plural: pluralPolish,
+		date: func(t time.Time, style DateStyle) string {
+			return t.Format("02.01.2006")
+		},
+	},
+	"ja": {
+		decimal: ".", group: ",", currencyPrefix: true,
+		plural: func(int) string { return "other" },
+		date: func(t time.Time, style DateStyle) string {
+			if style == DateStyleLong {
+				return t.Format("2006年1月2日")
+			}
+			return t.Format("2006/01/02")
+		},
+	},
+}
+
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+	"PLN": "zł",
+}
+
+var currencyDecimals = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+}
+
+// lookupLocale resolves a BCP 47 tag such as "en-GB" or "de_AT", falling back
+// to the base language and then to English.
+func lookupLocale(locale string) localeFormat {
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if f, ok := localeFormats[tag]; ok {
+		return f
+	}
+	if lang, _, ok := strings.Cut(tag, "-"); ok {
+		if f, ok := localeFormats[lang]; ok {
+			return f
+		}
+	}
+	return localeFormats["en"]
+}
+
+// FormatNumber formats v with the given number of decimal places using the
+// digit grouping and decimal separator of locale.
+func FormatNumber(v float64, decimals int, locale string) string {
+	f := lookupLocale(locale)
+	scale := math.Pow(10, float64(decimals))
+	rounded := math.Round(v*scale) / scale
+	if rounded == 0 {
+		// Small negative values round to -0, which would format as "-0.00".
+		rounded = 0
+	}
+	s := strconv.FormatFloat(rounded, 'f', decimals, 64)
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, frac, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	for i, d := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(f.group)
+		}
+		b.WriteRune(d)
+	}
+	if frac != "" {
+		b.WriteString(f.decimal)
+		b.WriteString(frac)
+	}
+	return sign + b.String()
+}
+
+// FormatCurrency formats amount in the given ISO 4217 currency for locale,
+// e.g. "$1,234.50" for en-US or "1.234,50 €" for de-DE.
+func FormatCurrency(amount float64, currency, locale string) string {
+	f := lookupLocale(locale)
+	currency = strings.ToUpper(currency)
+
+	decimals, ok := currencyDecimals[currency]
+	if !ok {
+		decimals = 2
+	}
+	symbol, ok := currencySymbols[currency]
+	if !ok {
+		symbol = currency
+	}
+
+	// The sign comes from the formatted number, so amounts that round to
+	// zero aren't shown as negative.
+	sign := ""
+	number := FormatNumber(amount, decimals, locale)
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	if f.currencyPrefix {
+		if len(symbol) > 1 && symbol == currency {
+			return sign + symbol + nbsp + number
+		}
+		return sign + symbol + number
+	}
+	return sign + number + nbsp + symbol
+}
+
+// FormatDate formats t for locale in the given style. Convert t to the
+// recipient's time zone first with t.In.
+func FormatDate(t time.Time, style DateStyle, locale string) string {
+	return lookupLocale(locale).date(t, style)
+}
+
+// Plural returns the variant of forms matching n under locale's plural rules.
+// A literal "#" in the chosen form is replaced with n formatted for locale.
+func Plural(n int, forms PluralForms, locale string) string {
+	f := lookupLocale(locale)
+
+	var form string
+	if n == 0 && forms.Zero != "" {
+		form = forms.Zero
+	} else {
+		switch f.plural(n) {
+		case "one":
+			form = forms.One
+		case "few":
+			form = forms.Few
+		case "many":
+			form = forms.Many
+		}
+	}
+	if form == "" {
+		form = forms.Other
+	}
+	return strings.ReplaceAll(form, "#", FormatNumber(float64(n), 0, locale))
+}
@@ -0,0 +1,68 @@
+package mail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatNumber(t *testing.T) {
+	assert.Equal(t, "1,234,567.89", FormatNumber(1234567.891, 2, "en-US"))
+	assert.Equal(t, "1.234.567,89", FormatNumber(1234567.891, 2, "de-DE"))
+	assert.Equal(t, "1 234", FormatNumber(1234, 0, "fr"))
+	assert.Equal(t, "-999", FormatNumber(-999, 0, "en"))
+	assert.Equal(t, "12", FormatNumber(12, 0, "xx-unknown"))
+	assert.Equal(t, "0.00", FormatNumber(-0.001, 2, "en"))
+	assert.Equal(t, "0", FormatNumber(-0.4, 0, "en"))
+}
+
+func TestFormatCurrency(t *testing.T) {
+	assert.Equal(t, "$1,234.50", FormatCurrency(1234.5, "USD", "en-US"))
+	assert.Equal(t, "1.234,50"+nbsp+"€", FormatCurrency(1234.5, "eur", "de_DE"))
+	assert.Equal(t, "¥1,235", FormatCurrency(1234.5, "JPY", "ja-JP"))
+	assert.Equal(t, "-£3.00", FormatCurrency(-3, "GBP", "en-GB"))
+	assert.Equal(t, "£0.00", FormatCurrency(-0.001, "GBP", "en-GB"))
+	assert.Equal(t, "CHF"+nbsp+"10.00", FormatCurrency(10, "CHF", "en"))
+}
+
+func TestFormatDate(t *testing.T) {
+	d := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		style  DateStyle
+		want   string
+	}{
+		{"en-US", DateStyleShort, "3/5/2024"},
+		{"en-US", DateStyleLong, "March 5, 2024"},
+		{"en-GB", DateStyleShort, "05/03/2024"},
+		{"de", DateStyleLong, "5. März 2024"},
+		{"fr-FR", DateStyleMedium, "5 mars 2024"},
+		{"fr-FR", DateStyleLong, "5 mars 2024"},
+		{"es", DateStyleLong, "5 de marzo de 2024"},
+		{"ja", DateStyleLong, "2024年3月5日"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, FormatDate(d, tt.style, tt.locale), "%s %s", tt.locale, tt.style)
+	}
+
+	assert.Equal(t, "5 févr. 2024", FormatDate(time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC), DateStyleMedium, "fr"))
+}
+
+func TestPlural(t *testing.T) {
+	forms := PluralForms{One: "# item", Other: "# items"}
+	assert.Equal(t, "1 item", Plural(1, forms, "en"))
+	assert.Equal(t, "2 items", Plural(2, forms, "en"))
+	assert.Equal(t, "0 items", Plural(0, forms, "en"))
+	assert.Equal(t, "0 item", Plural(0, forms, "fr"))
+	assert.Equal(t, "1,500 items", Plural(1500, forms, "en"))
+
+	pl := PluralForms{One: "# plik", Few: "# pliki", Many: "# plików", Other: "# pliku"}
+	assert.Equal(t, "1 plik", Plural(1, pl, "pl"))
+	assert.Equal(t, "3 pliki", Plural(3, pl, "pl"))
+	assert.Equal(t, "12 plików", Plural(12, pl, "pl"))
+	assert.Equal(t, "22 pliki", Plural(22, pl, "pl"))
+
+	assert.Equal(t, "no items", Plural(0, PluralForms{Zero: "no items", Other: "# items"}, "en"))
+}
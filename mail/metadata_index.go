@@ -0,0 +1,26 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// GetMetadataIndex returns the email metadata keys that List can filter on.
+func (c *Client) GetMetadataIndex(ctx context.Context) (*types.MetadataIndex, error) {
+	var resp types.MetadataIndex
+	if err := c.http.Get(ctx, "/mail/metadata-index", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetMetadataIndex replaces the email metadata keys that List can filter on,
+// up to the index's MaxKeys.
+func (c *Client) SetMetadataIndex(ctx context.Context, keys []string) (*types.MetadataIndex, error) {
+	var resp types.MetadataIndex
+	if err := c.http.Put(ctx, "/mail/metadata-index", map[string][]string{"keys": keys}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
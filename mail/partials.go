@@ -0,0 +1,99 @@
+package mail
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// PartialsClient handles layouts and partials: blocks of content shared
+// between templates, so a branding change is made once instead of in every
+// template.
+type PartialsClient struct {
+	http *client.HTTPClient
+}
+
+// NewPartialsClient creates a new partials client.
+func NewPartialsClient(http *client.HTTPClient) *PartialsClient {
+	return &PartialsClient{http: http}
+}
+
+// List lists partials and layouts.
+func (c *PartialsClient) List(ctx context.Context, req *ListPartialsRequest) (*ListPartialsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.Kind != nil {
+			params.Set("kind", string(*req.Kind))
+		}
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+	}
+
+	path := "/mail/partials"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListPartialsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a partial by ID.
+func (c *PartialsClient) Get(ctx context.Context, id string) (*Partial, error) {
+	var resp Partial
+	if err := c.http.Get(ctx, "/mail/partials/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Create creates a partial or layout.
+func (c *PartialsClient) Create(ctx context.Context, req *CreatePartialRequest) (*Partial, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Partial
+	if err := c.http.Post(ctx, "/mail/partials", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update updates a partial.
+func (c *PartialsClient) Update(ctx context.Context, req *UpdatePartialRequest) (*Partial, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Partial
+	if err := c.http.Put(ctx, "/mail/partials/"+req.ID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes a partial. Partials still used by templates cannot be
+// deleted.
+func (c *PartialsClient) Delete(ctx context.Context, id string) (*DeletePartialResponse, error) {
+	var resp DeletePartialResponse
+	if err := c.http.Delete(ctx, "/mail/partials/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
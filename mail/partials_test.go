@@ -0,0 +1,86 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPartialsTestClient(t *testing.T, handler http.HandlerFunc) (*PartialsClient, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewPartialsClient(httpClient), server
+}
+
+func TestPartialsClient_Create(t *testing.T) {
+	partialsClient, server := setupPartialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/partials", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "layout", body["kind"])
+		assert.Equal(t, "branded", body["slug"])
+		assert.Equal(t, "<body>{{> content }}</body>", body["html"])
+
+		json.NewEncoder(w).Encode(Partial{ID: "lay-1", Kind: PartialKindLayout, Slug: "branded"})
+	})
+	defer server.Close()
+
+	resp, err := partialsClient.Create(context.Background(), &CreatePartialRequest{
+		Kind: ptr(PartialKindLayout),
+		Name: "Branded",
+		Slug: "branded",
+		HTML: "<body>{{> content }}</body>",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "lay-1", resp.ID)
+}
+
+func TestPartialsClient_List(t *testing.T) {
+	partialsClient, server := setupPartialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/partials", r.URL.Path)
+		assert.Equal(t, "partial", r.URL.Query().Get("kind"))
+		assert.Equal(t, "20", r.URL.Query().Get("limit"))
+
+		json.NewEncoder(w).Encode(ListPartialsResponse{
+			Partials: []Partial{{ID: "par-1", Slug: "footer", TemplateCount: 50}},
+			Total:    1,
+		})
+	})
+	defer server.Close()
+
+	resp, err := partialsClient.List(context.Background(), &ListPartialsRequest{Kind: ptr(PartialKindPartial), Limit: ptr(20)})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Partials, 1)
+	assert.Equal(t, 50, resp.Partials[0].TemplateCount)
+}
+
+func TestPartialsClient_Update(t *testing.T) {
+	partialsClient, server := setupPartialsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/partials/par-1", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "<p>New footer</p>", body["html"])
+		assert.NotContains(t, body, "ID")
+
+		json.NewEncoder(w).Encode(Partial{ID: "par-1", HTML: "<p>New footer</p>"})
+	})
+	defer server.Close()
+
+	resp, err := partialsClient.Update(context.Background(), &UpdatePartialRequest{ID: "par-1", HTML: ptr("<p>New footer</p>")})
+
+	require.NoError(t, err)
+	assert.Equal(t, "<p>New footer</p>", resp.HTML)
+}
@@ -0,0 +1,128 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
+)
+
+// ProvidersClient handles bring-your-own sending provider operations.
+type ProvidersClient struct {
+	http *client.HTTPClient
+}
+
+// NewProvidersClient creates a new providers client.
+func NewProvidersClient(http *client.HTTPClient) *ProvidersClient {
+	return &ProvidersClient{http: http}
+}
+
+// Create configures a sending provider.
+func (c *ProvidersClient) Create(ctx context.Context, req *CreateProviderRequest) (*Provider, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Provider
+	if err := c.http.Post(ctx, "/mail/providers", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List lists sending providers.
+func (c *ProvidersClient) List(ctx context.Context, req *ListProvidersRequest) (*ListProvidersResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil && req.Environment != nil {
+		params.Set("environment", string(*req.Environment))
+	}
+
+	path := "/mail/providers"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListProvidersResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a sending provider by ID.
+func (c *ProvidersClient) Get(ctx context.Context, id string) (*Provider, error) {
+	var resp Provider
+	if err := c.http.Get(ctx, "/mail/providers/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update updates a sending provider. Credentials left nil are kept.
+func (c *ProvidersClient) Update(ctx context.Context, req *UpdateProviderRequest) (*Provider, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Provider
+	if err := c.http.Put(ctx, "/mail/providers/"+req.ID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes a sending provider and the routes that use it.
+func (c *ProvidersClient) Delete(ctx context.Context, id string) (*DeleteProviderResponse, error) {
+	var resp DeleteProviderResponse
+	if err := c.http.Delete(ctx, "/mail/providers/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Test checks that a provider's credentials are accepted, without sending an
+// email.
+func (c *ProvidersClient) Test(ctx context.Context, id string) (*TestProviderResponse, error) {
+	var resp TestProviderResponse
+	if err := c.http.Post(ctx, "/mail/providers/"+id+"/test", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetRoutes retrieves the routes of an environment.
+func (c *ProvidersClient) GetRoutes(ctx context.Context, environment *types.Environment) (*ProviderRoutesResponse, error) {
+	params := c.http.EnvironmentQuery()
+	if environment != nil {
+		params.Set("environment", string(*environment))
+	}
+
+	path := "/mail/providers/routes"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ProviderRoutesResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetRoutes replaces the routes of an environment. Sends matching no route
+// use the default provider, or the built-in one when there is none.
+func (c *ProvidersClient) SetRoutes(ctx context.Context, req *SetProviderRoutesRequest) (*ProviderRoutesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp ProviderRoutesResponse
+	if err := c.http.Put(ctx, "/mail/providers/routes", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,180 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupProvidersTestClient(t *testing.T, handler http.HandlerFunc) (*ProvidersClient, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewProvidersClient(httpClient), server
+}
+
+func TestProvidersClient_Create(t *testing.T) {
+	providersClient, server := setupProvidersTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/providers", r.URL.Path)
+
+		var req CreateProviderRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "production", string(*req.Environment))
+		assert.Equal(t, ProviderSES, req.Type)
+		assert.Equal(t, "AKIA123", req.SES.AccessKeyID)
+		assert.Equal(t, "secret", req.SES.SecretAccessKey)
+		assert.Equal(t, "eu-west-1", req.SES.Region)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Provider{
+			ID:        "prov-123",
+			Name:      req.Name,
+			Type:      ProviderSES,
+			SES:       &SESCredentials{AccessKeyID: "AKIA123", Region: "eu-west-1"},
+			IsDefault: true,
+			Enabled:   true,
+		})
+	})
+	defer server.Close()
+
+	env := types.EnvironmentProduction
+	resp, err := providersClient.Create(context.Background(), &CreateProviderRequest{
+		Environment: &env,
+		Name:        "Our SES",
+		Type:        ProviderSES,
+		SES: &SESCredentials{
+			AccessKeyID:     "AKIA123",
+			SecretAccessKey: "secret",
+			Region:          "eu-west-1",
+		},
+		IsDefault: ptr(true),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "prov-123", resp.ID)
+	assert.Empty(t, resp.SES.SecretAccessKey)
+	assert.True(t, resp.IsDefault)
+}
+
+func TestProvidersClient_List(t *testing.T) {
+	providersClient, server := setupProvidersTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/providers", r.URL.Path)
+		assert.Equal(t, "sandbox", r.URL.Query().Get("environment"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListProvidersResponse{
+			Providers: []Provider{
+				{ID: "prov-1", Type: ProviderPostmark, APIKeyLast4: ptr("abcd")},
+				{ID: "prov-2", Type: ProviderSMTP, SMTP: &SMTPServer{Host: "smtp.example.com", Port: 587}},
+			},
+		})
+	})
+	defer server.Close()
+
+	env := types.EnvironmentSandbox
+	resp, err := providersClient.List(context.Background(), &ListProvidersRequest{Environment: &env})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Providers, 2)
+	assert.Equal(t, "abcd", *resp.Providers[0].APIKeyLast4)
+	assert.Equal(t, 587, resp.Providers[1].SMTP.Port)
+}
+
+func TestProvidersClient_Update(t *testing.T) {
+	providersClient, server := setupProvidersTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/providers/prov-123", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "pm_new_key", body["apiKey"])
+		assert.NotContains(t, body, "ID")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Provider{ID: "prov-123", APIKeyLast4: ptr("_key")})
+	})
+	defer server.Close()
+
+	resp, err := providersClient.Update(context.Background(), &UpdateProviderRequest{
+		ID:     "prov-123",
+		APIKey: ptr("pm_new_key"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "_key", *resp.APIKeyLast4)
+}
+
+func TestProvidersClient_Delete(t *testing.T) {
+	providersClient, server := setupProvidersTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/mail/providers/prov-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DeleteProviderResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := providersClient.Delete(context.Background(), "prov-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestProvidersClient_Test(t *testing.T) {
+	providersClient, server := setupProvidersTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/providers/prov-123/test", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(TestProviderResponse{Success: false, Error: ptr("535 Authentication failed")})
+	})
+	defer server.Close()
+
+	resp, err := providersClient.Test(context.Background(), "prov-123")
+
+	require.NoError(t, err)
+	assert.False(t, resp.Success)
+	assert.Equal(t, "535 Authentication failed", *resp.Error)
+}
+
+func TestProvidersClient_Routes(t *testing.T) {
+	routes := []ProviderRoute{
+		{ProviderID: "prov-1", Tag: ptr("transactional")},
+		{ProviderID: "prov-2", Domain: ptr("news.example.com")},
+	}
+	providersClient, server := setupProvidersTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/providers/routes", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPut:
+			var req SetProviderRoutesRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, routes, req.Routes)
+		case http.MethodGet:
+			assert.Equal(t, "production", r.URL.Query().Get("environment"))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ProviderRoutesResponse{Routes: routes})
+	})
+	defer server.Close()
+
+	set, err := providersClient.SetRoutes(context.Background(), &SetProviderRoutesRequest{Routes: routes})
+	require.NoError(t, err)
+	assert.Len(t, set.Routes, 2)
+
+	env := types.EnvironmentProduction
+	got, err := providersClient.GetRoutes(context.Background(), &env)
+	require.NoError(t, err)
+	assert.Equal(t, "news.example.com", *got.Routes[1].Domain)
+}
@@ -0,0 +1,405 @@
+package mail
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrMissingVariables is wrapped by the error RenderLocal returns for
+// variables without a value when rendering with MissingVariableError, the
+// local counterpart of ErrCodeMissingVariables.
+var ErrMissingVariables = errors.New("missing template variables")
+
+// RenderLocalOptions configure RenderLocal like the matching fields of
+// PreviewTemplateRequest. MissingVariables defaults to MissingVariableEmpty.
+// Partials holds the partials and layouts the template uses, as returned by
+// PartialsClient.List; it is only needed for templates that include them.
+type RenderLocalOptions struct {
+	Locale           string
+	Timezone         string
+	MissingVariables MissingVariableMode
+	Partials         []Partial
+}
+
+var (
+	placeholderPattern = regexp.MustCompile(`\{\{\s*(.*?)\s*\}\}`)
+	includePattern     = regexp.MustCompile(`\{\{>\s*([\w.-]+)\s*\}\}`)
+)
+
+// maxIncludeDepth bounds nested includes, so a partial that includes itself
+// is reported instead of expanding forever.
+const maxIncludeDepth = 10
+
+// RenderLocal renders a template's subject, HTML and text with variables
+// without calling the API, using the same interpolation as Preview:
+// "{{ name }}" placeholders, dotted paths such as "{{ contact.firstName }}"
+// into nested maps, and the currency, date, number and plural filters
+// described on PreviewTemplateRequest. Variables without a value take the
+// "default" of their property in the template's VariablesSchema. Values are
+// HTML-escaped in the HTML body. The template's layout and "{{> slug }}"
+// includes are resolved from opts.Partials first, so partials can use
+// variables too.
+//
+// RenderLocal is meant for unit tests and previews; the server remains the
+// source of truth for sent emails.
+func (c *TemplatesClient) RenderLocal(tmpl *Template, variables map[string]interface{}, opts *RenderLocalOptions) (*PreviewTemplateResponse, error) {
+	r := &localRenderer{
+		variables: variables,
+		defaults:  schemaDefaults(tmpl.VariablesSchema),
+		missing:   map[string]bool{},
+		mode:      MissingVariableEmpty,
+		location:  time.UTC,
+	}
+	var partials []Partial
+	if opts != nil {
+		partials = opts.Partials
+		r.locale = opts.Locale
+		if opts.MissingVariables != "" {
+			r.mode = opts.MissingVariables
+		}
+		if opts.Timezone != "" {
+			loc, err := time.LoadLocation(opts.Timezone)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timezone %q: %w", opts.Timezone, err)
+			}
+			r.location = loc
+		}
+	}
+
+	body, text, err := resolveIncludes(tmpl, partials)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &PreviewTemplateResponse{
+		Subject: r.render(tmpl.Subject, false),
+		HTML:    r.render(body, true),
+	}
+	if text != nil {
+		rendered := r.render(*text, false)
+		resp.Text = &rendered
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	for name := range r.missing {
+		resp.MissingVariables = append(resp.MissingVariables, name)
+	}
+	sort.Strings(resp.MissingVariables)
+	if len(resp.MissingVariables) > 0 && r.mode == MissingVariableError {
+		return nil, fmt.Errorf("%w: %s", ErrMissingVariables, strings.Join(resp.MissingVariables, ", "))
+	}
+	return resp, nil
+}
+
+// resolveIncludes returns a template's HTML and text wrapped in its layout,
+// at the layout's "{{> content }}", with "{{> slug }}" includes expanded.
+func resolveIncludes(tmpl *Template, partials []Partial) (string, *string, error) {
+	bySlug := make(map[string]*Partial, len(partials))
+	var layout *Partial
+	for i := range partials {
+		p := &partials[i]
+		bySlug[p.Slug] = p
+		if tmpl.LayoutID != nil && p.ID == *tmpl.LayoutID {
+			layout = p
+		}
+	}
+
+	body, text := tmpl.HTML, tmpl.Text
+	if tmpl.LayoutID != nil {
+		if layout == nil {
+			return "", nil, fmt.Errorf("layout %q is not in RenderLocalOptions.Partials", *tmpl.LayoutID)
+		}
+		body = wrapInLayout(layout.HTML, body)
+		if text != nil && layout.Text != nil {
+			wrapped := wrapInLayout(*layout.Text, *text)
+			text = &wrapped
+		}
+	}
+
+	body, err := expandIncludes(body, bySlug, false, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	if text != nil {
+		expanded, err := expandIncludes(*text, bySlug, true, 0)
+		if err != nil {
+			return "", nil, err
+		}
+		text = &expanded
+	}
+	return body, text, nil
+}
+
+func wrapInLayout(layout, content string) string {
+	return includePattern.ReplaceAllStringFunc(layout, func(include string) string {
+		if includePattern.FindStringSubmatch(include)[1] != "content" {
+			return include
+		}
+		return content
+	})
+}
+
+// expandIncludes replaces each "{{> slug }}" in s with the partial's HTML, or
+// its text when text is true, expanding includes within it in turn.
+func expandIncludes(s string, bySlug map[string]*Partial, text bool, depth int) (string, error) {
+	var err error
+	out := includePattern.ReplaceAllStringFunc(s, func(include string) string {
+		if err != nil {
+			return ""
+		}
+		slug := includePattern.FindStringSubmatch(include)[1]
+		p, ok := bySlug[slug]
+		if !ok {
+			err = fmt.Errorf("partial %q is not in RenderLocalOptions.Partials", slug)
+			return ""
+		}
+		if depth >= maxIncludeDepth {
+			err = fmt.Errorf("partial %q: includes nested more than %d deep", slug, maxIncludeDepth)
+			return ""
+		}
+		content := p.HTML
+		if text {
+			content = ""
+			if p.Text != nil {
+				content = *p.Text
+			}
+		}
+		var expanded string
+		expanded, err = expandIncludes(content, bySlug, text, depth+1)
+		return expanded
+	})
+	return out, err
+}
+
+type localRenderer struct {
+	variables map[string]interface{}
+	defaults  map[string]interface{}
+	missing   map[string]bool
+	mode      MissingVariableMode
+	locale    string
+	location  *time.Location
+	err       error
+}
+
+func (r *localRenderer) render(s string, escape bool) string {
+	return placeholderPattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+		expr := placeholderPattern.FindStringSubmatch(placeholder)[1]
+		parts := splitOutsideQuotes(expr, '|')
+		name := strings.TrimSpace(parts[0])
+
+		value, ok := lookupPath(r.variables, name)
+		if !ok {
+			value, ok = lookupPath(r.defaults, name)
+		}
+		if !ok {
+			r.missing[name] = true
+			if r.mode == MissingVariableKeep {
+				return placeholder
+			}
+			return ""
+		}
+
+		out := formatTemplateValue(value)
+		for _, filter := range parts[1:] {
+			formatted, err := r.applyFilter(value, filter)
+			if err != nil {
+				if r.err == nil {
+					r.err = fmt.Errorf("%s: %w", strings.TrimSpace(placeholder), err)
+				}
+				return ""
+			}
+			out = formatted
+			value = formatted
+		}
+		if escape {
+			out = html.EscapeString(out)
+		}
+		return out
+	})
+}
+
+func (r *localRenderer) applyFilter(value interface{}, filter string) (string, error) {
+	name, rawArgs, _ := strings.Cut(filter, ":")
+	name = strings.TrimSpace(name)
+	var args []string
+	if strings.TrimSpace(rawArgs) != "" {
+		for _, arg := range splitOutsideQuotes(rawArgs, ',') {
+			arg = strings.TrimSpace(arg)
+			if unquoted, err := strconv.Unquote(arg); err == nil {
+				arg = unquoted
+			}
+			args = append(args, arg)
+		}
+	}
+
+	switch name {
+	case "currency":
+		amount, ok := toFloat(value)
+		if !ok || len(args) != 1 {
+			return "", fmt.Errorf("currency needs a number and a currency code")
+		}
+		return FormatCurrency(amount, args[0], r.locale), nil
+	case "number":
+		n, ok := toFloat(value)
+		if !ok {
+			return "", fmt.Errorf("number needs a number")
+		}
+		decimals := 0
+		if len(args) > 0 {
+			d, err := strconv.Atoi(args[0])
+			if err != nil {
+				return "", fmt.Errorf("number: invalid decimals %q", args[0])
+			}
+			decimals = d
+		}
+		return FormatNumber(n, decimals, r.locale), nil
+	case "date":
+		t, ok := toTime(value)
+		if !ok {
+			return "", fmt.Errorf("date needs a time")
+		}
+		style := DateStyleMedium
+		if len(args) > 0 {
+			style = DateStyle(args[0])
+		}
+		return FormatDate(t.In(r.location), style, r.locale), nil
+	case "plural":
+		n, ok := toFloat(value)
+		if !ok || len(args) != 2 {
+			return "", fmt.Errorf("plural needs a number and two forms")
+		}
+		return Plural(int(n), PluralForms{One: args[0], Other: args[1]}, r.locale), nil
+	default:
+		return "", fmt.Errorf("unknown filter %q", name)
+	}
+}
+
+// splitOutsideQuotes splits s at sep, ignoring separators inside double
+// quotes.
+func splitOutsideQuotes(s string, sep byte) []string {
+	var parts []string
+	inQuotes := false
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && inQuotes:
+			i++
+		case s[i] == '"':
+			inQuotes = !inQuotes
+		case s[i] == sep && !inQuotes:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// lookupPath resolves a dotted path such as "contact.firstName" in nested
+// maps.
+func lookupPath(values map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = values
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return current, current != nil
+}
+
+// schemaDefaults collects the default values of a variables schema, either a
+// JSON Schema object with "properties" or a map of variable names to property
+// definitions, into nested maps keyed like the variables.
+func schemaDefaults(schema map[string]interface{}) map[string]interface{} {
+	props := schema
+	if p, ok := schema["properties"].(map[string]interface{}); ok {
+		props = p
+	}
+
+	defaults := map[string]interface{}{}
+	for name, def := range props {
+		prop, ok := def.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if value, ok := prop["default"]; ok {
+			defaults[name] = value
+		} else if nested, ok := prop["properties"].(map[string]interface{}); ok {
+			if d := schemaDefaults(map[string]interface{}{"properties": nested}); len(d) > 0 {
+				defaults[name] = d
+			}
+		}
+	}
+	return defaults
+}
+
+func formatTemplateValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case map[string]interface{}, []interface{}:
+		b, _ := json.Marshal(v)
+		return string(b)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func toTime(v interface{}) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return *v, true
+	case string:
+		for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
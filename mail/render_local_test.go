@@ -0,0 +1,136 @@
+package mail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplatesClient_RenderLocal(t *testing.T) {
+	templatesClient := NewTemplatesClient(nil)
+	tmpl := &Template{
+		Subject: "Order for {{ contact.firstName }}",
+		HTML:    "<p>Hi {{contact.firstName}}, total {{ total | currency: \"EUR\" }} for {{ itemCount | plural: \"# item\", \"# items\" }}</p><p>{{ note }}</p>",
+		Text:    ptr("Placed {{ orderDate | date: \"long\" }} by {{ contact.firstName }}"),
+		VariablesSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"contact": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"firstName": map[string]interface{}{"type": "string", "default": "there"},
+					},
+				},
+			},
+		},
+	}
+
+	resp, err := templatesClient.RenderLocal(tmpl, map[string]interface{}{
+		"total":     1234.5,
+		"itemCount": 3,
+		"orderDate": "2024-03-05T23:30:00Z",
+		"note":      "Tom & Jerry <3",
+	}, &RenderLocalOptions{Locale: "de-DE", Timezone: "Europe/Berlin"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Order for there", resp.Subject)
+	assert.Equal(t, "<p>Hi there, total 1.234,50 € for 3 items</p><p>Tom &amp; Jerry &lt;3</p>", resp.HTML)
+	assert.Equal(t, "Placed 6. März 2024 by there", *resp.Text)
+	assert.Empty(t, resp.MissingVariables)
+}
+
+func TestTemplatesClient_RenderLocal_MissingVariables(t *testing.T) {
+	templatesClient := NewTemplatesClient(nil)
+	tmpl := &Template{Subject: "Hi {{ name }}", HTML: "<p>{{ name }} {{ code }}</p>"}
+
+	t.Run("empty by default", func(t *testing.T) {
+		resp, err := templatesClient.RenderLocal(tmpl, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Hi ", resp.Subject)
+		assert.Nil(t, resp.Text)
+		assert.Equal(t, []string{"code", "name"}, resp.MissingVariables)
+	})
+
+	t.Run("keep", func(t *testing.T) {
+		resp, err := templatesClient.RenderLocal(tmpl, map[string]interface{}{"name": "Ada"}, &RenderLocalOptions{MissingVariables: MissingVariableKeep})
+		require.NoError(t, err)
+		assert.Equal(t, "<p>Ada {{ code }}</p>", resp.HTML)
+		assert.Equal(t, []string{"code"}, resp.MissingVariables)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := templatesClient.RenderLocal(tmpl, nil, &RenderLocalOptions{MissingVariables: MissingVariableError})
+		require.ErrorIs(t, err, ErrMissingVariables)
+		assert.Contains(t, err.Error(), "code, name")
+	})
+}
+
+func TestTemplatesClient_RenderLocal_InvalidFilter(t *testing.T) {
+	templatesClient := NewTemplatesClient(nil)
+
+	_, err := templatesClient.RenderLocal(&Template{Subject: "{{ count | number: 0 }}", HTML: "{{ count | shout }}"},
+		map[string]interface{}{"count": 12345}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown filter "shout"`)
+}
+
+func TestTemplatesClient_RenderLocal_NilDate(t *testing.T) {
+	templatesClient := NewTemplatesClient(nil)
+	var shippedAt *time.Time
+
+	_, err := templatesClient.RenderLocal(&Template{Subject: "Shipped", HTML: "{{ shippedAt | date }}"},
+		map[string]interface{}{"shippedAt": shippedAt}, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "date needs a time")
+}
+
+func TestTemplatesClient_RenderLocal_Partials(t *testing.T) {
+	templatesClient := NewTemplatesClient(nil)
+	partials := []Partial{
+		{ID: "lay-1", Kind: PartialKindLayout, Slug: "branded", HTML: "<body>{{> header }}{{> content }}{{> footer }}</body>", Text: ptr("{{> content }}\n--\n{{> footer }}")},
+		{ID: "par-1", Slug: "header", HTML: "<h1>Acme</h1>"},
+		{ID: "par-2", Slug: "footer", HTML: "<p>Sent to {{ email }}</p>", Text: ptr("Sent to {{ email }}")},
+	}
+	tmpl := &Template{
+		Subject:  "Hi",
+		HTML:     "<p>Hi {{ name }}</p>",
+		Text:     ptr("Hi {{ name }}"),
+		LayoutID: ptr("lay-1"),
+	}
+
+	resp, err := templatesClient.RenderLocal(tmpl, map[string]interface{}{"name": "Ada", "email": "ada@example.com"},
+		&RenderLocalOptions{Partials: partials})
+
+	require.NoError(t, err)
+	assert.Equal(t, "<body><h1>Acme</h1><p>Hi Ada</p><p>Sent to ada@example.com</p></body>", resp.HTML)
+	assert.Equal(t, "Hi Ada\n--\nSent to ada@example.com", *resp.Text)
+
+	t.Run("missing layout", func(t *testing.T) {
+		_, err := templatesClient.RenderLocal(tmpl, nil, nil)
+		assert.EqualError(t, err, `layout "lay-1" is not in RenderLocalOptions.Partials`)
+	})
+
+	t.Run("missing partial", func(t *testing.T) {
+		_, err := templatesClient.RenderLocal(&Template{HTML: "{{> signature }}"}, nil, &RenderLocalOptions{Partials: partials})
+		assert.EqualError(t, err, `partial "signature" is not in RenderLocalOptions.Partials`)
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		loop := []Partial{{Slug: "loop", HTML: "x{{> loop }}"}}
+		_, err := templatesClient.RenderLocal(&Template{HTML: "{{> loop }}"}, nil, &RenderLocalOptions{Partials: loop})
+		assert.ErrorContains(t, err, "nested more than 10 deep")
+	})
+}
+
+func TestSchemaDefaults(t *testing.T) {
+	// A flat map of variable definitions is accepted as well as JSON Schema.
+	defaults := schemaDefaults(map[string]interface{}{
+		"plan":  map[string]interface{}{"default": "Free"},
+		"seats": map[string]interface{}{"type": "number"},
+	})
+	assert.Equal(t, map[string]interface{}{"plan": "Free"}, defaults)
+}
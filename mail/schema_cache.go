@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"sync"
+	"time"
+)
+
+// schemaCacheTTL is how long a fetched schema is reused before it is fetched
+// again, so that schema changes made elsewhere are picked up.
+const schemaCacheTTL = 5 * time.Minute
+
+// schemaCache caches schemas by key for schemaCacheTTL. The zero value is
+// ready to use.
+type schemaCache[V any] struct {
+	entries sync.Map // key -> schemaCacheEntry[V]
+}
+
+type schemaCacheEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func (c *schemaCache[V]) load(key string) (V, bool) {
+	if e, ok := c.entries.Load(key); ok {
+		entry := e.(schemaCacheEntry[V])
+		if time.Now().Before(entry.expires) {
+			return entry.value, true
+		}
+		c.entries.CompareAndDelete(key, e)
+	}
+	var zero V
+	return zero, false
+}
+
+func (c *schemaCache[V]) store(key string, value V) {
+	c.entries.Store(key, schemaCacheEntry[V]{value: value, expires: time.Now().Add(schemaCacheTTL)})
+}
+
+func (c *schemaCache[V]) forget(key string) {
+	c.entries.Delete(key)
+}
@@ -0,0 +1,138 @@
+package mail
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// SegmentsClient handles segment operations. Segments are saved filters over
+// contacts; unlike audiences, their membership is not managed directly.
+type SegmentsClient struct {
+	http *client.HTTPClient
+}
+
+// NewSegmentsClient creates a new segments client.
+func NewSegmentsClient(http *client.HTTPClient) *SegmentsClient {
+	return &SegmentsClient{http: http}
+}
+
+// List lists all segments.
+func (c *SegmentsClient) List(ctx context.Context, req *ListSegmentsRequest) (*ListSegmentsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+		if req.Search != nil {
+			params.Set("search", *req.Search)
+		}
+	}
+
+	path := "/mail/segments"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListSegmentsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a segment by ID.
+func (c *SegmentsClient) Get(ctx context.Context, id string) (*Segment, error) {
+	var resp Segment
+	if err := c.http.Get(ctx, "/mail/segments/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Create creates a new segment.
+func (c *SegmentsClient) Create(ctx context.Context, req *CreateSegmentRequest) (*Segment, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Segment
+	if err := c.http.Post(ctx, "/mail/segments", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update updates a segment.
+func (c *SegmentsClient) Update(ctx context.Context, req *UpdateSegmentRequest) (*Segment, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp Segment
+	if err := c.http.Put(ctx, "/mail/segments/"+req.ID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes a segment.
+func (c *SegmentsClient) Delete(ctx context.Context, id string) (*DeleteSegmentResponse, error) {
+	var resp DeleteSegmentResponse
+	if err := c.http.Delete(ctx, "/mail/segments/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Preview evaluates a filter without saving it, returning the match count
+// and a sample of matching contacts.
+func (c *SegmentsClient) Preview(ctx context.Context, req *PreviewSegmentRequest) (*PreviewSegmentResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp PreviewSegmentResponse
+	if err := c.http.Post(ctx, "/mail/segments/preview", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListContacts lists contacts currently matching a segment.
+func (c *SegmentsClient) ListContacts(ctx context.Context, req *ListSegmentContactsRequest) (*ListContactsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req.Limit != nil {
+		params.Set("limit", strconv.Itoa(*req.Limit))
+	}
+	if req.Offset != nil {
+		params.Set("offset", strconv.Itoa(*req.Offset))
+	}
+
+	path := "/mail/segments/" + req.ID + "/contacts"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListContactsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,173 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSegmentsTestClient(t *testing.T, handler http.HandlerFunc) (*SegmentsClient, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewSegmentsClient(httpClient), server
+}
+
+func TestSegmentsClient_Create(t *testing.T) {
+	segmentsClient, server := setupSegmentsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/segments", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "Engaged pro users", body["name"])
+		filter := body["filter"].(map[string]interface{})
+		assert.Equal(t, "all", filter["match"])
+		conditions := filter["conditions"].([]interface{})
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "metadata", conditions[0].(map[string]interface{})["source"])
+		groups := filter["groups"].([]interface{})
+		require.Len(t, groups, 1)
+		assert.Equal(t, "any", groups[0].(map[string]interface{})["match"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Segment{ID: "seg-123", Name: "Engaged pro users", ContactCount: ptr(42)})
+	})
+	defer server.Close()
+
+	resp, err := segmentsClient.Create(context.Background(), &CreateSegmentRequest{
+		Name: "Engaged pro users",
+		Filter: SegmentFilter{
+			Match: SegmentMatchAll,
+			Conditions: []SegmentCondition{
+				{Source: SegmentSourceMetadata, Field: "plan", Operator: SegmentOpEquals, Value: "pro"},
+			},
+			Groups: []SegmentFilter{{
+				Match: SegmentMatchAny,
+				Conditions: []SegmentCondition{
+					{Source: SegmentSourceEngagement, Field: "lastOpenedAt", Operator: SegmentOpExists, WithinDays: ptr(30)},
+					{Source: SegmentSourceEvent, Field: "purchase_completed", Operator: SegmentOpPerformed, WithinDays: ptr(30)},
+				},
+			}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "seg-123", resp.ID)
+	assert.Equal(t, 42, *resp.ContactCount)
+}
+
+func TestSegmentsClient_Update(t *testing.T) {
+	segmentsClient, server := setupSegmentsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/segments/seg-123", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", body["name"])
+		assert.NotContains(t, body, "filter")
+		assert.NotContains(t, body, "id")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Segment{ID: "seg-123", Name: "Renamed"})
+	})
+	defer server.Close()
+
+	resp, err := segmentsClient.Update(context.Background(), &UpdateSegmentRequest{ID: "seg-123", Name: ptr("Renamed")})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", resp.Name)
+}
+
+func TestSegmentsClient_List(t *testing.T) {
+	segmentsClient, server := setupSegmentsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/segments", r.URL.Path)
+		assert.Equal(t, "pro", r.URL.Query().Get("search"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListSegmentsResponse{Segments: []Segment{{ID: "seg-123"}}, Total: 1})
+	})
+	defer server.Close()
+
+	resp, err := segmentsClient.List(context.Background(), &ListSegmentsRequest{Search: ptr("pro")})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Segments, 1)
+}
+
+func TestSegmentsClient_Delete(t *testing.T) {
+	segmentsClient, server := setupSegmentsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/mail/segments/seg-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DeleteSegmentResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := segmentsClient.Delete(context.Background(), "seg-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestSegmentsClient_Preview(t *testing.T) {
+	segmentsClient, server := setupSegmentsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/segments/preview", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, float64(5), body["limit"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PreviewSegmentResponse{
+			Count:    120,
+			Contacts: []MailContact{{ID: "contact-1", Email: "a@example.com"}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := segmentsClient.Preview(context.Background(), &PreviewSegmentRequest{
+		Filter: SegmentFilter{
+			Match: SegmentMatchAll,
+			Conditions: []SegmentCondition{
+				{Source: SegmentSourceEvent, Field: "cart_abandoned", Operator: SegmentOpPerformed, MinCount: ptr(2)},
+			},
+		},
+		Limit: ptr(5),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 120, resp.Count)
+	assert.Len(t, resp.Contacts, 1)
+}
+
+func TestSegmentsClient_ListContacts(t *testing.T) {
+	segmentsClient, server := setupSegmentsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/segments/seg-123/contacts", r.URL.Path)
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListContactsResponse{
+			Contacts: []MailContact{{ID: "contact-1"}, {ID: "contact-2"}},
+			Total:    2,
+		})
+	})
+	defer server.Close()
+
+	resp, err := segmentsClient.ListContacts(context.Background(), &ListSegmentContactsRequest{ID: "seg-123", Limit: ptr(50)})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Contacts, 2)
+}
@@ -0,0 +1,201 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SendWithTemplate sends an email rendered from a template, with the
+// template variables given as a struct, or any other value that encodes to a
+// JSON object, instead of a map. req holds the rest of the email, such as
+// From and To; its TemplateID and TemplateVariables are set from templateID
+// and vars.
+//
+// When the template has a variables schema, SendWithTemplate checks vars
+// against it with CheckTemplateVariables before sending, so missing required
+// variables and misspelled names fail fast instead of rendering as blanks.
+// The schema is fetched the first time a template is used and cached on
+// c.Templates for five minutes, or until the template is updated or deleted
+// through c.Templates.
+func SendWithTemplate[T any](ctx context.Context, c *Client, templateID string, vars T, req *SendEmailRequest) (*SendEmailResponse, error) {
+	b, err := json.Marshal(vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode template variables: %w", err)
+	}
+	var variables map[string]interface{}
+	if err := json.Unmarshal(b, &variables); err != nil {
+		return nil, fmt.Errorf("template variables must encode to a JSON object: %w", err)
+	}
+
+	schema, err := c.Templates.variablesSchema(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckTemplateVariables(schema, variables); err != nil {
+		return nil, fmt.Errorf("template %q: %w", templateID, err)
+	}
+
+	send := SendEmailRequest{}
+	if req != nil {
+		send = *req
+	}
+	send.TemplateID = &templateID
+	send.TemplateVariables = variables
+	return c.Send(ctx, &send)
+}
+
+// variablesSchema returns the variables schema of a template, which is nil if
+// the template has none.
+func (c *TemplatesClient) variablesSchema(ctx context.Context, templateID string) (map[string]interface{}, error) {
+	if cached, ok := c.schemas.load(templateID); ok {
+		return cached, nil
+	}
+
+	tmpl, err := c.Get(ctx, templateID, TemplateFieldVariablesSchema)
+	if err != nil {
+		return nil, err
+	}
+	c.schemas.store(templateID, tmpl.VariablesSchema)
+	return tmpl.VariablesSchema, nil
+}
+
+// CheckTemplateVariables checks variables against a template's JSON Schema
+// variables schema: every required property must have a non-null value,
+// values must match the property's type, and every variable must be a
+// property of the schema, unless the schema sets additionalProperties. Nested
+// objects with their own properties are checked the same way. A nil or empty
+// schema accepts any variables.
+func CheckTemplateVariables(schema map[string]interface{}, variables map[string]interface{}) error {
+	var problems []string
+	checkSchemaObject(schema, variables, "", &problems)
+	if len(problems) > 0 {
+		return fmt.Errorf("variables do not match schema: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func checkSchemaObject(schema map[string]interface{}, values map[string]interface{}, prefix string, problems *[]string) {
+	props, _ := schema["properties"].(map[string]interface{})
+	if len(props) == 0 {
+		return
+	}
+
+	if required, ok := schema["required"].([]interface{}); ok {
+		for _, r := range required {
+			name, _ := r.(string)
+			if v, ok := values[name]; !ok || v == nil {
+				*problems = append(*problems, fmt.Sprintf("missing required variable %q", prefix+name))
+			}
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := values[name]
+		prop, ok := props[name].(map[string]interface{})
+		if !ok {
+			if additional, ok := schema["additionalProperties"]; ok && additional != false {
+				continue
+			}
+			problem := fmt.Sprintf("unknown variable %q", prefix+name)
+			if suggestion := closestName(name, props); suggestion != "" {
+				problem += fmt.Sprintf(" (did you mean %q?)", prefix+suggestion)
+			}
+			*problems = append(*problems, problem)
+			continue
+		}
+		if value == nil {
+			continue
+		}
+		if !schemaTypeMatches(prop["type"], value) {
+			*problems = append(*problems, fmt.Sprintf("variable %q is %s, not %v", prefix+name, jsonTypeName(value), prop["type"]))
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			checkSchemaObject(prop, nested, prefix+name+".", problems)
+		}
+	}
+}
+
+// schemaTypeMatches reports whether a decoded JSON value has a JSON Schema
+// type, given as a name or a list of names. A missing type matches anything.
+func schemaTypeMatches(schemaType interface{}, value interface{}) bool {
+	switch t := schemaType.(type) {
+	case string:
+		actual := jsonTypeName(value)
+		if t == "integer" {
+			f, ok := value.(float64)
+			return ok && f == float64(int64(f))
+		}
+		return actual == t
+	case []interface{}:
+		for _, each := range t {
+			if schemaTypeMatches(each, value) {
+				return true
+			}
+		}
+		return false
+	default:
+		return true
+	}
+}
+
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// closestName returns the property name within edit distance 2 of name, if
+// any, to point out likely typos.
+func closestName(name string, props map[string]interface{}) string {
+	best, bestDistance := "", 3
+	for prop := range props {
+		d := editDistance(strings.ToLower(name), strings.ToLower(prop))
+		if d < bestDistance || (d == bestDistance && prop < best) {
+			best, bestDistance = prop, d
+		}
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
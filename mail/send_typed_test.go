@@ -0,0 +1,154 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type orderVars struct {
+	FirstName string  `json:"firstName"`
+	Total     float64 `json:"total"`
+	Items     int     `json:"items"`
+	Address   struct {
+		City string `json:"city"`
+	} `json:"address"`
+}
+
+func orderSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"firstName", "total"},
+		"properties": map[string]interface{}{
+			"firstName": map[string]interface{}{"type": "string"},
+			"total":     map[string]interface{}{"type": "number"},
+			"items":     map[string]interface{}{"type": "integer"},
+			"coupon":    map[string]interface{}{"type": []interface{}{"string", "null"}},
+			"address": map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"city": map[string]interface{}{"type": "string"}},
+			},
+		},
+	}
+}
+
+func TestSendWithTemplate(t *testing.T) {
+	var gets int32
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /mail/templates/tpl-123":
+			atomic.AddInt32(&gets, 1)
+			assert.Equal(t, "variablesSchema", r.URL.Query().Get("fields"))
+			json.NewEncoder(w).Encode(Template{ID: "tpl-123", VariablesSchema: orderSchema()})
+		case "PUT /mail/templates/tpl-123":
+			json.NewEncoder(w).Encode(Template{ID: "tpl-123"})
+		case "POST /mail/send":
+			var req SendEmailRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "tpl-123", *req.TemplateID)
+			assert.Equal(t, "user@example.com", req.To)
+			assert.Equal(t, "Ada", req.TemplateVariables["firstName"])
+			json.NewEncoder(w).Encode(SendEmailResponse{ID: "email-1"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	vars := orderVars{FirstName: "Ada", Total: 42.5, Items: 2}
+	vars.Address.City = "London"
+	send := func() {
+		resp, err := SendWithTemplate(context.Background(), mailClient, "tpl-123", vars, &SendEmailRequest{
+			From: "shop@example.com",
+			To:   "user@example.com",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "email-1", resp.ID)
+	}
+	send()
+	send()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&gets))
+
+	// Updating the template drops its cached schema.
+	_, err := mailClient.Templates.Update(context.Background(), &UpdateTemplateRequest{ID: "tpl-123"})
+	require.NoError(t, err)
+	send()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+}
+
+func TestSchemaCache_Expires(t *testing.T) {
+	var cache schemaCache[string]
+	cache.store("a", "schema")
+	v, ok := cache.load("a")
+	assert.True(t, ok)
+	assert.Equal(t, "schema", v)
+
+	cache.entries.Store("a", schemaCacheEntry[string]{value: "schema", expires: time.Now().Add(-time.Second)})
+	_, ok = cache.load("a")
+	assert.False(t, ok)
+}
+
+func TestSendWithTemplate_Invalid(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			t.Fatal("email sent despite invalid variables")
+		}
+		json.NewEncoder(w).Encode(Template{ID: "tpl-123", VariablesSchema: orderSchema()})
+	})
+	defer server.Close()
+
+	_, err := SendWithTemplate(context.Background(), mailClient, "tpl-123", map[string]interface{}{
+		"fristName": "Ada",
+		"total":     42.5,
+	}, &SendEmailRequest{From: "shop@example.com", To: "user@example.com"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `missing required variable "firstName"`)
+	assert.Contains(t, err.Error(), `unknown variable "fristName" (did you mean "firstName"?)`)
+}
+
+func TestCheckTemplateVariables(t *testing.T) {
+	tests := []struct {
+		name      string
+		variables map[string]interface{}
+		err       string
+	}{
+		{name: "valid", variables: map[string]interface{}{"firstName": "Ada", "total": 1.0, "items": 3.0, "coupon": nil}},
+		{name: "wrong type", variables: map[string]interface{}{"firstName": "Ada", "total": "1"}, err: `variable "total" is string, not number`},
+		{name: "not an integer", variables: map[string]interface{}{"firstName": "Ada", "total": 1.0, "items": 1.5}, err: `variable "items" is number, not integer`},
+		{name: "null required", variables: map[string]interface{}{"firstName": nil, "total": 1.0}, err: `missing required variable "firstName"`},
+		{
+			name:      "nested",
+			variables: map[string]interface{}{"firstName": "Ada", "total": 1.0, "address": map[string]interface{}{"ctiy": "London"}},
+			err:       `unknown variable "address.ctiy" (did you mean "address.city"?)`,
+		},
+		{name: "no suggestion", variables: map[string]interface{}{"firstName": "Ada", "total": 1.0, "shippingMethod": "air"}, err: `unknown variable "shippingMethod"; `},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckTemplateVariables(orderSchema(), tt.variables)
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error()+"; ", tt.err)
+		})
+	}
+
+	t.Run("no schema", func(t *testing.T) {
+		assert.NoError(t, CheckTemplateVariables(nil, map[string]interface{}{"anything": 1.0}))
+	})
+
+	t.Run("additional properties", func(t *testing.T) {
+		schema := orderSchema()
+		schema["additionalProperties"] = true
+		assert.NoError(t, CheckTemplateVariables(schema, map[string]interface{}{"firstName": "Ada", "total": 1.0, "extra": 1.0}))
+	})
+}
@@ -0,0 +1,116 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// AddContacts enrolls many contacts in a sequence, by ID or every contact in
+// an audience, as an asynchronous job. Small requests may already be
+// completed in the returned job; poll larger ones with GetContactsJob or use
+// AddContactsAndWait.
+func (c *SequencesClient) AddContacts(ctx context.Context, req *AddContactsToSequenceRequest) (*SequenceContactsJob, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SequenceContactsJob
+	if err := c.http.Post(ctx, "/mail/sequences/"+req.ID+"/contacts/bulk-add", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RemoveContacts removes many contacts from a sequence, by ID or every
+// contact in an audience, as an asynchronous job like AddContacts.
+func (c *SequencesClient) RemoveContacts(ctx context.Context, req *RemoveContactsFromSequenceRequest) (*SequenceContactsJob, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SequenceContactsJob
+	if err := c.http.Post(ctx, "/mail/sequences/"+req.ID+"/contacts/bulk-remove", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetContactsJob retrieves a bulk add or remove job.
+func (c *SequencesClient) GetContactsJob(ctx context.Context, sequenceID, jobID string) (*SequenceContactsJob, error) {
+	var resp SequenceContactsJob
+	if err := c.http.Get(ctx, "/mail/sequences/"+sequenceID+"/contacts/jobs/"+jobID, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ContactsJobAndWaitOptions are options for AddContactsAndWait and
+// RemoveContactsAndWait. Rate-limit and server errors while polling are
+// retried up to MaxTransientErrors times in a row; see client.PollOptions.
+type ContactsJobAndWaitOptions struct {
+	PollInterval       time.Duration
+	Timeout            time.Duration
+	MaxTransientErrors int
+}
+
+// AddContactsAndWait calls AddContacts and polls until the job completes,
+// every 2 seconds for up to 30 minutes by default. It returns an error if the
+// job fails.
+func (c *SequencesClient) AddContactsAndWait(ctx context.Context, req *AddContactsToSequenceRequest, opts *ContactsJobAndWaitOptions) (*SequenceContactsJob, error) {
+	job, err := c.AddContacts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitForContactsJob(ctx, req.ID, job, opts)
+}
+
+// RemoveContactsAndWait calls RemoveContacts and polls until the job
+// completes, like AddContactsAndWait.
+func (c *SequencesClient) RemoveContactsAndWait(ctx context.Context, req *RemoveContactsFromSequenceRequest, opts *ContactsJobAndWaitOptions) (*SequenceContactsJob, error) {
+	job, err := c.RemoveContacts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.waitForContactsJob(ctx, req.ID, job, opts)
+}
+
+func (c *SequencesClient) waitForContactsJob(ctx context.Context, sequenceID string, job *SequenceContactsJob, opts *ContactsJobAndWaitOptions) (*SequenceContactsJob, error) {
+	pollInterval := 2 * time.Second
+	timeout := 30 * time.Minute
+	maxTransient := 0
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			pollInterval = opts.PollInterval
+		}
+		if opts.Timeout > 0 {
+			timeout = opts.Timeout
+		}
+		maxTransient = opts.MaxTransientErrors
+	}
+
+	return client.Poll(ctx, client.PollOptions{
+		Interval:           pollInterval,
+		Timeout:            timeout,
+		MaxTransientErrors: maxTransient,
+		TimeoutMessage:     "Sequence contacts job timed out",
+	}, func(ctx context.Context) (*SequenceContactsJob, bool, error) {
+		current := job
+		if job.Status != SequenceContactsJobCompleted && job.Status != SequenceContactsJobFailed {
+			var err error
+			if current, err = c.GetContactsJob(ctx, sequenceID, job.ID); err != nil {
+				return nil, false, err
+			}
+		}
+		if current.Status == SequenceContactsJobFailed {
+			errMsg := "Sequence contacts job failed"
+			if current.Error != nil {
+				errMsg += ": " + *current.Error
+			}
+			return nil, false, errors.New(errMsg)
+		}
+		return current, current.Status == SequenceContactsJobCompleted, nil
+	})
+}
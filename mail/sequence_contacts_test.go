@@ -0,0 +1,105 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequencesClient_AddContacts(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/sequences/seq-123/contacts/bulk-add", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []interface{}{"c1", "c2"}, body["contactIds"])
+		assert.Equal(t, "aud-1", body["audienceId"])
+		assert.NotContains(t, body, "ID")
+
+		json.NewEncoder(w).Encode(SequenceContactsJob{ID: "job-1", Status: SequenceContactsJobPending, Total: 100002})
+	})
+	defer server.Close()
+
+	job, err := sequencesClient.AddContacts(context.Background(), &AddContactsToSequenceRequest{
+		ID:         "seq-123",
+		ContactIDs: []string{"c1", "c2"},
+		AudienceID: ptr("aud-1"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", job.ID)
+	assert.Equal(t, 100002, job.Total)
+}
+
+func TestSequencesClient_AddContactsAndWait(t *testing.T) {
+	polls := 0
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /mail/sequences/seq-123/contacts/bulk-add":
+			json.NewEncoder(w).Encode(SequenceContactsJob{ID: "job-1", Status: SequenceContactsJobPending})
+		case "GET /mail/sequences/seq-123/contacts/jobs/job-1":
+			polls++
+			status := SequenceContactsJobProcessing
+			if polls == 2 {
+				status = SequenceContactsJobCompleted
+			}
+			json.NewEncoder(w).Encode(SequenceContactsJob{ID: "job-1", Status: status, Total: 3, Succeeded: 2, Skipped: 1})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	job, err := sequencesClient.AddContactsAndWait(context.Background(),
+		&AddContactsToSequenceRequest{ID: "seq-123", AudienceID: ptr("aud-1")},
+		&ContactsJobAndWaitOptions{PollInterval: time.Millisecond, Timeout: time.Second})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, polls)
+	assert.Equal(t, 1, job.Skipped)
+}
+
+func TestSequencesClient_RemoveContactsAndWait(t *testing.T) {
+	t.Run("completed immediately", func(t *testing.T) {
+		sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/mail/sequences/seq-123/contacts/bulk-remove", r.URL.Path)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "churned", body["reason"])
+
+			json.NewEncoder(w).Encode(SequenceContactsJob{ID: "job-1", Status: SequenceContactsJobCompleted, Succeeded: 1})
+		})
+		defer server.Close()
+
+		job, err := sequencesClient.RemoveContactsAndWait(context.Background(),
+			&RemoveContactsFromSequenceRequest{ID: "seq-123", ContactIDs: []string{"c1"}, Reason: ptr("churned")}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, job.Succeeded)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				json.NewEncoder(w).Encode(SequenceContactsJob{ID: "job-1", Status: SequenceContactsJobPending})
+				return
+			}
+			json.NewEncoder(w).Encode(SequenceContactsJob{ID: "job-1", Status: SequenceContactsJobFailed, Error: ptr("audience not found")})
+		})
+		defer server.Close()
+
+		_, err := sequencesClient.RemoveContactsAndWait(context.Background(),
+			&RemoveContactsFromSequenceRequest{ID: "seq-123", AudienceID: ptr("aud-1")},
+			&ContactsJobAndWaitOptions{PollInterval: time.Millisecond, Timeout: time.Second})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "audience not found")
+	})
+}
@@ -0,0 +1,169 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// SequenceScope is a handle to a single sequence, returned by
+// SequencesClient.For. Its methods fill in the sequence ID, so request structs
+// passed to them can leave ID empty; an ID that is set is overwritten. The
+// caller's request is copied, never modified.
+type SequenceScope struct {
+	c  *SequencesClient
+	id string
+}
+
+// For returns a handle to the sequence with the given ID.
+//
+//	seq := client.Mail.Sequences.For("seq-123")
+//	node, err := seq.CreateNode(ctx, &mail.CreateNodeRequest{NodeType: mail.SequenceNodeEmail})
+func (c *SequencesClient) For(id string) *SequenceScope {
+	return &SequenceScope{c: c, id: id}
+}
+
+// ID returns the ID of the sequence.
+func (s *SequenceScope) ID() string {
+	return s.id
+}
+
+// Get gets the sequence with its nodes and connections.
+func (s *SequenceScope) Get(ctx context.Context) (*SequenceWithNodes, error) {
+	return s.c.Get(ctx, s.id)
+}
+
+// Update updates the sequence.
+func (s *SequenceScope) Update(ctx context.Context, req *UpdateSequenceRequest) (*Sequence, error) {
+	return s.c.Update(ctx, types.WithID(req, func(r *UpdateSequenceRequest) { r.ID = s.id }))
+}
+
+// Publish publishes the sequence.
+func (s *SequenceScope) Publish(ctx context.Context) (*PublishSequenceResponse, error) {
+	return s.c.Publish(ctx, s.id)
+}
+
+// Pause pauses the sequence.
+func (s *SequenceScope) Pause(ctx context.Context) (*PauseSequenceResponse, error) {
+	return s.c.Pause(ctx, s.id)
+}
+
+// Resume resumes the sequence.
+func (s *SequenceScope) Resume(ctx context.Context) (*ResumeSequenceResponse, error) {
+	return s.c.Resume(ctx, s.id)
+}
+
+// Simulate dry-runs a test contact through the sequence.
+func (s *SequenceScope) Simulate(ctx context.Context, req *SimulateSequenceRequest) (*SequenceSimulation, error) {
+	return s.c.Simulate(ctx, types.WithID(req, func(r *SimulateSequenceRequest) { r.ID = s.id }))
+}
+
+// GetAnalytics gets analytics for the sequence.
+func (s *SequenceScope) GetAnalytics(ctx context.Context) (*SequenceAnalyticsResponse, error) {
+	return s.c.GetAnalytics(ctx, s.id)
+}
+
+// CreateNode creates a node in the sequence.
+func (s *SequenceScope) CreateNode(ctx context.Context, req *CreateNodeRequest) (*SequenceNode, error) {
+	return s.c.CreateNode(ctx, types.WithID(req, func(r *CreateNodeRequest) { r.ID = s.id }))
+}
+
+// UpdateNode updates a node in the sequence.
+func (s *SequenceScope) UpdateNode(ctx context.Context, req *UpdateNodeRequest) (*SequenceNode, error) {
+	return s.c.UpdateNode(ctx, types.WithID(req, func(r *UpdateNodeRequest) { r.ID = s.id }))
+}
+
+// UpdateNodePosition updates the position of a node in the sequence.
+func (s *SequenceScope) UpdateNodePosition(ctx context.Context, req *UpdateNodePositionRequest) (*SequenceNode, error) {
+	return s.c.UpdateNodePosition(ctx, types.WithID(req, func(r *UpdateNodePositionRequest) { r.ID = s.id }))
+}
+
+// DeleteNode deletes a node from the sequence.
+func (s *SequenceScope) DeleteNode(ctx context.Context, nodeID string) (*DeleteNodeResponse, error) {
+	return s.c.DeleteNode(ctx, s.id, nodeID)
+}
+
+// SetNodeEmail sets email content for a node.
+func (s *SequenceScope) SetNodeEmail(ctx context.Context, req *SetNodeEmailRequest) (*SequenceNode, error) {
+	return s.c.SetNodeEmail(ctx, s.id, req)
+}
+
+// SetNodeTimer sets timer configuration for a node.
+func (s *SequenceScope) SetNodeTimer(ctx context.Context, req *SetNodeTimerRequest) (*SequenceNode, error) {
+	return s.c.SetNodeTimer(ctx, s.id, req)
+}
+
+// SetNodeFilter sets filter configuration for a node.
+func (s *SequenceScope) SetNodeFilter(ctx context.Context, req *SetNodeFilterRequest) (*SequenceNode, error) {
+	return s.c.SetNodeFilter(ctx, s.id, req)
+}
+
+// SetNodeBranch sets branch configuration for a node.
+func (s *SequenceScope) SetNodeBranch(ctx context.Context, req *SetNodeBranchRequest) (*SequenceNode, error) {
+	return s.c.SetNodeBranch(ctx, s.id, req)
+}
+
+// SetNodeExperiment sets experiment configuration for a node.
+func (s *SequenceScope) SetNodeExperiment(ctx context.Context, req *SetNodeExperimentRequest) (*SequenceNode, error) {
+	return s.c.SetNodeExperiment(ctx, s.id, req)
+}
+
+// SetNodeWebhook sets webhook configuration for a node.
+func (s *SequenceScope) SetNodeWebhook(ctx context.Context, req *SetNodeWebhookRequest) (*SequenceNode, error) {
+	return s.c.SetNodeWebhook(ctx, s.id, req)
+}
+
+// CreateConnection creates a connection between two nodes in the sequence.
+func (s *SequenceScope) CreateConnection(ctx context.Context, req *CreateConnectionRequest) (*SequenceConnection, error) {
+	return s.c.CreateConnection(ctx, types.WithID(req, func(r *CreateConnectionRequest) { r.ID = s.id }))
+}
+
+// DeleteConnection deletes a connection from the sequence.
+func (s *SequenceScope) DeleteConnection(ctx context.Context, connectionID string) (*DeleteConnectionResponse, error) {
+	return s.c.DeleteConnection(ctx, s.id, connectionID)
+}
+
+// ListEntries lists the sequence's entries.
+func (s *SequenceScope) ListEntries(ctx context.Context, req *ListSequenceEntriesRequest) (*ListSequenceEntriesResponse, error) {
+	return s.c.ListEntries(ctx, types.WithID(req, func(r *ListSequenceEntriesRequest) { r.ID = s.id }))
+}
+
+// AddContact adds a contact to the sequence.
+func (s *SequenceScope) AddContact(ctx context.Context, contactID string) (*SequenceEntry, error) {
+	return s.c.AddContact(ctx, &AddContactToSequenceRequest{ID: s.id, ContactID: contactID})
+}
+
+// RemoveContact removes an entry from the sequence.
+func (s *SequenceScope) RemoveContact(ctx context.Context, req *RemoveContactFromSequenceRequest) (*RemoveContactFromSequenceResponse, error) {
+	return s.c.RemoveContact(ctx, types.WithID(req, func(r *RemoveContactFromSequenceRequest) { r.ID = s.id }))
+}
+
+// AddContacts starts a job enrolling many contacts in the sequence.
+func (s *SequenceScope) AddContacts(ctx context.Context, req *AddContactsToSequenceRequest) (*SequenceContactsJob, error) {
+	return s.c.AddContacts(ctx, types.WithID(req, func(r *AddContactsToSequenceRequest) { r.ID = s.id }))
+}
+
+// RemoveContacts starts a job removing many contacts from the sequence.
+func (s *SequenceScope) RemoveContacts(ctx context.Context, req *RemoveContactsFromSequenceRequest) (*SequenceContactsJob, error) {
+	return s.c.RemoveContacts(ctx, types.WithID(req, func(r *RemoveContactsFromSequenceRequest) { r.ID = s.id }))
+}
+
+// GetContactsJob gets a bulk enrollment or removal job for the sequence.
+func (s *SequenceScope) GetContactsJob(ctx context.Context, jobID string) (*SequenceContactsJob, error) {
+	return s.c.GetContactsJob(ctx, s.id, jobID)
+}
+
+// PauseEntry pauses an entry in the sequence.
+func (s *SequenceScope) PauseEntry(ctx context.Context, entryID string) (*SequenceEntry, error) {
+	return s.c.PauseEntry(ctx, s.id, entryID)
+}
+
+// ResumeEntry resumes a paused entry in the sequence.
+func (s *SequenceScope) ResumeEntry(ctx context.Context, entryID string) (*SequenceEntry, error) {
+	return s.c.ResumeEntry(ctx, s.id, entryID)
+}
+
+// SkipToNode moves an entry in the sequence to another node.
+func (s *SequenceScope) SkipToNode(ctx context.Context, entryID, nodeID string) (*SequenceEntry, error) {
+	return s.c.SkipToNode(ctx, s.id, entryID, nodeID)
+}
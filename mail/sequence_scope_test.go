@@ -0,0 +1,67 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequenceScope_CreateNode(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/sequences/seq-123/nodes", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "email", body["nodeType"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SequenceNode{ID: "node-1", Name: "Welcome"})
+	})
+	defer server.Close()
+
+	seq := sequencesClient.For("seq-123")
+	req := &CreateNodeRequest{NodeType: SequenceNodeEmail, Name: "Welcome"}
+	node, err := seq.CreateNode(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", node.ID)
+	assert.Empty(t, req.ID, "caller's request should not be modified")
+}
+
+func TestSequenceScope_Operations(t *testing.T) {
+	var paths []string
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	seq := sequencesClient.For("seq-123")
+	assert.Equal(t, "seq-123", seq.ID())
+
+	_, err := seq.UpdateNode(ctx, &UpdateNodeRequest{ID: "other", NodeID: "node-1"})
+	require.NoError(t, err)
+	_, err = seq.DeleteConnection(ctx, "conn-1")
+	require.NoError(t, err)
+	_, err = seq.ListEntries(ctx, nil)
+	require.NoError(t, err)
+	_, err = seq.SkipToNode(ctx, "entry-1", "node-2")
+	require.NoError(t, err)
+	_, err = seq.AddContact(ctx, "contact-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"PUT /mail/sequences/seq-123/nodes/node-1",
+		"DELETE /mail/sequences/seq-123/connections/conn-1",
+		"GET /mail/sequences/seq-123/entries",
+		"POST /mail/sequences/seq-123/entries/entry-1/skip",
+		"POST /mail/sequences/seq-123/add-contact",
+	}, paths)
+}
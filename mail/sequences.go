@@ -20,7 +20,11 @@ func NewSequencesClient(http *client.HTTPClient) *SequencesClient {
 
 // List lists all sequences.
 func (c *SequencesClient) List(ctx context.Context, req *ListSequencesRequest) (*ListSequencesResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -65,6 +69,10 @@ func (c *SequencesClient) Get(ctx context.Context, id string) (*SequenceWithNode
 
 // Create creates a new sequence.
 func (c *SequencesClient) Create(ctx context.Context, req *CreateSequenceRequest) (*Sequence, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Sequence
 	if err := c.http.Post(ctx, "/mail/sequences", req, &resp); err != nil {
 		return nil, err
@@ -74,6 +82,10 @@ func (c *SequencesClient) Create(ctx context.Context, req *CreateSequenceRequest
 
 // Update updates a sequence.
 func (c *SequencesClient) Update(ctx context.Context, req *UpdateSequenceRequest) (*Sequence, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Sequence
 	if err := c.http.Put(ctx, "/mail/sequences/"+req.ID, req, &resp); err != nil {
 		return nil, err
@@ -139,8 +151,99 @@ func (c *SequencesClient) Duplicate(ctx context.Context, id string, name *string
 	return &resp, nil
 }
 
+// Export returns the sequence's settings, nodes, connections and node
+// configs as a portable SequenceExport.
+func (c *SequencesClient) Export(ctx context.Context, id string) (*SequenceExport, error) {
+	var resp SequenceExport
+	if err := c.http.Get(ctx, "/mail/sequences/"+id+"/export", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Import creates a new draft sequence from an export, e.g. to promote a
+// sequence from sandbox to production.
+func (c *SequencesClient) Import(ctx context.Context, req *ImportSequenceRequest) (*SequenceWithNodes, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SequenceWithNodes
+	if err := c.http.Post(ctx, "/mail/sequences/import", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListRevisions lists the saved revisions of a sequence, newest first. A
+// revision is recorded for every change to the sequence or its graph.
+func (c *SequencesClient) ListRevisions(ctx context.Context, req *ListSequenceRevisionsRequest) (*ListSequenceRevisionsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req.Limit != nil {
+		params.Set("limit", strconv.Itoa(*req.Limit))
+	}
+	if req.Offset != nil {
+		params.Set("offset", strconv.Itoa(*req.Offset))
+	}
+
+	path := "/mail/sequences/" + req.ID + "/revisions"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListSequenceRevisionsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetRevision retrieves a sequence revision with its snapshot.
+func (c *SequencesClient) GetRevision(ctx context.Context, sequenceID string, revision int) (*SequenceRevision, error) {
+	var resp SequenceRevision
+	if err := c.http.Get(ctx, "/mail/sequences/"+sequenceID+"/revisions/"+strconv.Itoa(revision), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Rollback restores a sequence's settings and graph to an earlier revision,
+// recording the rollback as a new revision.
+func (c *SequencesClient) Rollback(ctx context.Context, sequenceID string, revision int) (*RollbackSequenceResponse, error) {
+	var resp RollbackSequenceResponse
+	body := map[string]interface{}{"revision": revision}
+	if err := c.http.Post(ctx, "/mail/sequences/"+sequenceID+"/rollback", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Simulate dry-runs a test contact through a sequence, applying the given
+// events, and returns the path taken and the emails that would be sent.
+// Nothing is delivered and the sequence's stats are unaffected. Draft
+// sequences can be simulated before publishing.
+func (c *SequencesClient) Simulate(ctx context.Context, req *SimulateSequenceRequest) (*SequenceSimulation, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SequenceSimulation
+	if err := c.http.Post(ctx, "/mail/sequences/"+req.ID+"/simulate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // CreateNode creates a new node in a sequence.
 func (c *SequencesClient) CreateNode(ctx context.Context, req *CreateNodeRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SequenceNode
 	if err := c.http.Post(ctx, "/mail/sequences/"+req.ID+"/nodes", req, &resp); err != nil {
 		return nil, err
@@ -150,6 +253,10 @@ func (c *SequencesClient) CreateNode(ctx context.Context, req *CreateNodeRequest
 
 // UpdateNode updates a node.
 func (c *SequencesClient) UpdateNode(ctx context.Context, req *UpdateNodeRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SequenceNode
 	if err := c.http.Put(ctx, "/mail/sequences/"+req.ID+"/nodes/"+req.NodeID, req, &resp); err != nil {
 		return nil, err
@@ -159,6 +266,10 @@ func (c *SequencesClient) UpdateNode(ctx context.Context, req *UpdateNodeRequest
 
 // UpdateNodePosition updates a node's position.
 func (c *SequencesClient) UpdateNodePosition(ctx context.Context, req *UpdateNodePositionRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	body := map[string]interface{}{
 		"positionX": req.PositionX,
 		"positionY": req.PositionY,
@@ -181,6 +292,10 @@ func (c *SequencesClient) DeleteNode(ctx context.Context, sequenceID, nodeID str
 
 // SetNodeEmail sets email content for a node.
 func (c *SequencesClient) SetNodeEmail(ctx context.Context, sequenceID string, req *SetNodeEmailRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SequenceNode
 	if err := c.http.Put(ctx, "/mail/sequences/"+sequenceID+"/nodes/"+req.NodeID+"/email", req, &resp); err != nil {
 		return nil, err
@@ -188,8 +303,26 @@ func (c *SequencesClient) SetNodeEmail(ctx context.Context, sequenceID string, r
 	return &resp, nil
 }
 
+// RepinTemplates re-pins the template versions used by a sequence's email
+// nodes, for example after a template edit that in-flight journeys should pick up.
+func (c *SequencesClient) RepinTemplates(ctx context.Context, sequenceID string, req *RepinSequenceTemplatesRequest) (*RepinSequenceTemplatesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp RepinSequenceTemplatesResponse
+	if err := c.http.Post(ctx, "/mail/sequences/"+sequenceID+"/templates/repin", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // SetNodeTimer sets timer configuration for a node.
 func (c *SequencesClient) SetNodeTimer(ctx context.Context, sequenceID string, req *SetNodeTimerRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SequenceNode
 	if err := c.http.Put(ctx, "/mail/sequences/"+sequenceID+"/nodes/"+req.NodeID+"/timer", req, &resp); err != nil {
 		return nil, err
@@ -199,6 +332,10 @@ func (c *SequencesClient) SetNodeTimer(ctx context.Context, sequenceID string, r
 
 // SetNodeFilter sets filter configuration for a node.
 func (c *SequencesClient) SetNodeFilter(ctx context.Context, sequenceID string, req *SetNodeFilterRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SequenceNode
 	if err := c.http.Put(ctx, "/mail/sequences/"+sequenceID+"/nodes/"+req.NodeID+"/filter", req, &resp); err != nil {
 		return nil, err
@@ -208,6 +345,10 @@ func (c *SequencesClient) SetNodeFilter(ctx context.Context, sequenceID string,
 
 // SetNodeBranch sets branch configuration for a node.
 func (c *SequencesClient) SetNodeBranch(ctx context.Context, sequenceID string, req *SetNodeBranchRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SequenceNode
 	if err := c.http.Put(ctx, "/mail/sequences/"+sequenceID+"/nodes/"+req.NodeID+"/branch", req, &resp); err != nil {
 		return nil, err
@@ -217,6 +358,10 @@ func (c *SequencesClient) SetNodeBranch(ctx context.Context, sequenceID string,
 
 // SetNodeExperiment sets experiment configuration for a node.
 func (c *SequencesClient) SetNodeExperiment(ctx context.Context, sequenceID string, req *SetNodeExperimentRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SequenceNode
 	if err := c.http.Put(ctx, "/mail/sequences/"+sequenceID+"/nodes/"+req.NodeID+"/experiment", req, &resp); err != nil {
 		return nil, err
@@ -224,8 +369,25 @@ func (c *SequencesClient) SetNodeExperiment(ctx context.Context, sequenceID stri
 	return &resp, nil
 }
 
+// SetNodeWebhook sets webhook configuration for a node.
+func (c *SequencesClient) SetNodeWebhook(ctx context.Context, sequenceID string, req *SetNodeWebhookRequest) (*SequenceNode, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp SequenceNode
+	if err := c.http.Put(ctx, "/mail/sequences/"+sequenceID+"/nodes/"+req.NodeID+"/webhook", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // CreateConnection creates a connection between nodes.
 func (c *SequencesClient) CreateConnection(ctx context.Context, req *CreateConnectionRequest) (*SequenceConnection, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp SequenceConnection
 	if err := c.http.Post(ctx, "/mail/sequences/"+req.ID+"/connections", req, &resp); err != nil {
 		return nil, err
@@ -244,6 +406,10 @@ func (c *SequencesClient) DeleteConnection(ctx context.Context, sequenceID, conn
 
 // ListEntries lists contacts in a sequence.
 func (c *SequencesClient) ListEntries(ctx context.Context, req *ListSequenceEntriesRequest) (*ListSequenceEntriesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	params := url.Values{}
 	if req.Limit != nil {
 		params.Set("limit", strconv.Itoa(*req.Limit))
@@ -269,6 +435,10 @@ func (c *SequencesClient) ListEntries(ctx context.Context, req *ListSequenceEntr
 
 // AddContact adds a contact to a sequence.
 func (c *SequencesClient) AddContact(ctx context.Context, req *AddContactToSequenceRequest) (*SequenceEntry, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	body := map[string]interface{}{"contactId": req.ContactID}
 	var resp SequenceEntry
 	if err := c.http.Post(ctx, "/mail/sequences/"+req.ID+"/add-contact", body, &resp); err != nil {
@@ -279,6 +449,10 @@ func (c *SequencesClient) AddContact(ctx context.Context, req *AddContactToSeque
 
 // RemoveContact removes a contact from a sequence.
 func (c *SequencesClient) RemoveContact(ctx context.Context, req *RemoveContactFromSequenceRequest) (*RemoveContactFromSequenceResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	body := map[string]interface{}{"entryId": req.EntryID}
 	if req.Reason != nil {
 		body["reason"] = *req.Reason
@@ -290,6 +464,36 @@ func (c *SequencesClient) RemoveContact(ctx context.Context, req *RemoveContactF
 	return &resp, nil
 }
 
+// PauseEntry pauses a single contact's progress through a sequence. The
+// contact stays on its current node until ResumeEntry is called.
+func (c *SequencesClient) PauseEntry(ctx context.Context, sequenceID, entryID string) (*SequenceEntry, error) {
+	var resp SequenceEntry
+	if err := c.http.Post(ctx, "/mail/sequences/"+sequenceID+"/entries/"+entryID+"/pause", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ResumeEntry resumes a paused sequence entry from its current node.
+func (c *SequencesClient) ResumeEntry(ctx context.Context, sequenceID, entryID string) (*SequenceEntry, error) {
+	var resp SequenceEntry
+	if err := c.http.Post(ctx, "/mail/sequences/"+sequenceID+"/entries/"+entryID+"/resume", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SkipToNode moves a sequence entry to another node, which runs immediately
+// unless the entry is paused. Nodes in between are not executed.
+func (c *SequencesClient) SkipToNode(ctx context.Context, sequenceID, entryID, nodeID string) (*SequenceEntry, error) {
+	var resp SequenceEntry
+	body := map[string]interface{}{"nodeId": nodeID}
+	if err := c.http.Post(ctx, "/mail/sequences/"+sequenceID+"/entries/"+entryID+"/skip", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetAnalytics retrieves sequence analytics.
 func (c *SequencesClient) GetAnalytics(ctx context.Context, id string) (*SequenceAnalyticsResponse, error) {
 	var resp SequenceAnalyticsResponse
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -235,6 +236,260 @@ func TestSequencesClient_Duplicate(t *testing.T) {
 	assert.Equal(t, "seq-456", resp.ID)
 }
 
+func TestSequencesClient_Export(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/sequences/seq-123/export", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SequenceExport{
+			Version:     1,
+			Name:        "Onboarding",
+			TriggerType: SequenceTriggerContactAdded,
+			Nodes: []SequenceExportNode{
+				{Key: "trigger", NodeType: SequenceNodeTrigger, Name: "Start"},
+				{Key: "welcome", NodeType: SequenceNodeEmail, Name: "Welcome", Config: map[string]interface{}{"subject": "Hi"}},
+			},
+			Connections: []SequenceExportConnection{
+				{Source: "trigger", Target: "welcome", ConnectionType: ConnectionDefault},
+			},
+		})
+	})
+	defer server.Close()
+
+	export, err := sequencesClient.Export(context.Background(), "seq-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, export.Version)
+	require.Len(t, export.Nodes, 2)
+	assert.Equal(t, "Hi", export.Nodes[1].Config["subject"])
+	require.Len(t, export.Connections, 1)
+	assert.Equal(t, "welcome", export.Connections[0].Target)
+}
+
+func TestSequencesClient_Import(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/sequences/import", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "production", body["environment"])
+		seq := body["sequence"].(map[string]interface{})
+		assert.Equal(t, "Onboarding", seq["name"])
+		assert.Len(t, seq["nodes"], 2)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SequenceWithNodes{
+			Sequence: Sequence{ID: "seq-789", Name: "Onboarding", Status: SequenceStatusDraft},
+			Nodes:    []SequenceNode{{ID: "node-1"}, {ID: "node-2"}},
+		})
+	})
+	defer server.Close()
+
+	env := types.EnvironmentProduction
+	resp, err := sequencesClient.Import(context.Background(), &ImportSequenceRequest{
+		Environment: &env,
+		Sequence: &SequenceExport{
+			Version:     1,
+			Name:        "Onboarding",
+			TriggerType: SequenceTriggerContactAdded,
+			Nodes: []SequenceExportNode{
+				{Key: "trigger", NodeType: SequenceNodeTrigger, Name: "Start"},
+				{Key: "welcome", NodeType: SequenceNodeEmail, Name: "Welcome"},
+			},
+			Connections: []SequenceExportConnection{
+				{Source: "trigger", Target: "welcome", ConnectionType: ConnectionDefault},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "seq-789", resp.ID)
+	assert.Equal(t, SequenceStatusDraft, resp.Status)
+	assert.Len(t, resp.Nodes, 2)
+}
+
+func TestSequencesClient_ListRevisions(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/sequences/seq-123/revisions", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListSequenceRevisionsResponse{
+			Revisions: []SequenceRevision{
+				{ID: "rev-2", SequenceID: "seq-123", Revision: 2, Source: SequenceRevisionEdit},
+				{ID: "rev-1", SequenceID: "seq-123", Revision: 1, Source: SequenceRevisionPublish, Published: true},
+			},
+			Total: 2,
+		})
+	})
+	defer server.Close()
+
+	resp, err := sequencesClient.ListRevisions(context.Background(), &ListSequenceRevisionsRequest{
+		ID:    "seq-123",
+		Limit: ptr(10),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Revisions, 2)
+	assert.Equal(t, 2, resp.Revisions[0].Revision)
+	assert.True(t, resp.Revisions[1].Published)
+}
+
+func TestSequencesClient_GetRevision(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/sequences/seq-123/revisions/1", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SequenceRevision{
+			ID:       "rev-1",
+			Revision: 1,
+			Snapshot: &SequenceExport{
+				Version: 1,
+				Name:    "Welcome",
+				Nodes:   []SequenceExportNode{{Key: "welcome", NodeType: SequenceNodeEmail}},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := sequencesClient.GetRevision(context.Background(), "seq-123", 1)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Snapshot)
+	assert.Equal(t, "Welcome", resp.Snapshot.Name)
+	assert.Len(t, resp.Snapshot.Nodes, 1)
+}
+
+func TestSequencesClient_Rollback(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/sequences/seq-123/rollback", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, float64(1), body["revision"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(RollbackSequenceResponse{
+			Sequence: SequenceWithNodes{Sequence: Sequence{ID: "seq-123", Status: SequenceStatusActive}},
+			Revision: SequenceRevision{ID: "rev-3", Revision: 3, Source: SequenceRevisionRollback},
+		})
+	})
+	defer server.Close()
+
+	resp, err := sequencesClient.Rollback(context.Background(), "seq-123", 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "seq-123", resp.Sequence.ID)
+	assert.Equal(t, 3, resp.Revision.Revision)
+	assert.Equal(t, SequenceRevisionRollback, resp.Revision.Source)
+}
+
+func TestSequencesClient_Simulate(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/sequences/seq-123/simulate", r.URL.Path)
+
+		var req SimulateSequenceRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+		assert.Equal(t, "test@example.com", req.Contact.Email)
+		require.Len(t, req.Events, 1)
+		assert.Equal(t, "purchase_completed", req.Events[0].EventName)
+		assert.Equal(t, 1440, req.Events[0].AfterMinutes)
+
+		yes := ConnectionYes
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SequenceSimulation{
+			Outcome: SimulationOutcomeCompleted,
+			Steps: []SimulationStep{
+				{NodeID: "n1", NodeType: SequenceNodeEmail, NodeName: "Welcome"},
+				{NodeID: "n2", NodeType: SequenceNodeTimer, NodeName: "Wait 2 days"},
+				{NodeID: "n3", NodeType: SequenceNodeFilter, NodeName: "Purchased?", AfterMinutes: 2880, Connection: &yes},
+			},
+			Emails: []SimulatedEmail{
+				{NodeID: "n1", To: "test@example.com", Subject: "Welcome, Ada"},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := sequencesClient.Simulate(context.Background(), &SimulateSequenceRequest{
+		ID: "seq-123",
+		Contact: SimulationContact{
+			Email:     "test@example.com",
+			FirstName: ptr("Ada"),
+		},
+		Events: []SimulatedEvent{
+			{EventName: "purchase_completed", AfterMinutes: 1440},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, SimulationOutcomeCompleted, resp.Outcome)
+	require.Len(t, resp.Steps, 3)
+	assert.Equal(t, ConnectionYes, *resp.Steps[2].Connection)
+	require.Len(t, resp.Emails, 1)
+	assert.Equal(t, "Welcome, Ada", resp.Emails[0].Subject)
+}
+
+func TestSequencesClient_EntryControls(t *testing.T) {
+	t.Run("pause", func(t *testing.T) {
+		sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/mail/sequences/seq-123/entries/entry-1/pause", r.URL.Path)
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SequenceEntry{ID: "entry-1", Status: SequenceEntryStatusPaused})
+		})
+		defer server.Close()
+
+		resp, err := sequencesClient.PauseEntry(context.Background(), "seq-123", "entry-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, SequenceEntryStatusPaused, resp.Status)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/mail/sequences/seq-123/entries/entry-1/resume", r.URL.Path)
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SequenceEntry{ID: "entry-1", Status: SequenceEntryStatusActive})
+		})
+		defer server.Close()
+
+		resp, err := sequencesClient.ResumeEntry(context.Background(), "seq-123", "entry-1")
+
+		require.NoError(t, err)
+		assert.Equal(t, SequenceEntryStatusActive, resp.Status)
+	})
+
+	t.Run("skip to node", func(t *testing.T) {
+		sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/mail/sequences/seq-123/entries/entry-1/skip", r.URL.Path)
+
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "node-5", body["nodeId"])
+
+			nodeID := "node-5"
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SequenceEntry{ID: "entry-1", CurrentNodeID: &nodeID, Status: SequenceEntryStatusActive})
+		})
+		defer server.Close()
+
+		resp, err := sequencesClient.SkipToNode(context.Background(), "seq-123", "entry-1", "node-5")
+
+		require.NoError(t, err)
+		assert.Equal(t, "node-5", *resp.CurrentNodeID)
+	})
+}
+
 func TestSequencesClient_CreateNode(t *testing.T) {
 	sequenceID := "seq-123"
 	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -313,6 +568,106 @@ func TestSequencesClient_DeleteNode(t *testing.T) {
 	assert.True(t, resp.Success)
 }
 
+func TestSequencesClient_SetNodeEmail_PinTemplateVersion(t *testing.T) {
+	sequenceID := "seq-123"
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/sequences/"+sequenceID+"/nodes/node-1/email", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "tmpl-123", body["templateId"])
+		assert.Equal(t, float64(3), body["templateVersion"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SequenceNode{
+			ID:     "node-1",
+			Config: map[string]interface{}{"templateId": "tmpl-123", "templateVersion": 3},
+		})
+	})
+	defer server.Close()
+
+	resp, err := sequencesClient.SetNodeEmail(context.Background(), sequenceID, &SetNodeEmailRequest{
+		NodeID:          "node-1",
+		TemplateID:      ptr("tmpl-123"),
+		TemplateVersion: ptr(3),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, float64(3), resp.Config["templateVersion"])
+}
+
+func TestSequencesClient_SetNodeWebhook(t *testing.T) {
+	sequenceID := "seq-123"
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/sequences/"+sequenceID+"/nodes/node-1/webhook", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "https://crm.example.com/hooks/lead", body["url"])
+		assert.Equal(t, "POST", body["method"])
+		assert.Equal(t, `{"email":"{{contact.email}}"}`, body["payloadTemplate"])
+		assert.Equal(t, "whsec_123", body["secret"])
+		assert.Equal(t, "branch", body["onFailure"])
+		assert.NotContains(t, body, "NodeID")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(SequenceNode{
+			ID:       "node-1",
+			NodeType: SequenceNodeWebhook,
+			Config:   map[string]interface{}{"url": "https://crm.example.com/hooks/lead"},
+		})
+	})
+	defer server.Close()
+
+	onFailure := WebhookFailureBranch
+	resp, err := sequencesClient.SetNodeWebhook(context.Background(), sequenceID, &SetNodeWebhookRequest{
+		NodeID:          "node-1",
+		URL:             "https://crm.example.com/hooks/lead",
+		Method:          WebhookMethodPost,
+		PayloadTemplate: ptr(`{"email":"{{contact.email}}"}`),
+		Secret:          ptr("whsec_123"),
+		OnFailure:       &onFailure,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, SequenceNodeWebhook, resp.NodeType)
+}
+
+func TestSequencesClient_RepinTemplates(t *testing.T) {
+	sequenceID := "seq-123"
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/sequences/"+sequenceID+"/templates/repin", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "tmpl-123", body["templateId"])
+		assert.NotContains(t, body, "version")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(RepinSequenceTemplatesResponse{
+			Success:      true,
+			UpdatedCount: 2,
+			Nodes:        []SequenceNode{{ID: "node-1"}, {ID: "node-4"}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := sequencesClient.RepinTemplates(context.Background(), sequenceID, &RepinSequenceTemplatesRequest{
+		TemplateID: ptr("tmpl-123"),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+	assert.Equal(t, 2, resp.UpdatedCount)
+	assert.Len(t, resp.Nodes, 2)
+}
+
 func TestSequencesClient_CreateConnection(t *testing.T) {
 	sequenceID := "seq-123"
 	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
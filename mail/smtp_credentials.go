@@ -0,0 +1,60 @@
+package mail
+
+import (
+	"context"
+	"strconv"
+)
+
+// CreateSMTPCredentials creates credentials for applications that can only
+// send email over SMTP. Emails sent with them are processed like emails sent
+// with Send.
+func (c *Client) CreateSMTPCredentials(ctx context.Context, req *CreateSMTPCredentialsRequest) (*CreateSMTPCredentialsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp CreateSMTPCredentialsResponse
+	if err := c.http.Post(ctx, "/mail/smtp-credentials", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListSMTPCredentials lists SMTP credentials. Revoked credentials are only
+// included when IncludeRevoked is set.
+func (c *Client) ListSMTPCredentials(ctx context.Context, req *ListSMTPCredentialsRequest) (*ListSMTPCredentialsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.IncludeRevoked != nil {
+			params.Set("includeRevoked", strconv.FormatBool(*req.IncludeRevoked))
+		}
+	}
+
+	path := "/mail/smtp-credentials"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListSMTPCredentialsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RevokeSMTPCredential revokes SMTP credentials. Connections using them are
+// rejected from then on.
+func (c *Client) RevokeSMTPCredential(ctx context.Context, id string) (*RevokeSMTPCredentialResponse, error) {
+	var resp RevokeSMTPCredentialResponse
+	if err := c.http.Post(ctx, "/mail/smtp-credentials/"+id+"/revoke", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
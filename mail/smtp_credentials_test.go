@@ -0,0 +1,98 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateSMTPCredentials(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/smtp-credentials", r.URL.Path)
+
+		var req CreateSMTPCredentialsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "Legacy CRM", req.Name)
+		assert.Equal(t, []string{"example.com"}, req.AllowedFromDomains)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateSMTPCredentialsResponse{
+			Credential: SMTPCredential{ID: "smtp-123", Name: req.Name, Username: "stack0_abc"},
+			SMTP: SMTPServer{
+				Host:     "smtp.stack0.dev",
+				Port:     587,
+				Username: "stack0_abc",
+				Password: "s3cret",
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.CreateSMTPCredentials(context.Background(), &CreateSMTPCredentialsRequest{
+		Name:               "Legacy CRM",
+		AllowedFromDomains: []string{"example.com"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "smtp-123", resp.Credential.ID)
+	assert.Equal(t, "smtp.stack0.dev", resp.SMTP.Host)
+	assert.Equal(t, "s3cret", resp.SMTP.Password)
+}
+
+func TestClient_ListSMTPCredentials(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/smtp-credentials", r.URL.Path)
+		assert.Equal(t, "production", r.URL.Query().Get("environment"))
+		assert.Equal(t, "true", r.URL.Query().Get("includeRevoked"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListSMTPCredentialsResponse{
+			Credentials: []SMTPCredential{{ID: "smtp-1"}, {ID: "smtp-2"}},
+		})
+	})
+	defer server.Close()
+
+	env := types.EnvironmentProduction
+	resp, err := mailClient.ListSMTPCredentials(context.Background(), &ListSMTPCredentialsRequest{
+		Environment:    &env,
+		IncludeRevoked: ptr(true),
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Credentials, 2)
+}
+
+func TestClient_ListSMTPCredentials_NilRequest(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.RawQuery)
+		json.NewEncoder(w).Encode(ListSMTPCredentialsResponse{})
+	})
+	defer server.Close()
+
+	_, err := mailClient.ListSMTPCredentials(context.Background(), nil)
+
+	require.NoError(t, err)
+}
+
+func TestClient_RevokeSMTPCredential(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/smtp-credentials/smtp-123/revoke", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(RevokeSMTPCredentialResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := mailClient.RevokeSMTPCredential(context.Background(), "smtp-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
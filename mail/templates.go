@@ -6,11 +6,16 @@ import (
 	"strconv"
 
 	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
 )
 
 // TemplatesClient handles template operations.
 type TemplatesClient struct {
 	http *client.HTTPClient
+
+	// schemas caches template variables schemas by template ID for
+	// SendWithTemplate. Update and Delete drop the template's entry.
+	schemas schemaCache[map[string]interface{}]
 }
 
 // NewTemplatesClient creates a new templates client.
@@ -20,7 +25,11 @@ func NewTemplatesClient(http *client.HTTPClient) *TemplatesClient {
 
 // List lists all templates.
 func (c *TemplatesClient) List(ctx context.Context, req *ListTemplatesRequest) (*ListTemplatesResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -37,6 +46,9 @@ func (c *TemplatesClient) List(ctx context.Context, req *ListTemplatesRequest) (
 		if req.Search != nil {
 			params.Set("search", *req.Search)
 		}
+		if len(req.Fields) > 0 {
+			params.Set("fields", client.JoinFields(req.Fields))
+		}
 	}
 
 	path := "/mail/templates"
@@ -51,16 +63,17 @@ func (c *TemplatesClient) List(ctx context.Context, req *ListTemplatesRequest) (
 	return &resp, nil
 }
 
-// Get retrieves a template by ID.
-func (c *TemplatesClient) Get(ctx context.Context, id string) (*Template, error) {
+// Get retrieves a template by ID. Pass fields to return only those fields.
+func (c *TemplatesClient) Get(ctx context.Context, id string, fields ...TemplateField) (*Template, error) {
 	var resp Template
-	if err := c.http.Get(ctx, "/mail/templates/"+id, &resp); err != nil {
+	if err := c.http.Get(ctx, client.WithFields("/mail/templates/"+id, fields), &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-// GetBySlug retrieves a template by slug.
+// GetBySlug retrieves a template by slug. Slug aliases resolve too, with
+// DeprecatedSlug set on the returned template.
 func (c *TemplatesClient) GetBySlug(ctx context.Context, slug string) (*Template, error) {
 	var resp Template
 	if err := c.http.Get(ctx, "/mail/templates/slug/"+slug, &resp); err != nil {
@@ -69,8 +82,33 @@ func (c *TemplatesClient) GetBySlug(ctx context.Context, slug string) (*Template
 	return &resp, nil
 }
 
+// AddSlugAlias adds an alias that resolves to the template wherever its slug
+// is accepted, for example to keep a retired slug working.
+func (c *TemplatesClient) AddSlugAlias(ctx context.Context, templateID, alias string) (*TemplateSlugAliasesResponse, error) {
+	var resp TemplateSlugAliasesResponse
+	body := map[string]interface{}{"alias": alias}
+	if err := c.http.Post(ctx, "/mail/templates/"+templateID+"/slug-aliases", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RemoveSlugAlias removes a slug alias. Sends that still reference it fail
+// afterwards.
+func (c *TemplatesClient) RemoveSlugAlias(ctx context.Context, templateID, alias string) (*TemplateSlugAliasesResponse, error) {
+	var resp TemplateSlugAliasesResponse
+	if err := c.http.Delete(ctx, "/mail/templates/"+templateID+"/slug-aliases/"+url.PathEscape(alias), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Create creates a new template.
 func (c *TemplatesClient) Create(ctx context.Context, req *CreateTemplateRequest) (*Template, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Template
 	if err := c.http.Post(ctx, "/mail/templates", req, &resp); err != nil {
 		return nil, err
@@ -80,10 +118,15 @@ func (c *TemplatesClient) Create(ctx context.Context, req *CreateTemplateRequest
 
 // Update updates a template.
 func (c *TemplatesClient) Update(ctx context.Context, req *UpdateTemplateRequest) (*Template, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp Template
 	if err := c.http.Put(ctx, "/mail/templates/"+req.ID, req, &resp); err != nil {
 		return nil, err
 	}
+	c.schemas.forget(req.ID)
 	return &resp, nil
 }
 
@@ -93,15 +136,131 @@ func (c *TemplatesClient) Delete(ctx context.Context, id string) (*DeleteTemplat
 	if err := c.http.Delete(ctx, "/mail/templates/"+id, &resp); err != nil {
 		return nil, err
 	}
+	c.schemas.forget(id)
+	return &resp, nil
+}
+
+// Duplicate copies a template's content and variables schema into a new
+// template with slug newSlug, e.g. to start a locale variant or to copy a
+// production template into sandbox. A nil targetEnvironment keeps the
+// original's environment. The copy keeps the original's name and starts at
+// version 1.
+func (c *TemplatesClient) Duplicate(ctx context.Context, id, newSlug string, targetEnvironment *types.Environment) (*Template, error) {
+	body := map[string]interface{}{"slug": newSlug}
+	if targetEnvironment != nil {
+		body["targetEnvironment"] = *targetEnvironment
+	}
+	var resp Template
+	if err := c.http.Post(ctx, "/mail/templates/"+id+"/duplicate", body, &resp); err != nil {
+		return nil, err
+	}
 	return &resp, nil
 }
 
 // Preview previews a template with variables.
 func (c *TemplatesClient) Preview(ctx context.Context, req *PreviewTemplateRequest) (*PreviewTemplateResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp PreviewTemplateResponse
 	body := map[string]interface{}{"variables": req.Variables}
+	if req.Locale != nil {
+		body["locale"] = *req.Locale
+	}
+	if req.Timezone != nil {
+		body["timezone"] = *req.Timezone
+	}
+	if req.MissingVariables != nil {
+		body["missingVariables"] = *req.MissingVariables
+	}
+	if req.Partials != nil {
+		body["partials"] = req.Partials
+	}
+	if req.LayoutID != nil {
+		body["layoutId"] = *req.LayoutID
+	}
 	if err := c.http.Post(ctx, "/mail/templates/"+req.ID+"/preview", body, &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
+
+// PreviewClients renders a template with variables in major mail clients,
+// including their dark modes, and returns a screenshot and the displayed HTML
+// for each. Pass clients to render in only those; by default every supported
+// client is used. Rendering can take several seconds per client.
+func (c *TemplatesClient) PreviewClients(ctx context.Context, templateID string, variables map[string]interface{}, clients ...EmailClient) (*ClientPreviewsResponse, error) {
+	body := map[string]interface{}{"variables": variables}
+	if len(clients) > 0 {
+		body["clients"] = clients
+	}
+	var resp ClientPreviewsResponse
+	if err := c.http.Post(ctx, "/mail/templates/"+templateID+"/preview-clients", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Compile compiles MJML to HTML without saving a template. With the default
+// strict validation, invalid MJML returns an error; with MJMLValidationSoft
+// the HTML is returned along with the validation errors.
+func (c *TemplatesClient) Compile(ctx context.Context, req *CompileMJMLRequest) (*CompileMJMLResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp CompileMJMLResponse
+	if err := c.http.Post(ctx, "/mail/templates/compile", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListVersions lists the versions of a template, newest first.
+func (c *TemplatesClient) ListVersions(ctx context.Context, templateID string, req *ListTemplateVersionsRequest) (*ListTemplateVersionsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req != nil {
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+	}
+
+	path := "/mail/templates/" + templateID + "/versions"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListTemplateVersionsResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetVersion retrieves a version of a template.
+func (c *TemplatesClient) GetVersion(ctx context.Context, templateID string, version int) (*TemplateVersion, error) {
+	var resp TemplateVersion
+	if err := c.http.Get(ctx, "/mail/templates/"+templateID+"/versions/"+strconv.Itoa(version), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Rollback restores the content of a previous version. The restored content
+// becomes a new version, so the rollback itself can be undone.
+func (c *TemplatesClient) Rollback(ctx context.Context, templateID string, version int) (*Template, error) {
+	var resp Template
+	body := map[string]interface{}{"version": version}
+	if err := c.http.Post(ctx, "/mail/templates/"+templateID+"/rollback", body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -100,6 +100,41 @@ func TestTemplatesClient_Get(t *testing.T) {
 	assert.True(t, resp.IsActive)
 }
 
+func TestTemplatesClient_Fields(t *testing.T) {
+	t.Run("get", func(t *testing.T) {
+		templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/mail/templates/tpl-123", r.URL.Path)
+			assert.Equal(t, "name,subject", r.URL.Query().Get("fields"))
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(Template{ID: "tpl-123", Name: "Welcome", Subject: "Hi"})
+		})
+		defer server.Close()
+
+		resp, err := templatesClient.Get(context.Background(), "tpl-123", TemplateFieldName, TemplateFieldSubject)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Welcome", resp.Name)
+		assert.Nil(t, resp.MailyJSON)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "name,slug", r.URL.Query().Get("fields"))
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ListTemplatesResponse{})
+		})
+		defer server.Close()
+
+		_, err := templatesClient.List(context.Background(), &ListTemplatesRequest{
+			Fields: []TemplateField{TemplateFieldName, TemplateFieldSlug},
+		})
+
+		require.NoError(t, err)
+	})
+}
+
 func TestTemplatesClient_GetBySlug(t *testing.T) {
 	slug := "welcome"
 	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -235,6 +270,77 @@ func TestTemplatesClient_Preview(t *testing.T) {
 	assert.Contains(t, resp.HTML, "John")
 }
 
+func TestTemplatesClient_Preview_PartialOverrides(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"footer": "<p>New footer</p>"}, body["partials"])
+		assert.Equal(t, "layout-2", body["layoutId"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PreviewTemplateResponse{HTML: "<p>New footer</p>"})
+	})
+	defer server.Close()
+
+	_, err := templatesClient.Preview(context.Background(), &PreviewTemplateRequest{
+		ID:       "tpl-123",
+		Partials: map[string]string{"footer": "<p>New footer</p>"},
+		LayoutID: ptr("layout-2"),
+	})
+
+	require.NoError(t, err)
+}
+
+func TestTemplatesClient_PreviewClients(t *testing.T) {
+	t.Run("all clients", func(t *testing.T) {
+		templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/mail/templates/tpl-123/preview-clients", r.URL.Path)
+
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]interface{}{"name": "John"}, body["variables"])
+			assert.NotContains(t, body, "clients")
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ClientPreviewsResponse{
+				Subject: "Welcome John!",
+				Previews: []ClientPreview{
+					{Client: EmailClientGmail, ScreenshotURL: "https://cdn.example.com/gmail.png"},
+					{Client: EmailClientAppleMail, DarkMode: true, ScreenshotURL: "https://cdn.example.com/apple-dark.png"},
+				},
+			})
+		})
+		defer server.Close()
+
+		resp, err := templatesClient.PreviewClients(context.Background(), "tpl-123", map[string]interface{}{"name": "John"})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Previews, 2)
+		assert.Equal(t, EmailClientGmail, resp.Previews[0].Client)
+		assert.True(t, resp.Previews[1].DarkMode)
+	})
+
+	t.Run("selected clients", func(t *testing.T) {
+		templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&body)
+			require.NoError(t, err)
+			assert.Equal(t, []interface{}{"outlook", "ios_mail"}, body["clients"])
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"previews":[]}`))
+		})
+		defer server.Close()
+
+		_, err := templatesClient.PreviewClients(context.Background(), "tpl-123", nil, EmailClientOutlook, EmailClientIOSMail)
+
+		require.NoError(t, err)
+	})
+}
+
 func TestTemplatesClient_Preview_WithTextOutput(t *testing.T) {
 	templateID := "tpl-123"
 	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -257,3 +363,276 @@ func TestTemplatesClient_Preview_WithTextOutput(t *testing.T) {
 	assert.NotNil(t, resp.Text)
 	assert.Equal(t, "Hello World!", *resp.Text)
 }
+
+func TestTemplatesClient_Preview_WithLocale(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "de-DE", body["locale"])
+		assert.Equal(t, "Europe/Berlin", body["timezone"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PreviewTemplateResponse{
+			Subject: "Ihre Bestellung",
+			HTML:    "<p>Summe: 1.234,50 €</p>",
+		})
+	})
+	defer server.Close()
+
+	resp, err := templatesClient.Preview(context.Background(), &PreviewTemplateRequest{
+		ID:        "tpl-123",
+		Variables: map[string]interface{}{"total": 1234.5},
+		Locale:    ptr("de-DE"),
+		Timezone:  ptr("Europe/Berlin"),
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, resp.HTML, "1.234,50 €")
+}
+
+func TestTemplatesClient_Preview_MissingVariables(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "keep", body["missingVariables"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(PreviewTemplateResponse{
+			Subject:          "Hi {{firstName}}",
+			HTML:             "<p>Hi {{firstName}}</p>",
+			MissingVariables: []string{"firstName"},
+		})
+	})
+	defer server.Close()
+
+	mode := MissingVariableKeep
+	resp, err := templatesClient.Preview(context.Background(), &PreviewTemplateRequest{
+		ID:               "tpl-123",
+		Variables:        map[string]interface{}{},
+		MissingVariables: &mode,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"firstName"}, resp.MissingVariables)
+}
+
+func TestTemplatesClient_ListVersions(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/templates/tpl-123/versions", r.URL.Path)
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListTemplateVersionsResponse{
+			Versions: []TemplateVersion{
+				{TemplateID: "tpl-123", Version: 2, Subject: "Welcome!"},
+				{TemplateID: "tpl-123", Version: 1, Subject: "Welcome"},
+			},
+			Total: 2,
+			Limit: 5,
+		})
+	})
+	defer server.Close()
+
+	resp, err := templatesClient.ListVersions(context.Background(), "tpl-123", &ListTemplateVersionsRequest{Limit: ptr(5)})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Versions, 2)
+	assert.Equal(t, 2, resp.Versions[0].Version)
+}
+
+func TestTemplatesClient_GetVersion(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/templates/tpl-123/versions/1", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(TemplateVersion{TemplateID: "tpl-123", Version: 1, HTML: "<p>v1</p>"})
+	})
+	defer server.Close()
+
+	resp, err := templatesClient.GetVersion(context.Background(), "tpl-123", 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, "<p>v1</p>", resp.HTML)
+}
+
+func TestTemplatesClient_Rollback(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/templates/tpl-123/rollback", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, float64(1), body["version"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Template{ID: "tpl-123", HTML: "<p>v1</p>", Version: 3})
+	})
+	defer server.Close()
+
+	resp, err := templatesClient.Rollback(context.Background(), "tpl-123", 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Version)
+}
+
+func TestTemplatesClient_Compile(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/templates/compile", r.URL.Path)
+
+		var req CompileMJMLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.MJML, "<mj-text>")
+		assert.Equal(t, MJMLValidationSoft, *req.ValidationLevel)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CompileMJMLResponse{
+			HTML:   "<!doctype html><html>...</html>",
+			Errors: []MJMLError{{Line: 3, TagName: "mj-text", Message: "Attribute foo is illegal"}},
+		})
+	})
+	defer server.Close()
+
+	level := MJMLValidationSoft
+	resp, err := templatesClient.Compile(context.Background(), &CompileMJMLRequest{
+		MJML:            "<mjml><mj-body><mj-section><mj-column><mj-text>Hi</mj-text></mj-column></mj-section></mj-body></mjml>",
+		ValidationLevel: &level,
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, resp.HTML, "<html>")
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, 3, resp.Errors[0].Line)
+}
+
+func TestTemplatesClient_Create_MJML(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "<mjml></mjml>", body["mjml"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Template{ID: "tpl-123", MJML: ptr("<mjml></mjml>"), HTML: "<html></html>"})
+	})
+	defer server.Close()
+
+	resp, err := templatesClient.Create(context.Background(), &CreateTemplateRequest{
+		Name:    "Welcome",
+		Slug:    "welcome",
+		Subject: "Welcome",
+		MJML:    ptr("<mjml></mjml>"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "<html></html>", resp.HTML)
+}
+
+func TestTemplatesClient_Update_KeepSlugAlias(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "welcome-v2", body["slug"])
+		assert.Equal(t, true, body["keepSlugAlias"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Template{ID: "tpl-123", Slug: "welcome-v2", SlugAliases: []string{"welcome"}})
+	})
+	defer server.Close()
+
+	resp, err := templatesClient.Update(context.Background(), &UpdateTemplateRequest{
+		ID:            "tpl-123",
+		Slug:          ptr("welcome-v2"),
+		KeepSlugAlias: ptr(true),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"welcome"}, resp.SlugAliases)
+}
+
+func TestTemplatesClient_GetBySlug_Alias(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/templates/slug/welcome", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id":"tpl-123","slug":"welcome-v2","slugAliases":["welcome"],"deprecatedSlug":"welcome"}`))
+	})
+	defer server.Close()
+
+	resp, err := templatesClient.GetBySlug(context.Background(), "welcome")
+
+	require.NoError(t, err)
+	assert.Equal(t, "welcome-v2", resp.Slug)
+	assert.Equal(t, "welcome", *resp.DeprecatedSlug)
+}
+
+func TestTemplatesClient_SlugAliases(t *testing.T) {
+	templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/mail/templates/tpl-123/slug-aliases", r.URL.Path)
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "welcome", body["alias"])
+			json.NewEncoder(w).Encode(TemplateSlugAliasesResponse{Slug: "welcome-v2", SlugAliases: []string{"welcome"}})
+		case http.MethodDelete:
+			assert.Equal(t, "/mail/templates/tpl-123/slug-aliases/welcome", r.URL.Path)
+			json.NewEncoder(w).Encode(TemplateSlugAliasesResponse{Slug: "welcome-v2", SlugAliases: []string{}})
+		}
+	})
+	defer server.Close()
+
+	added, err := templatesClient.AddSlugAlias(context.Background(), "tpl-123", "welcome")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"welcome"}, added.SlugAliases)
+
+	removed, err := templatesClient.RemoveSlugAlias(context.Background(), "tpl-123", "welcome")
+	require.NoError(t, err)
+	assert.Empty(t, removed.SlugAliases)
+}
+
+func TestTemplatesClient_Duplicate(t *testing.T) {
+	t.Run("to another environment", func(t *testing.T) {
+		templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/mail/templates/tpl-123/duplicate", r.URL.Path)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "welcome", body["slug"])
+			assert.Equal(t, "sandbox", body["targetEnvironment"])
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(Template{ID: "tpl-456", Slug: "welcome", Environment: types.EnvironmentSandbox, Version: 1})
+		})
+		defer server.Close()
+
+		env := types.EnvironmentSandbox
+		resp, err := templatesClient.Duplicate(context.Background(), "tpl-123", "welcome", &env)
+
+		require.NoError(t, err)
+		assert.Equal(t, "tpl-456", resp.ID)
+		assert.Equal(t, types.EnvironmentSandbox, resp.Environment)
+	})
+
+	t.Run("same environment", func(t *testing.T) {
+		templatesClient, server := setupTemplatesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "welcome-fr", body["slug"])
+			assert.NotContains(t, body, "targetEnvironment")
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(Template{ID: "tpl-789", Slug: "welcome-fr"})
+		})
+		defer server.Close()
+
+		resp, err := templatesClient.Duplicate(context.Background(), "tpl-123", "welcome-fr", nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "welcome-fr", resp.Slug)
+	})
+}
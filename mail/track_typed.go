@@ -0,0 +1,161 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// EventContact identifies the contact an event is tracked for. Set ID or
+// Email.
+type EventContact struct {
+	ID    string
+	Email string
+}
+
+// TrackTyped tracks an event whose properties are given as a struct, or any
+// other value that encodes to a JSON object, instead of a map.
+//
+// When props is a struct and the event has a properties schema, TrackTyped
+// checks before sending that every required property has a field and that
+// field types match the schema's property types. Fields are named by their
+// json tags. The schema is fetched the first time an event is tracked and
+// cached on c for five minutes.
+func TrackTyped[T any](ctx context.Context, c *EventsClient, name string, contact EventContact, props T) (*TrackEventResponse, error) {
+	schema, err := c.propertiesSchema(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if schema != nil {
+		if err := CheckEventProperties[T](schema); err != nil {
+			return nil, fmt.Errorf("event %q: %w", name, err)
+		}
+	}
+
+	b, err := json.Marshal(props)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event properties: %w", err)
+	}
+	var properties map[string]interface{}
+	if err := json.Unmarshal(b, &properties); err != nil {
+		return nil, fmt.Errorf("event properties must encode to a JSON object: %w", err)
+	}
+
+	req := &TrackEventRequest{
+		EventName:  name,
+		Properties: properties,
+	}
+	if contact.ID != "" {
+		req.ContactID = &contact.ID
+	}
+	if contact.Email != "" {
+		req.ContactEmail = &contact.Email
+	}
+	return c.Track(ctx, req)
+}
+
+// propertiesSchema returns the properties schema of the named event, or nil if
+// the event doesn't exist or has no schema. Only the schemas of events that
+// exist are cached, so an event created later is checked once it exists.
+func (c *EventsClient) propertiesSchema(ctx context.Context, name string) (*EventPropertiesSchema, error) {
+	if cached, ok := c.schemas.load(name); ok {
+		return cached, nil
+	}
+
+	offset := 0
+	for {
+		resp, err := c.List(ctx, &ListEventsRequest{Search: &name, Offset: &offset})
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range resp.Events {
+			if event.Name == name {
+				c.schemas.store(name, event.PropertiesSchema)
+				return event.PropertiesSchema, nil
+			}
+		}
+		offset += len(resp.Events)
+		if len(resp.Events) == 0 || offset >= resp.Total {
+			return nil, nil
+		}
+	}
+}
+
+// CheckEventProperties reports whether the fields of struct type T are
+// compatible with schema: every required property must have a field, and
+// fields for schema properties must have a matching type. Fields not in the
+// schema are allowed. Types other than structs are not checked.
+func CheckEventProperties[T any](schema *EventPropertiesSchema) error {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || schema == nil {
+		return nil
+	}
+
+	fields := make(map[string]reflect.Type)
+	for _, f := range types.JSONFields(t) {
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		fields[f.JSONName] = ft
+	}
+
+	var problems []string
+	for _, prop := range schema.Properties {
+		field, ok := fields[prop.Name]
+		if !ok {
+			if prop.Required != nil && *prop.Required {
+				problems = append(problems, fmt.Sprintf("missing required property %q", prop.Name))
+			}
+			continue
+		}
+		if !propertyTypeMatches(prop.Type, field) {
+			problems = append(problems, fmt.Sprintf("property %q is %s, not %s", prop.Name, field, prop.Type))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s does not match properties schema: %s", t, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// propertyTypeMatches reports whether values of Go type t encode to the JSON
+// type of an EventProperty. Unknown property types and interface fields
+// match anything.
+func propertyTypeMatches(propType string, t reflect.Type) bool {
+	if t.Kind() == reflect.Interface {
+		return true
+	}
+	switch propType {
+	case "string":
+		return t.Kind() == reflect.String
+	case "number":
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			return true
+		}
+		return false
+	case "boolean":
+		return t.Kind() == reflect.Bool
+	case "date":
+		return t == timeType || t.Kind() == reflect.String
+	case "object":
+		return (t.Kind() == reflect.Struct && t != timeType) || t.Kind() == reflect.Map
+	case "array":
+		return t.Kind() == reflect.Slice || t.Kind() == reflect.Array
+	default:
+		return true
+	}
+}
@@ -0,0 +1,187 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type purchaseProps struct {
+	Amount    float64   `json:"amount"`
+	Product   string    `json:"product"`
+	Coupon    *string   `json:"coupon,omitempty"`
+	Items     []string  `json:"items"`
+	Purchased time.Time `json:"purchasedAt"`
+	internal  string
+}
+
+func purchaseSchema() *EventPropertiesSchema {
+	required := true
+	return &EventPropertiesSchema{Properties: []EventProperty{
+		{Name: "amount", Type: "number", Required: &required},
+		{Name: "product", Type: "string", Required: &required},
+		{Name: "coupon", Type: "string"},
+		{Name: "items", Type: "array"},
+		{Name: "purchasedAt", Type: "date"},
+	}}
+}
+
+func TestTrackTyped(t *testing.T) {
+	var lists int32
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /mail/events":
+			atomic.AddInt32(&lists, 1)
+			assert.Equal(t, "purchase_completed", r.URL.Query().Get("search"))
+			json.NewEncoder(w).Encode(ListEventsResponse{Events: []MailEvent{
+				{Name: "purchase_completed_v2"},
+				{Name: "purchase_completed", PropertiesSchema: purchaseSchema()},
+			}})
+		case "POST /mail/events/track":
+			var req TrackEventRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, "purchase_completed", req.EventName)
+			assert.Equal(t, "alice@example.com", *req.ContactEmail)
+			assert.Nil(t, req.ContactID)
+			assert.Equal(t, 99.99, req.Properties["amount"])
+			assert.Equal(t, "Pro Plan", req.Properties["product"])
+			assert.NotContains(t, req.Properties, "coupon")
+			assert.NotContains(t, req.Properties, "internal")
+
+			id := "occ-1"
+			json.NewEncoder(w).Encode(TrackEventResponse{Success: true, EventOccurrenceID: &id})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	props := purchaseProps{Amount: 99.99, Product: "Pro Plan", Items: []string{"pro"}, Purchased: time.Now()}
+	for i := 0; i < 2; i++ {
+		resp, err := TrackTyped(context.Background(), eventsClient, "purchase_completed", EventContact{Email: "alice@example.com"}, props)
+		require.NoError(t, err)
+		assert.Equal(t, "occ-1", *resp.EventOccurrenceID)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&lists))
+}
+
+func TestTrackTyped_SchemaMismatch(t *testing.T) {
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatal("event should not be tracked")
+		}
+		json.NewEncoder(w).Encode(ListEventsResponse{Events: []MailEvent{
+			{Name: "purchase_completed", PropertiesSchema: purchaseSchema()},
+		}})
+	})
+	defer server.Close()
+
+	type wrongProps struct {
+		Amount string `json:"amount"`
+	}
+	_, err := TrackTyped(context.Background(), eventsClient, "purchase_completed", EventContact{ID: "contact-1"}, wrongProps{Amount: "99.99"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `property "amount" is string, not number`)
+	assert.Contains(t, err.Error(), `missing required property "product"`)
+}
+
+func TestTrackTyped_NoSchema(t *testing.T) {
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(ListEventsResponse{})
+			return
+		}
+		var req TrackEventRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "contact-1", *req.ContactID)
+		assert.Equal(t, "/pricing", req.Properties["page"])
+		json.NewEncoder(w).Encode(TrackEventResponse{Success: true})
+	})
+	defer server.Close()
+
+	type pageView struct {
+		Page string `json:"page"`
+	}
+	_, err := TrackTyped(context.Background(), eventsClient, "page_viewed", EventContact{ID: "contact-1"}, pageView{Page: "/pricing"})
+
+	require.NoError(t, err)
+}
+
+func TestTrackTyped_SchemaOnLaterPage(t *testing.T) {
+	var lists int32
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatal("event should not be tracked")
+		}
+		atomic.AddInt32(&lists, 1)
+		switch r.URL.Query().Get("search") {
+		case "purchase":
+			if r.URL.Query().Get("offset") == "0" {
+				json.NewEncoder(w).Encode(ListEventsResponse{Events: []MailEvent{{Name: "purchase_completed"}}, Total: 2})
+				return
+			}
+			json.NewEncoder(w).Encode(ListEventsResponse{Events: []MailEvent{{Name: "purchase", PropertiesSchema: purchaseSchema()}}, Total: 2, Offset: 1})
+		default:
+			json.NewEncoder(w).Encode(ListEventsResponse{})
+		}
+	})
+	defer server.Close()
+
+	type wrongProps struct {
+		Amount string `json:"amount"`
+	}
+	for i := 0; i < 2; i++ {
+		_, err := TrackTyped(context.Background(), eventsClient, "purchase", EventContact{ID: "contact-1"}, wrongProps{Amount: "ten"})
+		assert.ErrorContains(t, err, `property "amount" is string, not number`)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&lists))
+
+	// Events that don't exist yet are not cached.
+	schema, err := eventsClient.propertiesSchema(context.Background(), "signup")
+	require.NoError(t, err)
+	assert.Nil(t, schema)
+	_, err = eventsClient.propertiesSchema(context.Background(), "signup")
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&lists))
+}
+
+func TestTrackTyped_NotAnObject(t *testing.T) {
+	eventsClient, server := setupEventsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatal("event should not be tracked")
+		}
+		json.NewEncoder(w).Encode(ListEventsResponse{})
+	})
+	defer server.Close()
+
+	_, err := TrackTyped(context.Background(), eventsClient, "page_viewed", EventContact{ID: "contact-1"}, []string{"a"})
+
+	assert.Error(t, err)
+}
+
+func TestCheckEventProperties(t *testing.T) {
+	type Base struct {
+		Amount int `json:"amount"`
+	}
+	type embedded struct {
+		Base
+		Product *string `json:"product"`
+	}
+
+	assert.NoError(t, CheckEventProperties[purchaseProps](purchaseSchema()))
+	assert.NoError(t, CheckEventProperties[*embedded](&EventPropertiesSchema{Properties: []EventProperty{
+		{Name: "amount", Type: "number"},
+		{Name: "product", Type: "string"},
+	}}))
+	assert.NoError(t, CheckEventProperties[map[string]interface{}](purchaseSchema()))
+	assert.Error(t, CheckEventProperties[embedded](&EventPropertiesSchema{Properties: []EventProperty{
+		{Name: "amount", Type: "boolean"},
+	}}))
+}
@@ -13,12 +13,70 @@ type EmailAddress struct {
 	Name  string `json:"name,omitempty"`
 }
 
-// Attachment represents an email attachment.
+// Attachment represents an email attachment. Set exactly one of Content,
+// Path, AssetID, PrivateFileID or Hash. AssetID and PrivateFileID reference a
+// CDN asset or private file that is fetched at send time, so large or
+// repeated attachments don't need to be inlined; Filename and ContentType
+// default to the file's own values when empty. Hash references content
+// stored earlier with UploadAttachment by its SHA-256 hex digest; see
+// EnsureAttachment.
 type Attachment struct {
-	Filename    string `json:"filename"`
-	Content     string `json:"content"` // Base64 encoded
-	ContentType string `json:"contentType,omitempty"`
-	Path        string `json:"path,omitempty"` // URL to file
+	Filename      string `json:"filename,omitempty"`
+	Content       string `json:"content,omitempty"` // Base64 encoded
+	ContentType   string `json:"contentType,omitempty"`
+	Path          string `json:"path,omitempty"`          // URL to file
+	AssetID       string `json:"assetId,omitempty"`       // CDN asset ID
+	PrivateFileID string `json:"privateFileId,omitempty"` // CDN private file ID
+	ContentID     string `json:"contentId,omitempty"`     // Inline image, referenced as cid:ContentID
+	Hash          string `json:"hash,omitempty"`          // SHA-256 of stored content
+}
+
+// ErrCodeAttachmentNotFound is the APIError code returned when an
+// attachment's Hash does not match any stored content, e.g. because it has
+// expired.
+const ErrCodeAttachmentNotFound = "ATTACHMENT_NOT_FOUND"
+
+// StoredAttachment is attachment content stored for reuse by hash.
+type StoredAttachment struct {
+	Hash        string    `json:"hash"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// EmailAttachment is an attachment of a sent email. Inline is true for images
+// referenced from the HTML as cid:ContentID. Hash is set when the content was
+// sent by reference to a StoredAttachment.
+type EmailAttachment struct {
+	ID          string  `json:"id"`
+	Filename    string  `json:"filename"`
+	ContentType string  `json:"contentType"`
+	Size        int64   `json:"size"`
+	Inline      bool    `json:"inline"`
+	ContentID   *string `json:"contentId,omitempty"`
+	Hash        *string `json:"hash,omitempty"`
+}
+
+// EmailAttachmentsResponse is the response when listing a sent email's
+// attachments.
+type EmailAttachmentsResponse struct {
+	Attachments []EmailAttachment `json:"attachments"`
+}
+
+// InlineQRCode is a QR code generated at send time and embedded as an
+// inline image. Data may contain template variables, e.g.
+// "https://example.com/tickets/{{ticketCode}}", which are rendered per
+// recipient. Reference the image in HTML with <img src="cid:CID">.
+type InlineQRCode struct {
+	CID             string  `json:"cid"`
+	Data            string  `json:"data"`
+	Size            *int    `json:"size,omitempty"`
+	Margin          *int    `json:"margin,omitempty"`
+	ErrorCorrection *string `json:"errorCorrection,omitempty"`
+	ForegroundColor *string `json:"foregroundColor,omitempty"`
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
 }
 
 // EmailStatus represents the status of an email.
@@ -37,48 +95,108 @@ const (
 	EmailStatusUnsubscribed EmailStatus = "unsubscribed"
 )
 
-// SendEmailRequest is the request to send an email.
-type SendEmailRequest struct {
-	ProjectSlug       *string                `json:"projectSlug,omitempty"`
-	Environment       *types.Environment     `json:"environment,omitempty"`
-	From              interface{}            `json:"from"` // string or EmailAddress
-	To                interface{}            `json:"to"`   // string, EmailAddress, or []interface{}
-	CC                interface{}            `json:"cc,omitempty"`
-	BCC               interface{}            `json:"bcc,omitempty"`
-	ReplyTo           interface{}            `json:"replyTo,omitempty"`
-	Subject           string                 `json:"subject"`
-	HTML              *string                `json:"html,omitempty"`
-	Text              *string                `json:"text,omitempty"`
-	TemplateID        *string                `json:"templateId,omitempty"`
-	TemplateVariables map[string]interface{} `json:"templateVariables,omitempty"`
-	Tags              []string               `json:"tags,omitempty"`
-	Metadata          map[string]interface{} `json:"metadata,omitempty"`
-	Attachments       []Attachment           `json:"attachments,omitempty"`
-	Headers           map[string]string      `json:"headers,omitempty"`
-	ScheduledAt       *time.Time             `json:"scheduledAt,omitempty"`
-}
+// MissingVariableMode controls how template variables without a value are
+// rendered.
+type MissingVariableMode string
 
-// SendEmailResponse is the response after sending an email.
-type SendEmailResponse struct {
-	ID        string    `json:"id"`
-	From      string    `json:"from"`
-	To        string    `json:"to"`
-	Subject   string    `json:"subject"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"createdAt"`
-}
+const (
+	// MissingVariableError rejects the send or preview with an APIError with
+	// code ErrCodeMissingVariables.
+	MissingVariableError MissingVariableMode = "error"
+	// MissingVariableEmpty renders missing variables as empty strings.
+	MissingVariableEmpty MissingVariableMode = "empty"
+	// MissingVariableKeep leaves the "{{name}}" placeholder in the output.
+	MissingVariableKeep MissingVariableMode = "keep"
+)
+
+// ErrCodeMissingVariables is the APIError code returned when rendering with
+// MissingVariableError and a template variable has no value.
+const ErrCodeMissingVariables = "MISSING_VARIABLES"
 
-// SendBatchEmailRequest is the request to send batch emails.
+// SendEmailRequest is the request to send an email. Set TemplateID or
+// TemplateSlug to render a template; a slug may also be one of the template's
+// slug aliases. Set ReplyToEmailID to the ID of a previously sent email to
+// send this one as a reply to it: the In-Reply-To and References headers are
+// filled in from that email so the conversation threads in the recipient's
+// inbox.
+type SendEmailRequest struct {
+	ProjectSlug           *string                `json:"projectSlug,omitempty"`
+	Environment           *types.Environment     `json:"environment,omitempty"`
+	From                  interface{}            `json:"from" validate:"required"` // string or EmailAddress
+	To                    interface{}            `json:"to" validate:"required"`   // string, EmailAddress, or []interface{}
+	CC                    interface{}            `json:"cc,omitempty"`
+	BCC                   interface{}            `json:"bcc,omitempty"`
+	ReplyTo               interface{}            `json:"replyTo,omitempty"`
+	ReplyToEmailID        *string                `json:"replyToEmailId,omitempty"`
+	Subject               string                 `json:"subject"`
+	HTML                  *string                `json:"html,omitempty"`
+	Text                  *string                `json:"text,omitempty"`
+	TemplateID            *string                `json:"templateId,omitempty"`
+	TemplateSlug          *string                `json:"templateSlug,omitempty"`
+	TemplateVariables     map[string]interface{} `json:"templateVariables,omitempty"`
+	Tags                  []string               `json:"tags,omitempty"`
+	Metadata              map[string]interface{} `json:"metadata,omitempty"`
+	Attachments           []Attachment           `json:"attachments,omitempty" validate:"dive"`
+	Headers               map[string]string      `json:"headers,omitempty"`
+	ScheduledAt           *time.Time             `json:"scheduledAt,omitempty"`
+	IgnoreSendingCalendar *bool                  `json:"ignoreSendingCalendar,omitempty"`
+	QRCodes               []InlineQRCode         `json:"qrCodes,omitempty"`
+	MissingVariables      *MissingVariableMode   `json:"missingVariables,omitempty"`
+}
+
+// SendEmailResponse is the response after sending an email. MissingVariables
+// lists template variables that had no value when rendering.
+// DeprecatedTemplateSlug is set to the TemplateSlug of the request when it
+// matched a slug alias rather than the template's current slug. MessageID is
+// the email's Message-ID header, which replies reference.
+type SendEmailResponse struct {
+	ID                     string    `json:"id"`
+	MessageID              *string   `json:"messageId,omitempty"`
+	From                   string    `json:"from"`
+	To                     string    `json:"to"`
+	Subject                string    `json:"subject"`
+	Status                 string    `json:"status"`
+	MissingVariables       []string  `json:"missingVariables,omitempty"`
+	DeprecatedTemplateSlug *string   `json:"deprecatedTemplateSlug,omitempty"`
+	CreatedAt              time.Time `json:"createdAt"`
+}
+
+// SendBatchEmailRequest is the request to send batch emails. Environment is
+// the environment of the whole batch, which Simulate requires to be the
+// sandbox.
 type SendBatchEmailRequest struct {
 	ProjectSlug *string            `json:"projectSlug,omitempty"`
-	Emails      []SendEmailRequest `json:"emails"`
+	Environment *types.Environment `json:"environment,omitempty"`
+	Emails      []SendEmailRequest `json:"emails" validate:"required,dive"`
+	Simulate    *SimulateOptions   `json:"simulate,omitempty"`
+}
+
+// ErrCodeSimulationNotAllowed is the APIError code returned when Simulate is
+// set on a send outside the sandbox environment.
+const ErrCodeSimulationNotAllowed = "SIMULATION_NOT_ALLOWED"
+
+// SimulateOptions turns a sandbox batch or broadcast send into a load test:
+// nothing is delivered, but each email moves through realistic statuses and
+// emits the matching webhook events over SpreadMinutes. Rates are fractions
+// between 0 and 1 of the emails that reach each outcome; unset fields use the
+// API's defaults, so an empty SimulateOptions is a valid simulation.
+type SimulateOptions struct {
+	DeliveryRate  *float64 `json:"deliveryRate,omitempty"`
+	BounceRate    *float64 `json:"bounceRate,omitempty"`
+	OpenRate      *float64 `json:"openRate,omitempty"`
+	ClickRate     *float64 `json:"clickRate,omitempty"`
+	ComplaintRate *float64 `json:"complaintRate,omitempty"`
+	SpreadMinutes *int     `json:"spreadMinutes,omitempty"`
 }
 
 // BatchEmailResult represents the result of a single email in a batch.
 type BatchEmailResult struct {
-	ID      string `json:"id"`
-	Success bool   `json:"success"`
-	Error   string `json:"error,omitempty"`
+	ID                     string   `json:"id"`
+	MessageID              *string  `json:"messageId,omitempty"`
+	Success                bool     `json:"success"`
+	Error                  string   `json:"error,omitempty"`
+	MissingVariables       []string `json:"missingVariables,omitempty"`
+	DeprecatedTemplateSlug *string  `json:"deprecatedTemplateSlug,omitempty"`
 }
 
 // SendBatchEmailResponse is the response after sending batch emails.
@@ -89,18 +207,23 @@ type SendBatchEmailResponse struct {
 
 // SendBroadcastEmailRequest is the request to send a broadcast email.
 type SendBroadcastEmailRequest struct {
-	ProjectSlug       *string                `json:"projectSlug,omitempty"`
-	Environment       *types.Environment     `json:"environment,omitempty"`
-	From              interface{}            `json:"from"`
-	To                []interface{}          `json:"to"`
-	Subject           string                 `json:"subject"`
-	HTML              *string                `json:"html,omitempty"`
-	Text              *string                `json:"text,omitempty"`
-	TemplateID        *string                `json:"templateId,omitempty"`
-	TemplateVariables map[string]interface{} `json:"templateVariables,omitempty"`
-	Tags              []string               `json:"tags,omitempty"`
-	Metadata          map[string]interface{} `json:"metadata,omitempty"`
-	ScheduledAt       *time.Time             `json:"scheduledAt,omitempty"`
+	ProjectSlug           *string                `json:"projectSlug,omitempty"`
+	Environment           *types.Environment     `json:"environment,omitempty"`
+	From                  interface{}            `json:"from" validate:"required"`
+	To                    []interface{}          `json:"to" validate:"required"`
+	Subject               string                 `json:"subject"`
+	HTML                  *string                `json:"html,omitempty"`
+	Text                  *string                `json:"text,omitempty"`
+	TemplateID            *string                `json:"templateId,omitempty"`
+	TemplateSlug          *string                `json:"templateSlug,omitempty"`
+	TemplateVariables     map[string]interface{} `json:"templateVariables,omitempty"`
+	Tags                  []string               `json:"tags,omitempty"`
+	Metadata              map[string]interface{} `json:"metadata,omitempty"`
+	ScheduledAt           *time.Time             `json:"scheduledAt,omitempty"`
+	IgnoreSendingCalendar *bool                  `json:"ignoreSendingCalendar,omitempty"`
+	QRCodes               []InlineQRCode         `json:"qrCodes,omitempty"`
+	MissingVariables      *MissingVariableMode   `json:"missingVariables,omitempty"`
+	Simulate              *SimulateOptions       `json:"simulate,omitempty"`
 }
 
 // SendBroadcastEmailResponse is the response after sending a broadcast email.
@@ -129,9 +252,17 @@ type GetEmailResponse struct {
 	OpenedAt          *time.Time             `json:"openedAt"`
 	ClickedAt         *time.Time             `json:"clickedAt"`
 	BouncedAt         *time.Time             `json:"bouncedAt"`
+	MessageID         *string                `json:"messageId"`
 	ProviderMessageID *string                `json:"providerMessageId"`
 }
 
+// GetManyEmailsResponse is the response when getting emails by ID. NotFound
+// lists requested IDs that don't exist.
+type GetManyEmailsResponse struct {
+	Emails   []GetEmailResponse `json:"emails"`
+	NotFound []string           `json:"notFound"`
+}
+
 // ListEmailsRequest is the request to list emails.
 type ListEmailsRequest struct {
 	ProjectSlug *string            `url:"projectSlug,omitempty"`
@@ -147,6 +278,7 @@ type ListEmailsRequest struct {
 	EndDate     *time.Time         `url:"endDate,omitempty"`
 	SortBy      *string            `url:"sortBy,omitempty"`
 	SortOrder   *string            `url:"sortOrder,omitempty"`
+	Metadata    map[string]string  `url:"metadata,omitempty"`
 }
 
 // Email represents an email in a list.
@@ -177,6 +309,45 @@ type ListEmailsResponse struct {
 	Offset int     `json:"offset"`
 }
 
+// SearchField is a field that full-text email search can match against.
+type SearchField string
+
+const (
+	SearchFieldSubject   SearchField = "subject"
+	SearchFieldBody      SearchField = "body"
+	SearchFieldRecipient SearchField = "recipient"
+)
+
+// SearchEmailsFilters narrows a full-text email search.
+// When Fields is empty, subject, body and recipient are all searched.
+type SearchEmailsFilters struct {
+	ProjectSlug *string            `url:"projectSlug,omitempty"`
+	Environment *types.Environment `url:"environment,omitempty"`
+	Fields      []SearchField      `url:"fields,omitempty"`
+	Status      *EmailStatus       `url:"status,omitempty"`
+	From        *string            `url:"from,omitempty"`
+	Tag         *string            `url:"tag,omitempty"`
+	StartDate   *time.Time         `url:"startDate,omitempty"`
+	EndDate     *time.Time         `url:"endDate,omitempty"`
+	Limit       *int               `url:"limit,omitempty"`
+	Cursor      *string            `url:"cursor,omitempty"`
+}
+
+// EmailSearchResult is a single email matched by a search, with its relevance score.
+type EmailSearchResult struct {
+	Email
+	Score      float64             `json:"score"`
+	Highlights map[string][]string `json:"highlights,omitempty"`
+}
+
+// SearchEmailsResponse is the response from a full-text email search.
+// Results are ordered by relevance, most relevant first.
+type SearchEmailsResponse struct {
+	Results    []EmailSearchResult `json:"results"`
+	Total      int                 `json:"total"`
+	NextCursor *string             `json:"nextCursor,omitempty"`
+}
+
 // ResendEmailResponse is the response when resending an email.
 type ResendEmailResponse struct {
 	Success bool `json:"success"`
@@ -192,6 +363,20 @@ type CancelEmailResponse struct {
 	Success bool `json:"success"`
 }
 
+// EmailAnalyticsRequest filters email analytics. All fields are optional;
+// a nil request returns analytics across all emails.
+type EmailAnalyticsRequest struct {
+	ProjectSlug *string            `url:"projectSlug,omitempty"`
+	Environment *types.Environment `url:"environment,omitempty"`
+	Tag         *string            `url:"tag,omitempty"`
+	From        *string            `url:"from,omitempty"`
+	Domain      *string            `url:"domain,omitempty"`
+	TemplateID  *string            `url:"templateId,omitempty"`
+	CampaignID  *string            `url:"campaignId,omitempty"`
+	StartDate   *time.Time         `url:"startDate,omitempty"`
+	EndDate     *time.Time         `url:"endDate,omitempty"`
+}
+
 // EmailAnalyticsResponse contains email analytics data.
 type EmailAnalyticsResponse struct {
 	Total        int     `json:"total"`
@@ -202,6 +387,269 @@ type EmailAnalyticsResponse struct {
 	DeliveryRate float64 `json:"deliveryRate"`
 	OpenRate     float64 `json:"openRate"`
 	ClickRate    float64 `json:"clickRate"`
+	Failovers    int     `json:"failovers"`
+}
+
+// AnalyticsScope selects the project and environment that analytics are
+// computed for, like EmailAnalyticsRequest.ProjectSlug and Environment. Both
+// are optional; unset fields fall back to the API key's defaults.
+type AnalyticsScope struct {
+	ProjectSlug *string
+	Environment *types.Environment
+}
+
+// AnalyticsDateRange is an inclusive date range for analytics queries.
+type AnalyticsDateRange struct {
+	Start time.Time
+	End   time.Time
+}
+
+// AnalyticsDelta compares a metric between two periods. PercentChange is
+// nil when the previous value is zero.
+type AnalyticsDelta struct {
+	Current       float64  `json:"current"`
+	Previous      float64  `json:"previous"`
+	Change        float64  `json:"change"`
+	PercentChange *float64 `json:"percentChange"`
+}
+
+// AnalyticsComparisonResponse contains analytics for two periods and the
+// change between them.
+type AnalyticsComparisonResponse struct {
+	Current      EmailAnalyticsResponse `json:"current"`
+	Previous     EmailAnalyticsResponse `json:"previous"`
+	Sent         AnalyticsDelta         `json:"sent"`
+	Delivered    AnalyticsDelta         `json:"delivered"`
+	DeliveryRate AnalyticsDelta         `json:"deliveryRate"`
+	OpenRate     AnalyticsDelta         `json:"openRate"`
+	ClickRate    AnalyticsDelta         `json:"clickRate"`
+}
+
+// MailboxProvider identifies a recipient mailbox provider.
+type MailboxProvider string
+
+const (
+	MailboxProviderGmail   MailboxProvider = "gmail"
+	MailboxProviderOutlook MailboxProvider = "outlook"
+	MailboxProviderYahoo   MailboxProvider = "yahoo"
+	MailboxProviderApple   MailboxProvider = "apple"
+	MailboxProviderOther   MailboxProvider = "other"
+)
+
+// DomainReputationLevel is a sending domain's reputation as reported by
+// mailbox providers.
+type DomainReputationLevel string
+
+const (
+	DomainReputationHigh    DomainReputationLevel = "high"
+	DomainReputationMedium  DomainReputationLevel = "medium"
+	DomainReputationLow     DomainReputationLevel = "low"
+	DomainReputationBad     DomainReputationLevel = "bad"
+	DomainReputationUnknown DomainReputationLevel = "unknown"
+)
+
+// DeliverabilityReportRequest is the request for a deliverability report.
+type DeliverabilityReportRequest struct {
+	ProjectSlug *string            `url:"projectSlug,omitempty"`
+	Environment *types.Environment `url:"environment,omitempty"`
+	Domain      *string            `url:"domain,omitempty"`
+	StartDate   *time.Time         `url:"startDate,omitempty"`
+	EndDate     *time.Time         `url:"endDate,omitempty"`
+}
+
+// ProviderDeliverability contains delivery metrics for a mailbox provider.
+type ProviderDeliverability struct {
+	Provider     MailboxProvider `json:"provider"`
+	Sent         int             `json:"sent"`
+	Delivered    int             `json:"delivered"`
+	Bounced      int             `json:"bounced"`
+	SpamFolder   int             `json:"spamFolder"`
+	DeliveryRate float64         `json:"deliveryRate"`
+	BounceRate   float64         `json:"bounceRate"`
+	SpamRate     float64         `json:"spamRate"`
+}
+
+// FallbackProviderType identifies the backend used by the fallback provider.
+type FallbackProviderType string
+
+const (
+	FallbackProviderSMTP     FallbackProviderType = "smtp"
+	FallbackProviderSendGrid FallbackProviderType = "sendgrid"
+	FallbackProviderPostmark FallbackProviderType = "postmark"
+	FallbackProviderMailgun  FallbackProviderType = "mailgun"
+)
+
+// SMTPServer holds the connection details for an SMTP relay used as a
+// fallback or sending provider.
+type SMTPServer struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+// FallbackProvider is the configured fallback sending provider. Secrets are
+// never returned: the SMTP password is omitted and only the last four
+// characters of an API key are included.
+type FallbackProvider struct {
+	Type                  FallbackProviderType `json:"type"`
+	Enabled               bool                 `json:"enabled"`
+	SMTP                  *SMTPServer          `json:"smtp"`
+	APIKeyLast4           *string              `json:"apiKeyLast4"`
+	DeferThresholdMinutes int                  `json:"deferThresholdMinutes"`
+	CreatedAt             time.Time            `json:"createdAt"`
+	UpdatedAt             *time.Time           `json:"updatedAt"`
+}
+
+// SetFallbackProviderRequest configures the fallback provider. Set SMTP for
+// FallbackProviderSMTP and APIKey for the other types. Emails still deferred
+// by the primary provider after DeferThresholdMinutes are sent through the
+// fallback instead.
+type SetFallbackProviderRequest struct {
+	Type                  FallbackProviderType `json:"type" validate:"required"`
+	SMTP                  *SMTPServer          `json:"smtp,omitempty"`
+	APIKey                *string              `json:"apiKey,omitempty"`
+	DeferThresholdMinutes int                  `json:"deferThresholdMinutes"`
+	Enabled               *bool                `json:"enabled,omitempty"`
+}
+
+// DeleteFallbackProviderResponse is the response when deleting the fallback
+// provider.
+type DeleteFallbackProviderResponse struct {
+	Success bool `json:"success"`
+}
+
+// SMTPCredential is a username and password for sending through the Stack0
+// SMTP relay. The password is only returned when the credential is created.
+type SMTPCredential struct {
+	ID                 string     `json:"id"`
+	Name               string     `json:"name"`
+	Username           string     `json:"username"`
+	Environment        string     `json:"environment"`
+	AllowedFromDomains []string   `json:"allowedFromDomains,omitempty"`
+	ExpiresAt          *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt         *time.Time `json:"lastUsedAt,omitempty"`
+	RevokedAt          *time.Time `json:"revokedAt,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
+// CreateSMTPCredentialsRequest is the request to create SMTP credentials.
+// AllowedFromDomains restricts the sender addresses the credentials may use
+// to verified domains; when empty any verified domain may be used.
+type CreateSMTPCredentialsRequest struct {
+	Environment        *types.Environment `json:"environment,omitempty"`
+	Name               string             `json:"name" validate:"required"`
+	AllowedFromDomains []string           `json:"allowedFromDomains,omitempty"`
+	ExpiresAt          *time.Time         `json:"expiresAt,omitempty"`
+}
+
+// CreateSMTPCredentialsResponse is the response when creating SMTP
+// credentials. SMTP holds the relay host, port, username and password to
+// configure in the sending application; the password cannot be retrieved
+// again.
+type CreateSMTPCredentialsResponse struct {
+	Credential SMTPCredential `json:"credential"`
+	SMTP       SMTPServer     `json:"smtp"`
+}
+
+// ListSMTPCredentialsRequest is the request to list SMTP credentials.
+type ListSMTPCredentialsRequest struct {
+	Environment    *types.Environment `url:"environment,omitempty"`
+	IncludeRevoked *bool              `url:"includeRevoked,omitempty"`
+}
+
+// ListSMTPCredentialsResponse is the response when listing SMTP credentials.
+type ListSMTPCredentialsResponse struct {
+	Credentials []SMTPCredential `json:"credentials"`
+}
+
+// RevokeSMTPCredentialResponse is the response when revoking SMTP
+// credentials.
+type RevokeSMTPCredentialResponse struct {
+	Success bool `json:"success"`
+}
+
+// Failover records an email that was handed to the fallback provider.
+type Failover struct {
+	ID              string               `json:"id"`
+	EmailID         string               `json:"emailId"`
+	Provider        FallbackProviderType `json:"provider"`
+	Reason          string               `json:"reason"`
+	DeferredSeconds int                  `json:"deferredSeconds"`
+	Delivered       bool                 `json:"delivered"`
+	FailedOverAt    time.Time            `json:"failedOverAt"`
+}
+
+// ListFailoversRequest is the request to list failovers.
+type ListFailoversRequest struct {
+	StartDate *time.Time `url:"startDate,omitempty"`
+	EndDate   *time.Time `url:"endDate,omitempty"`
+	Limit     *int       `url:"limit,omitempty"`
+	Offset    *int       `url:"offset,omitempty"`
+}
+
+// ListFailoversResponse is the response when listing failovers.
+type ListFailoversResponse struct {
+	Failovers []Failover `json:"failovers"`
+	Total     int        `json:"total"`
+	Limit     int        `json:"limit"`
+	Offset    int        `json:"offset"`
+}
+
+// DomainReputation contains reputation signals for a sending domain.
+type DomainReputation struct {
+	Domain        string                `json:"domain"`
+	Reputation    DomainReputationLevel `json:"reputation"`
+	SPFAligned    bool                  `json:"spfAligned"`
+	DKIMAligned   bool                  `json:"dkimAligned"`
+	DMARCAligned  bool                  `json:"dmarcAligned"`
+	ComplaintRate float64               `json:"complaintRate"`
+	Blocklists    []string              `json:"blocklists"`
+}
+
+// DeliverabilityReportResponse contains per-provider delivery metrics and
+// domain reputation signals for a period.
+type DeliverabilityReportResponse struct {
+	PeriodStart time.Time                `json:"periodStart"`
+	PeriodEnd   time.Time                `json:"periodEnd"`
+	Providers   []ProviderDeliverability `json:"providers"`
+	Domains     []DomainReputation       `json:"domains"`
+}
+
+// SnapshotCounts are email totals for a metrics snapshot.
+type SnapshotCounts struct {
+	Total        int `json:"total"`
+	Sent         int `json:"sent"`
+	Delivered    int `json:"delivered"`
+	Opened       int `json:"opened"`
+	Clicked      int `json:"clicked"`
+	Bounced      int `json:"bounced"`
+	Complained   int `json:"complained"`
+	Unsubscribed int `json:"unsubscribed"`
+	Failed       int `json:"failed"`
+}
+
+// SnapshotBreakdown is the totals for a single tag, template, domain or
+// campaign. Key is the tag, domain, or the template or campaign ID; Name is
+// set for templates and campaigns.
+type SnapshotBreakdown struct {
+	Key  string  `json:"key"`
+	Name *string `json:"name,omitempty"`
+	SnapshotCounts
+}
+
+// MetricsSnapshot contains email totals for a time window broken down by
+// status, tag, template, domain and campaign.
+type MetricsSnapshot struct {
+	PeriodStart time.Time           `json:"periodStart"`
+	PeriodEnd   time.Time           `json:"periodEnd"`
+	GeneratedAt time.Time           `json:"generatedAt"`
+	Totals      SnapshotCounts      `json:"totals"`
+	ByStatus    map[EmailStatus]int `json:"byStatus"`
+	ByTag       []SnapshotBreakdown `json:"byTag"`
+	ByTemplate  []SnapshotBreakdown `json:"byTemplate"`
+	ByDomain    []SnapshotBreakdown `json:"byDomain"`
+	ByCampaign  []SnapshotBreakdown `json:"byCampaign"`
 }
 
 // TimeSeriesAnalyticsRequest is the request for time series analytics.
@@ -290,6 +738,10 @@ type Domain struct {
 	VerificationToken     *string      `json:"verificationToken"`
 	SESVerificationRecord *DNSRecord   `json:"sesVerificationRecord"`
 	IsDefault             bool         `json:"isDefault"`
+	ReturnPathDomain      *string      `json:"returnPathDomain,omitempty"`
+	TrackingDomain        *string      `json:"trackingDomain,omitempty"`
+	OpenTracking          bool         `json:"openTracking"`
+	ClickTracking         bool         `json:"clickTracking"`
 	VerifiedAt            *time.Time   `json:"verifiedAt"`
 	LastCheckedAt         *time.Time   `json:"lastCheckedAt"`
 	CreatedAt             time.Time    `json:"createdAt"`
@@ -302,9 +754,13 @@ type ListDomainsRequest struct {
 	Environment *types.Environment `url:"environment,omitempty"`
 }
 
-// AddDomainRequest is the request to add a domain.
+// AddDomainRequest is the request to add a domain. ProjectSlug and
+// Environment select the project the domain is added to; the API key's
+// default project and environment are used when they are unset.
 type AddDomainRequest struct {
-	Domain string `json:"domain"`
+	ProjectSlug *string            `json:"projectSlug,omitempty"`
+	Environment *types.Environment `json:"environment,omitempty"`
+	Domain      string             `json:"domain" validate:"required"`
 }
 
 // AddDomainResponse is the response when adding a domain.
@@ -327,6 +783,8 @@ type GetDNSRecordsResponse struct {
 	SPFRecord             *DNSRecord   `json:"spfRecord"`
 	DMARCRecord           *DNSRecord   `json:"dmarcRecord"`
 	SESVerificationRecord *DNSRecord   `json:"sesVerificationRecord"`
+	ReturnPathRecords     []DNSRecord  `json:"returnPathRecords,omitempty"`
+	TrackingRecord        *DNSRecord   `json:"trackingRecord,omitempty"`
 	Status                DomainStatus `json:"status"`
 	VerifiedAt            *time.Time   `json:"verifiedAt"`
 	VerificationDetails   *struct {
@@ -337,6 +795,18 @@ type GetDNSRecordsResponse struct {
 	} `json:"verificationDetails,omitempty"`
 }
 
+// UpdateDomainRequest is the request to update a domain. ReturnPathSubdomain
+// and TrackingSubdomain are subdomains of the domain, e.g. "bounce" and
+// "links"; their DNS records are listed by GetDNSRecords once set. Pass an
+// empty string to remove one.
+type UpdateDomainRequest struct {
+	ID                  string  `validate:"required"`
+	ReturnPathSubdomain *string `json:"returnPathSubdomain,omitempty"`
+	TrackingSubdomain   *string `json:"trackingSubdomain,omitempty"`
+	OpenTracking        *bool   `json:"openTracking,omitempty"`
+	ClickTracking       *bool   `json:"clickTracking,omitempty"`
+}
+
 // VerifyDomainResponse is the response when verifying a domain.
 type VerifyDomainResponse struct {
 	Verified bool   `json:"verified"`
@@ -348,7 +818,61 @@ type DeleteDomainResponse struct {
 	Success bool `json:"success"`
 }
 
-// Template represents an email template.
+// DNSCheckStatus is the outcome of checking one DNS record with CheckDNS.
+type DNSCheckStatus string
+
+const (
+	DNSCheckStatusOK       DNSCheckStatus = "ok"
+	DNSCheckStatusMissing  DNSCheckStatus = "missing"
+	DNSCheckStatusMismatch DNSCheckStatus = "mismatch"
+	DNSCheckStatusError    DNSCheckStatus = "error"
+)
+
+// DNSRecordCheck is the result of looking up one expected DNS record. Found
+// holds the values the resolver returned for the record's name and type, and
+// Error the lookup error when Status is DNSCheckStatusError.
+type DNSRecordCheck struct {
+	Record DNSRecord
+	Status DNSCheckStatus
+	Found  []string
+	Error  string
+}
+
+// DNSCheckResult is the result of CheckDNS. Ready is true when every
+// expected record was found with the expected value.
+type DNSCheckResult struct {
+	Domain  string
+	Records []DNSRecordCheck
+	Ready   bool
+}
+
+// TemplateField names a field of Template for use with field selection on
+// Templates.Get and Templates.List. Requesting only the fields you need
+// avoids transferring large values such as MailyJSON; ID is always returned.
+type TemplateField string
+
+const (
+	TemplateFieldName            TemplateField = "name"
+	TemplateFieldSlug            TemplateField = "slug"
+	TemplateFieldSlugAliases     TemplateField = "slugAliases"
+	TemplateFieldDescription     TemplateField = "description"
+	TemplateFieldSubject         TemplateField = "subject"
+	TemplateFieldPreviewText     TemplateField = "previewText"
+	TemplateFieldHTML            TemplateField = "html"
+	TemplateFieldText            TemplateField = "text"
+	TemplateFieldMJML            TemplateField = "mjml"
+	TemplateFieldLayoutID        TemplateField = "layoutId"
+	TemplateFieldMailyJSON       TemplateField = "mailyJson"
+	TemplateFieldVariablesSchema TemplateField = "variablesSchema"
+	TemplateFieldIsActive        TemplateField = "isActive"
+	TemplateFieldVersion         TemplateField = "version"
+	TemplateFieldCreatedAt       TemplateField = "createdAt"
+	TemplateFieldUpdatedAt       TemplateField = "updatedAt"
+)
+
+// Template represents an email template. SlugAliases are previous slugs that
+// still resolve to the template. DeprecatedSlug is set when the template was
+// looked up by one of them.
 type Template struct {
 	ID              string                 `json:"id"`
 	OrganizationID  string                 `json:"organizationId"`
@@ -356,43 +880,56 @@ type Template struct {
 	CreatedByUserID *string                `json:"createdByUserId"`
 	Name            string                 `json:"name"`
 	Slug            string                 `json:"slug"`
+	SlugAliases     []string               `json:"slugAliases"`
+	DeprecatedSlug  *string                `json:"deprecatedSlug,omitempty"`
 	Description     *string                `json:"description"`
 	Subject         string                 `json:"subject"`
 	PreviewText     *string                `json:"previewText"`
 	HTML            string                 `json:"html"`
 	Text            *string                `json:"text"`
+	MJML            *string                `json:"mjml"`
+	LayoutID        *string                `json:"layoutId"`
 	MailyJSON       map[string]interface{} `json:"mailyJson"`
 	VariablesSchema map[string]interface{} `json:"variablesSchema"`
 	IsActive        bool                   `json:"isActive"`
+	Version         int                    `json:"version"`
 	CreatedAt       time.Time              `json:"createdAt"`
 	UpdatedAt       *time.Time             `json:"updatedAt"`
 }
 
-// CreateTemplateRequest is the request to create a template.
+// CreateTemplateRequest is the request to create a template. When MJML is
+// set, HTML is compiled from it and may be left empty.
 type CreateTemplateRequest struct {
 	Environment     *types.Environment     `json:"environment,omitempty"`
-	Name            string                 `json:"name"`
-	Slug            string                 `json:"slug"`
+	Name            string                 `json:"name" validate:"required"`
+	Slug            string                 `json:"slug" validate:"required"`
 	Description     *string                `json:"description,omitempty"`
 	Subject         string                 `json:"subject"`
 	PreviewText     *string                `json:"previewText,omitempty"`
 	HTML            string                 `json:"html"`
 	Text            *string                `json:"text,omitempty"`
+	MJML            *string                `json:"mjml,omitempty"`
+	LayoutID        *string                `json:"layoutId,omitempty"`
 	MailyJSON       map[string]interface{} `json:"mailyJson,omitempty"`
 	VariablesSchema map[string]interface{} `json:"variablesSchema,omitempty"`
 	IsActive        *bool                  `json:"isActive,omitempty"`
 }
 
-// UpdateTemplateRequest is the request to update a template.
+// UpdateTemplateRequest is the request to update a template. Setting MJML
+// recompiles HTML from it. When Slug changes and KeepSlugAlias is true, the
+// previous slug becomes an alias so sends that reference it keep working.
 type UpdateTemplateRequest struct {
-	ID              string
+	ID              string                 `validate:"required"`
 	Name            *string                `json:"name,omitempty"`
 	Slug            *string                `json:"slug,omitempty"`
+	KeepSlugAlias   *bool                  `json:"keepSlugAlias,omitempty"`
 	Description     *string                `json:"description,omitempty"`
 	Subject         *string                `json:"subject,omitempty"`
 	PreviewText     *string                `json:"previewText,omitempty"`
 	HTML            *string                `json:"html,omitempty"`
 	Text            *string                `json:"text,omitempty"`
+	MJML            *string                `json:"mjml,omitempty"`
+	LayoutID        *string                `json:"layoutId,omitempty"`
 	MailyJSON       map[string]interface{} `json:"mailyJson,omitempty"`
 	VariablesSchema map[string]interface{} `json:"variablesSchema,omitempty"`
 	IsActive        *bool                  `json:"isActive,omitempty"`
@@ -405,6 +942,7 @@ type ListTemplatesRequest struct {
 	Offset      *int               `url:"offset,omitempty"`
 	IsActive    *bool              `url:"isActive,omitempty"`
 	Search      *string            `url:"search,omitempty"`
+	Fields      []TemplateField    `url:"fields,omitempty"`
 }
 
 // ListTemplatesResponse is the response when listing templates.
@@ -421,16 +959,216 @@ type DeleteTemplateResponse struct {
 }
 
 // PreviewTemplateRequest is the request to preview a template.
+//
+// Templates may apply locale-aware filters to variables, which are rendered
+// using Locale and Timezone:
+//
+//	{{ total | currency: "EUR" }}
+//	{{ orderDate | date: "long" }}
+//	{{ count | number: 0 }}
+//	{{ itemCount | plural: "# item", "# items" }}
+//
+// Partials and the template's layout are resolved as when sending. To see a
+// change to a shared block before saving it, Partials overrides the HTML of
+// partials by slug and LayoutID previews the template with another layout.
 type PreviewTemplateRequest struct {
-	ID        string
-	Variables map[string]interface{} `json:"variables"`
+	ID               string                 `validate:"required"`
+	Variables        map[string]interface{} `json:"variables"`
+	Locale           *string                `json:"locale,omitempty"`
+	Timezone         *string                `json:"timezone,omitempty"`
+	MissingVariables *MissingVariableMode   `json:"missingVariables,omitempty"`
+	Partials         map[string]string      `json:"partials,omitempty"`
+	LayoutID         *string                `json:"layoutId,omitempty"`
 }
 
 // PreviewTemplateResponse is the response when previewing a template.
 type PreviewTemplateResponse struct {
-	Subject string  `json:"subject"`
-	HTML    string  `json:"html"`
-	Text    *string `json:"text"`
+	Subject          string   `json:"subject"`
+	HTML             string   `json:"html"`
+	Text             *string  `json:"text"`
+	MissingVariables []string `json:"missingVariables,omitempty"`
+}
+
+// EmailClient is a mail client that templates can be preview-rendered in.
+type EmailClient string
+
+const (
+	EmailClientGmail       EmailClient = "gmail"
+	EmailClientGmailMobile EmailClient = "gmail_mobile"
+	EmailClientOutlook     EmailClient = "outlook"
+	EmailClientOutlookWeb  EmailClient = "outlook_web"
+	EmailClientAppleMail   EmailClient = "apple_mail"
+	EmailClientIOSMail     EmailClient = "ios_mail"
+)
+
+// ClientPreview is a template rendered in one mail client. HTML is the markup
+// as that client displays it, after its sanitizing and CSS rewriting, and
+// ScreenshotURL is an image of the rendered email. Clients with a dark mode
+// are rendered twice, once with DarkMode set.
+type ClientPreview struct {
+	Client        EmailClient `json:"client"`
+	DarkMode      bool        `json:"darkMode"`
+	ScreenshotURL string      `json:"screenshotUrl"`
+	HTML          string      `json:"html"`
+	Width         int         `json:"width"`
+	Height        int         `json:"height"`
+	Error         *string     `json:"error,omitempty"`
+}
+
+// ClientPreviewsResponse is the response when previewing a template across
+// mail clients.
+type ClientPreviewsResponse struct {
+	Subject          string          `json:"subject"`
+	Previews         []ClientPreview `json:"previews"`
+	MissingVariables []string        `json:"missingVariables,omitempty"`
+}
+
+// MJMLValidationLevel controls how MJML validation errors are handled when
+// compiling.
+type MJMLValidationLevel string
+
+const (
+	// MJMLValidationStrict fails compilation on any validation error.
+	MJMLValidationStrict MJMLValidationLevel = "strict"
+	// MJMLValidationSoft compiles anyway and reports validation errors.
+	MJMLValidationSoft MJMLValidationLevel = "soft"
+	// MJMLValidationSkip compiles without validating.
+	MJMLValidationSkip MJMLValidationLevel = "skip"
+)
+
+// CompileMJMLRequest is the request to compile MJML to HTML.
+type CompileMJMLRequest struct {
+	MJML            string               `json:"mjml" validate:"required"`
+	Minify          *bool                `json:"minify,omitempty"`
+	ValidationLevel *MJMLValidationLevel `json:"validationLevel,omitempty"`
+}
+
+// MJMLError is an MJML validation error.
+type MJMLError struct {
+	Line    int    `json:"line"`
+	TagName string `json:"tagName"`
+	Message string `json:"message"`
+}
+
+// CompileMJMLResponse is the response when compiling MJML.
+type CompileMJMLResponse struct {
+	HTML   string      `json:"html"`
+	Errors []MJMLError `json:"errors,omitempty"`
+}
+
+// TemplateSlugAliasesResponse is the response when adding or removing a
+// template slug alias.
+type TemplateSlugAliasesResponse struct {
+	Slug        string   `json:"slug"`
+	SlugAliases []string `json:"slugAliases"`
+}
+
+// TemplateVersion is a snapshot of a template's content. Every update of the
+// content creates a new version, numbered from 1.
+type TemplateVersion struct {
+	TemplateID      string                 `json:"templateId"`
+	Version         int                    `json:"version"`
+	Subject         string                 `json:"subject"`
+	PreviewText     *string                `json:"previewText"`
+	HTML            string                 `json:"html"`
+	Text            *string                `json:"text"`
+	MJML            *string                `json:"mjml"`
+	MailyJSON       map[string]interface{} `json:"mailyJson"`
+	VariablesSchema map[string]interface{} `json:"variablesSchema"`
+	CreatedByUserID *string                `json:"createdByUserId"`
+	// RolledBackFrom is the version this version restored, if it was created
+	// by a rollback.
+	RolledBackFrom *int      `json:"rolledBackFrom"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// ListTemplateVersionsRequest is the request to list a template's versions.
+type ListTemplateVersionsRequest struct {
+	Limit  *int `url:"limit,omitempty"`
+	Offset *int `url:"offset,omitempty"`
+}
+
+// ListTemplateVersionsResponse lists a template's versions, newest first.
+type ListTemplateVersionsResponse struct {
+	Versions []TemplateVersion `json:"versions"`
+	Total    int               `json:"total"`
+	Limit    int               `json:"limit"`
+	Offset   int               `json:"offset"`
+}
+
+// PartialKind distinguishes layouts, which wrap a template's body, from
+// partials, which are included in it.
+type PartialKind string
+
+const (
+	PartialKindPartial PartialKind = "partial"
+	PartialKindLayout  PartialKind = "layout"
+)
+
+// Partial is a reusable block of template content, such as a branded header
+// or footer. Templates include a partial by slug with "{{> footer }}". A
+// layout wraps every template that sets it as LayoutID, with "{{> content }}"
+// marking where the template's body goes. Partials may include other
+// partials and use template variables. TemplateCount is the number of
+// templates that use it.
+type Partial struct {
+	ID              string            `json:"id"`
+	OrganizationID  string            `json:"organizationId"`
+	Environment     types.Environment `json:"environment"`
+	Kind            PartialKind       `json:"kind"`
+	Name            string            `json:"name"`
+	Slug            string            `json:"slug"`
+	Description     *string           `json:"description"`
+	HTML            string            `json:"html"`
+	Text            *string           `json:"text"`
+	TemplateCount   int               `json:"templateCount"`
+	CreatedByUserID *string           `json:"createdByUserId"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	UpdatedAt       *time.Time        `json:"updatedAt"`
+}
+
+// CreatePartialRequest is the request to create a partial or layout. Kind
+// defaults to PartialKindPartial.
+type CreatePartialRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	Kind        *PartialKind       `json:"kind,omitempty"`
+	Name        string             `json:"name" validate:"required"`
+	Slug        string             `json:"slug" validate:"required"`
+	Description *string            `json:"description,omitempty"`
+	HTML        string             `json:"html" validate:"required"`
+	Text        *string            `json:"text,omitempty"`
+}
+
+// UpdatePartialRequest is the request to update a partial. Changes apply to
+// every template that uses it from the next send.
+type UpdatePartialRequest struct {
+	ID          string  `json:"-" validate:"required"`
+	Name        *string `json:"name,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	Description *string `json:"description,omitempty"`
+	HTML        *string `json:"html,omitempty"`
+	Text        *string `json:"text,omitempty"`
+}
+
+// ListPartialsRequest is the request to list partials.
+type ListPartialsRequest struct {
+	Environment *types.Environment
+	Kind        *PartialKind
+	Limit       *int
+	Offset      *int
+}
+
+// ListPartialsResponse is the response when listing partials.
+type ListPartialsResponse struct {
+	Partials []Partial `json:"partials"`
+	Total    int       `json:"total"`
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+}
+
+// DeletePartialResponse is the response when deleting a partial.
+type DeletePartialResponse struct {
+	Success bool `json:"success"`
 }
 
 // Audience represents a contact audience.
@@ -452,13 +1190,13 @@ type Audience struct {
 // CreateAudienceRequest is the request to create an audience.
 type CreateAudienceRequest struct {
 	Environment *types.Environment `json:"environment,omitempty"`
-	Name        string             `json:"name"`
+	Name        string             `json:"name" validate:"required"`
 	Description *string            `json:"description,omitempty"`
 }
 
 // UpdateAudienceRequest is the request to update an audience.
 type UpdateAudienceRequest struct {
-	ID          string
+	ID          string  `validate:"required"`
 	Name        *string `json:"name,omitempty"`
 	Description *string `json:"description,omitempty"`
 }
@@ -484,10 +1222,25 @@ type DeleteAudienceResponse struct {
 	Success bool `json:"success"`
 }
 
+// DuplicateAudienceRequest sets options for the copy made by
+// AudiencesClient.Duplicate. Name defaults to the original's name.
+// IncludeContacts also copies the audience's contacts, creating them in the
+// target environment where they don't already exist by email.
+type DuplicateAudienceRequest struct {
+	Name            *string `json:"name,omitempty"`
+	IncludeContacts *bool   `json:"includeContacts,omitempty"`
+}
+
+// DuplicateAudienceResponse is the response when duplicating an audience.
+type DuplicateAudienceResponse struct {
+	Audience       Audience `json:"audience"`
+	ContactsCopied int      `json:"contactsCopied"`
+}
+
 // AddContactsToAudienceRequest is the request to add contacts to an audience.
 type AddContactsToAudienceRequest struct {
-	ID         string
-	ContactIDs []string `json:"contactIds"`
+	ID         string   `validate:"required"`
+	ContactIDs []string `json:"contactIds" validate:"required"`
 }
 
 // AddContactsToAudienceResponse is the response when adding contacts to an audience.
@@ -498,8 +1251,8 @@ type AddContactsToAudienceResponse struct {
 
 // RemoveContactsFromAudienceRequest is the request to remove contacts from an audience.
 type RemoveContactsFromAudienceRequest struct {
-	ID         string
-	ContactIDs []string `json:"contactIds"`
+	ID         string   `validate:"required"`
+	ContactIDs []string `json:"contactIds" validate:"required"`
 }
 
 // RemoveContactsFromAudienceResponse is the response when removing contacts from an audience.
@@ -518,6 +1271,16 @@ const (
 	ContactStatusComplained   ContactStatus = "complained"
 )
 
+// ContactExpand names related data that can be included when listing or
+// fetching contacts.
+type ContactExpand string
+
+const (
+	// ContactExpandEngagement populates the LastEmailedAt, LastOpenedAt,
+	// LastClickedAt and Total* fields of MailContact.
+	ContactExpandEngagement ContactExpand = "engagement"
+)
+
 // MailContact represents a mail contact.
 type MailContact struct {
 	ID             string                 `json:"id"`
@@ -527,10 +1290,18 @@ type MailContact struct {
 	Email          string                 `json:"email"`
 	FirstName      *string                `json:"firstName"`
 	LastName       *string                `json:"lastName"`
+	Locale         *string                `json:"locale"`
+	Timezone       *string                `json:"timezone"`
 	Metadata       map[string]interface{} `json:"metadata"`
 	Status         string                 `json:"status"`
 	SubscribedAt   *time.Time             `json:"subscribedAt"`
 	UnsubscribedAt *time.Time             `json:"unsubscribedAt"`
+	LastEmailedAt  *time.Time             `json:"lastEmailedAt,omitempty"`
+	LastOpenedAt   *time.Time             `json:"lastOpenedAt,omitempty"`
+	LastClickedAt  *time.Time             `json:"lastClickedAt,omitempty"`
+	TotalSent      *int                   `json:"totalSent,omitempty"`
+	TotalOpened    *int                   `json:"totalOpened,omitempty"`
+	TotalClicked   *int                   `json:"totalClicked,omitempty"`
 	CreatedAt      time.Time              `json:"createdAt"`
 	UpdatedAt      *time.Time             `json:"updatedAt"`
 }
@@ -544,22 +1315,112 @@ type AudienceContact struct {
 // CreateContactRequest is the request to create a contact.
 type CreateContactRequest struct {
 	Environment *types.Environment     `json:"environment,omitempty"`
-	Email       string                 `json:"email"`
+	Email       string                 `json:"email" validate:"required"`
 	FirstName   *string                `json:"firstName,omitempty"`
 	LastName    *string                `json:"lastName,omitempty"`
+	Locale      *string                `json:"locale,omitempty"`
+	Timezone    *string                `json:"timezone,omitempty"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // UpdateContactRequest is the request to update a contact.
 type UpdateContactRequest struct {
-	ID        string
+	ID        string                 `validate:"required"`
 	Email     *string                `json:"email,omitempty"`
 	FirstName *string                `json:"firstName,omitempty"`
 	LastName  *string                `json:"lastName,omitempty"`
+	Locale    *string                `json:"locale,omitempty"`
+	Timezone  *string                `json:"timezone,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	Status    *ContactStatus         `json:"status,omitempty"`
+}
+
+// GetManyContactsResponse is the response when getting contacts by ID.
+// NotFound lists requested IDs that don't exist.
+type GetManyContactsResponse struct {
+	Contacts []MailContact `json:"contacts"`
+	NotFound []string      `json:"notFound"`
+}
+
+// ExportPersonalDataRequest identifies the contact whose personal data to
+// export, by ContactID or by Email.
+type ExportPersonalDataRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	ContactID   *string            `json:"contactId,omitempty"`
+	Email       *string            `json:"email,omitempty"`
+}
+
+// PersonalDataExport is everything Stack0 stores about a contact, for
+// answering data subject access requests.
+type PersonalDataExport struct {
+	Contact          MailContact        `json:"contact"`
+	Audiences        []Audience         `json:"audiences"`
+	Emails           []GetEmailResponse `json:"emails"`
+	EventOccurrences []EventOccurrence  `json:"eventOccurrences"`
+	SequenceEntries  []SequenceEntry    `json:"sequenceEntries"`
+	GeneratedAt      time.Time          `json:"generatedAt"`
+}
+
+// ErasureCounts are the number of records removed by an erasure.
+type ErasureCounts struct {
+	Emails              int `json:"emails"`
+	EventOccurrences    int `json:"eventOccurrences"`
+	SequenceEntries     int `json:"sequenceEntries"`
+	AudienceMemberships int `json:"audienceMemberships"`
+}
+
+// ErasureReceipt records a completed erasure for compliance records. The
+// erased address itself is not retained; EmailHash is its SHA-256 hex
+// digest, so a receipt can be matched to a request later.
+type ErasureReceipt struct {
+	ID        string        `json:"id"`
+	ContactID string        `json:"contactId"`
+	EmailHash string        `json:"emailHash"`
+	Deleted   ErasureCounts `json:"deleted"`
+	ErasedAt  time.Time     `json:"erasedAt"`
+}
+
+// UpsertContactInput is a contact to create or update, matched by email.
+// When the contact exists, nil fields leave its current values unchanged.
+type UpsertContactInput struct {
+	Email     string                 `json:"email"`
+	FirstName *string                `json:"firstName,omitempty"`
+	LastName  *string                `json:"lastName,omitempty"`
+	Locale    *string                `json:"locale,omitempty"`
+	Timezone  *string                `json:"timezone,omitempty"`
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
 	Status    *ContactStatus         `json:"status,omitempty"`
 }
 
+// UpsertAction is the outcome of upserting a single contact.
+type UpsertAction string
+
+const (
+	UpsertActionCreated   UpsertAction = "created"
+	UpsertActionUpdated   UpsertAction = "updated"
+	UpsertActionUnchanged UpsertAction = "unchanged"
+	UpsertActionFailed    UpsertAction = "failed"
+)
+
+// UpsertContactResult is the result for one input row. Index is the row's
+// position in the request; Contact is nil when Action is failed.
+type UpsertContactResult struct {
+	Index   int          `json:"index"`
+	Email   string       `json:"email"`
+	Action  UpsertAction `json:"action"`
+	Contact *MailContact `json:"contact,omitempty"`
+	Error   *string      `json:"error,omitempty"`
+}
+
+// UpsertContactsResponse is the response when upserting contacts.
+type UpsertContactsResponse struct {
+	Results   []UpsertContactResult `json:"results"`
+	Created   int                   `json:"created"`
+	Updated   int                   `json:"updated"`
+	Unchanged int                   `json:"unchanged"`
+	Failed    int                   `json:"failed"`
+}
+
 // ListContactsRequest is the request to list contacts.
 type ListContactsRequest struct {
 	Environment *types.Environment `url:"environment,omitempty"`
@@ -567,6 +1428,7 @@ type ListContactsRequest struct {
 	Offset      *int               `url:"offset,omitempty"`
 	Search      *string            `url:"search,omitempty"`
 	Status      *ContactStatus     `url:"status,omitempty"`
+	Expand      []ContactExpand    `url:"expand,omitempty"`
 }
 
 // ListContactsResponse is the response when listing contacts.
@@ -579,20 +1441,57 @@ type ListContactsResponse struct {
 
 // ListAudienceContactsRequest is the request to list contacts in an audience.
 type ListAudienceContactsRequest struct {
-	ID          string
+	ID          string             `validate:"required"`
 	Environment *types.Environment `url:"environment,omitempty"`
 	Limit       *int               `url:"limit,omitempty"`
 	Offset      *int               `url:"offset,omitempty"`
 	Search      *string            `url:"search,omitempty"`
 	Status      *ContactStatus     `url:"status,omitempty"`
+	// Cursor continues from the NextCursor of a previous page. Unlike Offset
+	// it stays fast deep into large audiences.
+	Cursor *string `url:"cursor,omitempty"`
 }
 
 // ListAudienceContactsResponse is the response when listing audience contacts.
 type ListAudienceContactsResponse struct {
-	Contacts []AudienceContact `json:"contacts"`
-	Total    int               `json:"total"`
-	Limit    int               `json:"limit"`
-	Offset   int               `json:"offset"`
+	Contacts   []AudienceContact `json:"contacts"`
+	Total      int               `json:"total"`
+	Limit      int               `json:"limit"`
+	Offset     int               `json:"offset"`
+	NextCursor *string           `json:"nextCursor,omitempty"`
+}
+
+// AudienceExportStatus is the status of an asynchronous audience export.
+type AudienceExportStatus string
+
+const (
+	AudienceExportStatusPending    AudienceExportStatus = "pending"
+	AudienceExportStatusProcessing AudienceExportStatus = "processing"
+	AudienceExportStatusCompleted  AudienceExportStatus = "completed"
+	AudienceExportStatusFailed     AudienceExportStatus = "failed"
+)
+
+// ExportAudienceContactsRequest is the request to export an audience's
+// contacts asynchronously. Format defaults to CSV.
+type ExportAudienceContactsRequest struct {
+	Format ExportFormat   `json:"format,omitempty"`
+	Status *ContactStatus `json:"status,omitempty"`
+}
+
+// AudienceExport is an asynchronous export of an audience's contacts. Once
+// completed, DownloadURL points to the exported file until ExpiresAt.
+type AudienceExport struct {
+	ID               string               `json:"id"`
+	AudienceID       string               `json:"audienceId"`
+	Status           AudienceExportStatus `json:"status"`
+	Format           ExportFormat         `json:"format"`
+	TotalContacts    int                  `json:"totalContacts"`
+	ExportedContacts int                  `json:"exportedContacts"`
+	DownloadURL      *string              `json:"downloadUrl"`
+	ExpiresAt        *time.Time           `json:"expiresAt"`
+	Error            *string              `json:"error"`
+	CreatedAt        time.Time            `json:"createdAt"`
+	CompletedAt      *time.Time           `json:"completedAt"`
 }
 
 // DeleteContactResponse is the response when deleting a contact.
@@ -612,7 +1511,7 @@ type ImportContactInput struct {
 type ImportContactsRequest struct {
 	Environment *types.Environment   `json:"environment,omitempty"`
 	AudienceID  *string              `json:"audienceId,omitempty"`
-	Contacts    []ImportContactInput `json:"contacts"`
+	Contacts    []ImportContactInput `json:"contacts" validate:"required"`
 }
 
 // ImportContactError represents an error during contact import.
@@ -629,6 +1528,147 @@ type ImportContactsResponse struct {
 	Errors   []ImportContactError `json:"errors"`
 }
 
+// SegmentMatch controls whether a segment filter requires all or any of its
+// conditions to match.
+type SegmentMatch string
+
+const (
+	SegmentMatchAll SegmentMatch = "all"
+	SegmentMatchAny SegmentMatch = "any"
+)
+
+// SegmentConditionSource is what a segment condition inspects.
+type SegmentConditionSource string
+
+const (
+	SegmentSourceContact    SegmentConditionSource = "contact"
+	SegmentSourceMetadata   SegmentConditionSource = "metadata"
+	SegmentSourceEvent      SegmentConditionSource = "event"
+	SegmentSourceEngagement SegmentConditionSource = "engagement"
+)
+
+// SegmentOperator is the comparison a segment condition applies.
+type SegmentOperator string
+
+const (
+	SegmentOpEquals       SegmentOperator = "eq"
+	SegmentOpNotEquals    SegmentOperator = "neq"
+	SegmentOpContains     SegmentOperator = "contains"
+	SegmentOpGreaterThan  SegmentOperator = "gt"
+	SegmentOpGreaterEqual SegmentOperator = "gte"
+	SegmentOpLessThan     SegmentOperator = "lt"
+	SegmentOpLessEqual    SegmentOperator = "lte"
+	SegmentOpIn           SegmentOperator = "in"
+	SegmentOpExists       SegmentOperator = "exists"
+	SegmentOpNotExists    SegmentOperator = "not_exists"
+	SegmentOpBefore       SegmentOperator = "before"
+	SegmentOpAfter        SegmentOperator = "after"
+	SegmentOpPerformed    SegmentOperator = "performed"
+	SegmentOpNotPerformed SegmentOperator = "not_performed"
+)
+
+// SegmentCondition is a single rule in a segment filter. Field is a contact
+// field (e.g. "locale"), a metadata key (e.g. "plan"), an event name, or an
+// engagement field (e.g. "lastOpenedAt", "totalClicked") depending on Source.
+// WithinDays limits event and engagement conditions to a trailing window,
+// and MinCount requires an event to have occurred at least that many times.
+type SegmentCondition struct {
+	Source     SegmentConditionSource `json:"source"`
+	Field      string                 `json:"field"`
+	Operator   SegmentOperator        `json:"operator"`
+	Value      interface{}            `json:"value,omitempty"`
+	WithinDays *int                   `json:"withinDays,omitempty"`
+	MinCount   *int                   `json:"minCount,omitempty"`
+}
+
+// SegmentFilter is a set of conditions combined with Match. Groups nest
+// further filters, so (a AND (b OR c)) is an "all" filter with condition a
+// and an "any" group holding b and c.
+type SegmentFilter struct {
+	Match      SegmentMatch       `json:"match"`
+	Conditions []SegmentCondition `json:"conditions,omitempty"`
+	Groups     []SegmentFilter    `json:"groups,omitempty"`
+}
+
+// Segment is a saved, dynamic filter over contacts. Membership is evaluated
+// when the segment is used, so it always reflects current contact data. A
+// segment ID can be used as a campaign's SegmentID or a sequence's
+// AudienceFilterID.
+type Segment struct {
+	ID              string        `json:"id"`
+	OrganizationID  string        `json:"organizationId"`
+	ProjectID       *string       `json:"projectId"`
+	Environment     string        `json:"environment"`
+	Name            string        `json:"name"`
+	Description     *string       `json:"description"`
+	Filter          SegmentFilter `json:"filter"`
+	ContactCount    *int          `json:"contactCount"`
+	CountedAt       *time.Time    `json:"countedAt"`
+	CreatedByUserID *string       `json:"createdByUserId"`
+	CreatedAt       time.Time     `json:"createdAt"`
+	UpdatedAt       *time.Time    `json:"updatedAt"`
+}
+
+// CreateSegmentRequest is the request to create a segment.
+type CreateSegmentRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	Name        string             `json:"name" validate:"required"`
+	Description *string            `json:"description,omitempty"`
+	Filter      SegmentFilter      `json:"filter"`
+}
+
+// UpdateSegmentRequest is the request to update a segment.
+type UpdateSegmentRequest struct {
+	ID          string         `json:"-" validate:"required"`
+	Name        *string        `json:"name,omitempty"`
+	Description *string        `json:"description,omitempty"`
+	Filter      *SegmentFilter `json:"filter,omitempty"`
+}
+
+// ListSegmentsRequest is the request to list segments.
+type ListSegmentsRequest struct {
+	Environment *types.Environment
+	Limit       *int
+	Offset      *int
+	Search      *string
+}
+
+// ListSegmentsResponse is the response when listing segments.
+type ListSegmentsResponse struct {
+	Segments []Segment `json:"segments"`
+	Total    int       `json:"total"`
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+}
+
+// DeleteSegmentResponse is the response when deleting a segment.
+type DeleteSegmentResponse struct {
+	Success bool `json:"success"`
+}
+
+// PreviewSegmentRequest is the request to evaluate an unsaved filter.
+// Limit caps the number of sample contacts returned; Count is always the
+// full match count.
+type PreviewSegmentRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	Filter      SegmentFilter      `json:"filter"`
+	Limit       *int               `json:"limit,omitempty"`
+}
+
+// PreviewSegmentResponse is the result of evaluating a segment filter.
+type PreviewSegmentResponse struct {
+	Count    int           `json:"count"`
+	Contacts []MailContact `json:"contacts"`
+}
+
+// ListSegmentContactsRequest is the request to list contacts currently
+// matching a saved segment.
+type ListSegmentContactsRequest struct {
+	ID     string `validate:"required"`
+	Limit  *int
+	Offset *int
+}
+
 // CampaignStatus represents the status of a campaign.
 type CampaignStatus string
 
@@ -642,72 +1682,109 @@ const (
 	CampaignStatusFailed    CampaignStatus = "failed"
 )
 
-// Campaign represents an email campaign.
-type Campaign struct {
-	ID              string                 `json:"id"`
-	OrganizationID  string                 `json:"organizationId"`
-	ProjectID       *string                `json:"projectId"`
-	Environment     string                 `json:"environment"`
-	Name            string                 `json:"name"`
-	Subject         string                 `json:"subject"`
-	PreviewText     *string                `json:"previewText"`
-	FromEmail       string                 `json:"fromEmail"`
-	FromName        *string                `json:"fromName"`
-	ReplyTo         *string                `json:"replyTo"`
-	TemplateID      *string                `json:"templateId"`
-	HTML            *string                `json:"html"`
-	Text            *string                `json:"text"`
-	AudienceID      *string                `json:"audienceId"`
-	Status          string                 `json:"status"`
-	ScheduledAt     *time.Time             `json:"scheduledAt"`
-	SentAt          *time.Time             `json:"sentAt"`
-	CompletedAt     *time.Time             `json:"completedAt"`
-	TotalRecipients int                    `json:"totalRecipients"`
-	SentCount       int                    `json:"sentCount"`
-	DeliveredCount  int                    `json:"deliveredCount"`
-	OpenedCount     int                    `json:"openedCount"`
-	ClickedCount    int                    `json:"clickedCount"`
-	BouncedCount    int                    `json:"bouncedCount"`
-	FailedCount     int                    `json:"failedCount"`
-	Tags            []string               `json:"tags"`
-	Metadata        map[string]interface{} `json:"metadata"`
-	CreatedByUserID *string                `json:"createdByUserId"`
-	CreatedAt       time.Time              `json:"createdAt"`
-	UpdatedAt       *time.Time             `json:"updatedAt"`
-}
+// CampaignExpand names related data that can be included when fetching a
+// campaign.
+type CampaignExpand string
+
+const (
+	CampaignExpandAudience CampaignExpand = "audience"
+	CampaignExpandTemplate CampaignExpand = "template"
+	// CampaignExpandResolvedContent includes the content as sent: the
+	// template version merged with the campaign's overrides.
+	CampaignExpandResolvedContent CampaignExpand = "resolvedContent"
+)
 
-// CreateCampaignRequest is the request to create a campaign.
+// CampaignResolvedContent is the content a campaign sends, with the template
+// merged with the campaign's subject, preview text and body overrides.
+// Template variables are left as placeholders, since they differ per
+// recipient. TemplateVersion is the template version it was resolved from.
+type CampaignResolvedContent struct {
+	Subject         string    `json:"subject"`
+	PreviewText     *string   `json:"previewText"`
+	HTML            string    `json:"html"`
+	Text            *string   `json:"text"`
+	TemplateID      *string   `json:"templateId"`
+	TemplateVersion *int      `json:"templateVersion"`
+	ResolvedAt      time.Time `json:"resolvedAt"`
+}
+
+// Campaign represents an email campaign. Audience, Template and
+// ResolvedContent are only populated when requested with CampaignExpand.
+type Campaign struct {
+	ID              string                   `json:"id"`
+	OrganizationID  string                   `json:"organizationId"`
+	ProjectID       *string                  `json:"projectId"`
+	Environment     string                   `json:"environment"`
+	Name            string                   `json:"name"`
+	Subject         string                   `json:"subject"`
+	PreviewText     *string                  `json:"previewText"`
+	FromEmail       string                   `json:"fromEmail"`
+	FromName        *string                  `json:"fromName"`
+	ReplyTo         *string                  `json:"replyTo"`
+	TemplateID      *string                  `json:"templateId"`
+	TemplateVersion *int                     `json:"templateVersion"`
+	HTML            *string                  `json:"html"`
+	Text            *string                  `json:"text"`
+	AudienceID      *string                  `json:"audienceId"`
+	SegmentID       *string                  `json:"segmentId"`
+	Status          string                   `json:"status"`
+	ScheduledAt     *time.Time               `json:"scheduledAt"`
+	SentAt          *time.Time               `json:"sentAt"`
+	CompletedAt     *time.Time               `json:"completedAt"`
+	TotalRecipients int                      `json:"totalRecipients"`
+	SentCount       int                      `json:"sentCount"`
+	DeliveredCount  int                      `json:"deliveredCount"`
+	OpenedCount     int                      `json:"openedCount"`
+	ClickedCount    int                      `json:"clickedCount"`
+	BouncedCount    int                      `json:"bouncedCount"`
+	FailedCount     int                      `json:"failedCount"`
+	Tags            []string                 `json:"tags"`
+	Metadata        map[string]interface{}   `json:"metadata"`
+	Audience        *Audience                `json:"audience,omitempty"`
+	Template        *Template                `json:"template,omitempty"`
+	ResolvedContent *CampaignResolvedContent `json:"resolvedContent,omitempty"`
+	CreatedByUserID *string                  `json:"createdByUserId"`
+	CreatedAt       time.Time                `json:"createdAt"`
+	UpdatedAt       *time.Time               `json:"updatedAt"`
+}
+
+// CreateCampaignRequest is the request to create a campaign. Recipients come
+// from either a static audience (AudienceID) or a dynamic segment (SegmentID).
 type CreateCampaignRequest struct {
-	Environment *types.Environment `json:"environment,omitempty"`
-	Name        string             `json:"name"`
-	Subject     string             `json:"subject"`
-	PreviewText *string            `json:"previewText,omitempty"`
-	FromEmail   string             `json:"fromEmail"`
-	FromName    *string            `json:"fromName,omitempty"`
-	ReplyTo     *string            `json:"replyTo,omitempty"`
-	TemplateID  *string            `json:"templateId,omitempty"`
-	HTML        *string            `json:"html,omitempty"`
-	Text        *string            `json:"text,omitempty"`
-	AudienceID  *string            `json:"audienceId,omitempty"`
-	ScheduledAt *time.Time         `json:"scheduledAt,omitempty"`
-	Tags        []string           `json:"tags,omitempty"`
+	Environment     *types.Environment `json:"environment,omitempty"`
+	Name            string             `json:"name" validate:"required"`
+	Subject         string             `json:"subject" validate:"required"`
+	PreviewText     *string            `json:"previewText,omitempty"`
+	FromEmail       string             `json:"fromEmail" validate:"required"`
+	FromName        *string            `json:"fromName,omitempty"`
+	ReplyTo         *string            `json:"replyTo,omitempty"`
+	TemplateID      *string            `json:"templateId,omitempty"`
+	TemplateVersion *int               `json:"templateVersion,omitempty"`
+	HTML            *string            `json:"html,omitempty"`
+	Text            *string            `json:"text,omitempty"`
+	AudienceID      *string            `json:"audienceId,omitempty"`
+	SegmentID       *string            `json:"segmentId,omitempty"`
+	ScheduledAt     *time.Time         `json:"scheduledAt,omitempty"`
+	Tags            []string           `json:"tags,omitempty"`
 }
 
 // UpdateCampaignRequest is the request to update a campaign.
 type UpdateCampaignRequest struct {
-	ID          string
-	Name        *string    `json:"name,omitempty"`
-	Subject     *string    `json:"subject,omitempty"`
-	PreviewText *string    `json:"previewText,omitempty"`
-	FromEmail   *string    `json:"fromEmail,omitempty"`
-	FromName    *string    `json:"fromName,omitempty"`
-	ReplyTo     *string    `json:"replyTo,omitempty"`
-	TemplateID  *string    `json:"templateId,omitempty"`
-	HTML        *string    `json:"html,omitempty"`
-	Text        *string    `json:"text,omitempty"`
-	AudienceID  *string    `json:"audienceId,omitempty"`
-	ScheduledAt *time.Time `json:"scheduledAt,omitempty"`
-	Tags        []string   `json:"tags,omitempty"`
+	ID              string     `validate:"required"`
+	Name            *string    `json:"name,omitempty"`
+	Subject         *string    `json:"subject,omitempty"`
+	PreviewText     *string    `json:"previewText,omitempty"`
+	FromEmail       *string    `json:"fromEmail,omitempty"`
+	FromName        *string    `json:"fromName,omitempty"`
+	ReplyTo         *string    `json:"replyTo,omitempty"`
+	TemplateID      *string    `json:"templateId,omitempty"`
+	TemplateVersion *int       `json:"templateVersion,omitempty"`
+	HTML            *string    `json:"html,omitempty"`
+	Text            *string    `json:"text,omitempty"`
+	AudienceID      *string    `json:"audienceId,omitempty"`
+	SegmentID       *string    `json:"segmentId,omitempty"`
+	ScheduledAt     *time.Time `json:"scheduledAt,omitempty"`
+	Tags            []string   `json:"tags,omitempty"`
 }
 
 // ListCampaignsRequest is the request to list campaigns.
@@ -727,16 +1804,98 @@ type ListCampaignsResponse struct {
 	Offset    int        `json:"offset"`
 }
 
+// ListCampaignRecipientsRequest is the request to list a campaign's recipients.
+type ListCampaignRecipientsRequest struct {
+	Email     *string      `url:"email,omitempty"`
+	ContactID *string      `url:"contactId,omitempty"`
+	Status    *EmailStatus `url:"status,omitempty"`
+	Limit     *int         `url:"limit,omitempty"`
+	Offset    *int         `url:"offset,omitempty"`
+}
+
+// CampaignRecipient is a contact a campaign was sent to, with the delivery
+// timeline of their email.
+type CampaignRecipient struct {
+	ContactID   string      `json:"contactId"`
+	Email       string      `json:"email"`
+	EmailID     *string     `json:"emailId"`
+	Status      EmailStatus `json:"status"`
+	Error       *string     `json:"error"`
+	SentAt      *time.Time  `json:"sentAt"`
+	DeliveredAt *time.Time  `json:"deliveredAt"`
+	OpenedAt    *time.Time  `json:"openedAt"`
+	ClickedAt   *time.Time  `json:"clickedAt"`
+	BouncedAt   *time.Time  `json:"bouncedAt"`
+}
+
+// ListCampaignRecipientsResponse is the response when listing campaign recipients.
+type ListCampaignRecipientsResponse struct {
+	Recipients []CampaignRecipient `json:"recipients"`
+	Total      int                 `json:"total"`
+	Limit      int                 `json:"limit"`
+	Offset     int                 `json:"offset"`
+}
+
 // DeleteCampaignResponse is the response when deleting a campaign.
 type DeleteCampaignResponse struct {
 	Success bool `json:"success"`
 }
 
 // SendCampaignRequest is the request to send a campaign.
+//
+// SendRate caps overall throughput and RampUp warms up a new domain by
+// starting slower and stepping up to SendRate.
+//
+// With SendAtLocalTime set, the wall-clock time of ScheduledAt is applied in
+// each recipient's timezone, so 09:00 is delivered at 09:00 local time.
+// Recipients whose timezone can't be resolved use FallbackTimezone, or the
+// timezone of ScheduledAt if that is empty.
 type SendCampaignRequest struct {
-	ID          string
-	SendNow     *bool      `json:"sendNow,omitempty"`
-	ScheduledAt *time.Time `json:"scheduledAt,omitempty"`
+	ID                    string              `validate:"required"`
+	SendNow               *bool               `json:"sendNow,omitempty"`
+	ScheduledAt           *time.Time          `json:"scheduledAt,omitempty"`
+	IgnoreSendingCalendar *bool               `json:"ignoreSendingCalendar,omitempty"`
+	SendRate              *CampaignSendRate   `json:"sendRate,omitempty"`
+	RampUp                *CampaignRampUp     `json:"rampUp,omitempty"`
+	SendAtLocalTime       *bool               `json:"sendAtLocalTime,omitempty"`
+	TimezoneResolution    *TimezoneResolution `json:"timezoneResolution,omitempty"`
+	FallbackTimezone      *string             `json:"fallbackTimezone,omitempty"`
+}
+
+// TimezoneResolution controls how a recipient's timezone is determined for
+// local-time sends.
+type TimezoneResolution string
+
+const (
+	// TimezoneResolutionContact uses only the contact's Timezone field.
+	TimezoneResolutionContact TimezoneResolution = "contact"
+	// TimezoneResolutionInferred uses the contact's Timezone field and
+	// otherwise infers it from the location of recent opens and clicks.
+	TimezoneResolutionInferred TimezoneResolution = "inferred"
+)
+
+// CampaignSendRate limits how fast a campaign is sent.
+type CampaignSendRate struct {
+	MaxPerHour *int `json:"maxPerHour,omitempty"`
+	MaxPerDay  *int `json:"maxPerDay,omitempty"`
+}
+
+// RampUpStep sends at MaxPerHour for Hours before moving to the next step.
+type RampUpStep struct {
+	Hours      int `json:"hours"`
+	MaxPerHour int `json:"maxPerHour"`
+}
+
+// CampaignRampUp is a warm-up schedule for a campaign. Once the last step
+// completes the campaign continues at its SendRate.
+type CampaignRampUp struct {
+	Steps []RampUpStep `json:"steps"`
+}
+
+// SendCampaignTestResponse is the response when test-sending a campaign.
+type SendCampaignTestResponse struct {
+	Success bool               `json:"success"`
+	Results []BatchEmailResult `json:"results"`
 }
 
 // SendCampaignResponse is the response when sending a campaign.
@@ -752,11 +1911,31 @@ type PauseCampaignResponse struct {
 	Success bool `json:"success"`
 }
 
+// ResumeCampaignResponse is the response when resuming a paused campaign.
+type ResumeCampaignResponse struct {
+	Success             bool           `json:"success"`
+	Status              CampaignStatus `json:"status"`
+	TotalRecipients     int            `json:"totalRecipients"`
+	SentCount           int            `json:"sentCount"`
+	RemainingRecipients int            `json:"remainingRecipients"`
+}
+
 // CancelCampaignResponse is the response when canceling a campaign.
 type CancelCampaignResponse struct {
 	Success bool `json:"success"`
 }
 
+// DuplicateCampaignRequest overrides fields of the copy made by
+// CampaignsClient.Duplicate. Unset fields are copied from the original;
+// ClearStats resets the copy's send counters and stats.
+type DuplicateCampaignRequest struct {
+	Name        *string    `json:"name,omitempty"`
+	Subject     *string    `json:"subject,omitempty"`
+	AudienceID  *string    `json:"audienceId,omitempty"`
+	ScheduledAt *time.Time `json:"scheduledAt,omitempty"`
+	ClearStats  *bool      `json:"clearStats,omitempty"`
+}
+
 // CampaignStatsResponse contains campaign statistics.
 type CampaignStatsResponse struct {
 	Total        int     `json:"total"`
@@ -814,6 +1993,7 @@ const (
 	SequenceNodeExit          SequenceNodeType = "exit"
 	SequenceNodeAddToList     SequenceNodeType = "add_to_list"
 	SequenceNodeUpdateContact SequenceNodeType = "update_contact"
+	SequenceNodeWebhook       SequenceNodeType = "webhook"
 )
 
 // ConnectionType represents the type of connection between nodes.
@@ -882,26 +2062,69 @@ type SequenceWithNodes struct {
 	Connections []SequenceConnection `json:"connections"`
 }
 
+// SequenceExport is a portable copy of a sequence graph returned by
+// SequencesClient.Export. It holds no IDs, stats or environment, so it can be
+// committed to version control and imported into another environment.
+// Connections refer to nodes by Key.
+type SequenceExport struct {
+	Version          int                        `json:"version"`
+	Name             string                     `json:"name"`
+	Description      *string                    `json:"description,omitempty"`
+	TriggerType      SequenceTriggerType        `json:"triggerType"`
+	TriggerFrequency *SequenceTriggerFrequency  `json:"triggerFrequency,omitempty"`
+	TriggerConfig    map[string]interface{}     `json:"triggerConfig,omitempty"`
+	Nodes            []SequenceExportNode       `json:"nodes"`
+	Connections      []SequenceExportConnection `json:"connections"`
+}
+
+// SequenceExportNode is a node of an exported sequence.
+type SequenceExportNode struct {
+	Key       string                 `json:"key"`
+	NodeType  SequenceNodeType       `json:"nodeType"`
+	Name      string                 `json:"name"`
+	PositionX float64                `json:"positionX"`
+	PositionY float64                `json:"positionY"`
+	SortOrder int                    `json:"sortOrder"`
+	Config    map[string]interface{} `json:"config,omitempty"`
+}
+
+// SequenceExportConnection is a connection between two exported nodes.
+type SequenceExportConnection struct {
+	Source         string         `json:"source"`
+	Target         string         `json:"target"`
+	ConnectionType ConnectionType `json:"connectionType"`
+	Label          *string        `json:"label,omitempty"`
+}
+
+// ImportSequenceRequest is the request to create a sequence from an export.
+type ImportSequenceRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	Sequence    *SequenceExport    `json:"sequence" validate:"required"`
+	Name        *string            `json:"name,omitempty"`
+}
+
 // CreateSequenceRequest is the request to create a sequence.
 type CreateSequenceRequest struct {
-	Environment      *types.Environment       `json:"environment,omitempty"`
-	Name             string                   `json:"name"`
-	Description      *string                  `json:"description,omitempty"`
-	TriggerType      SequenceTriggerType      `json:"triggerType"`
-	TriggerFrequency *SequenceTriggerFrequency `json:"triggerFrequency,omitempty"`
-	TriggerConfig    map[string]interface{}   `json:"triggerConfig,omitempty"`
-	AudienceFilterID *string                  `json:"audienceFilterId,omitempty"`
+	Environment           *types.Environment        `json:"environment,omitempty"`
+	Name                  string                    `json:"name" validate:"required"`
+	Description           *string                   `json:"description,omitempty"`
+	TriggerType           SequenceTriggerType       `json:"triggerType" validate:"required"`
+	TriggerFrequency      *SequenceTriggerFrequency `json:"triggerFrequency,omitempty"`
+	TriggerConfig         map[string]interface{}    `json:"triggerConfig,omitempty"`
+	AudienceFilterID      *string                   `json:"audienceFilterId,omitempty"`
+	IgnoreSendingCalendar *bool                     `json:"ignoreSendingCalendar,omitempty"`
 }
 
 // UpdateSequenceRequest is the request to update a sequence.
 type UpdateSequenceRequest struct {
-	ID               string
-	Name             *string                   `json:"name,omitempty"`
-	Description      *string                   `json:"description,omitempty"`
-	TriggerType      *SequenceTriggerType      `json:"triggerType,omitempty"`
-	TriggerFrequency *SequenceTriggerFrequency `json:"triggerFrequency,omitempty"`
-	TriggerConfig    map[string]interface{}   `json:"triggerConfig,omitempty"`
-	AudienceFilterID *string                  `json:"audienceFilterId,omitempty"`
+	ID                    string                    `validate:"required"`
+	Name                  *string                   `json:"name,omitempty"`
+	Description           *string                   `json:"description,omitempty"`
+	TriggerType           *SequenceTriggerType      `json:"triggerType,omitempty"`
+	TriggerFrequency      *SequenceTriggerFrequency `json:"triggerFrequency,omitempty"`
+	TriggerConfig         map[string]interface{}    `json:"triggerConfig,omitempty"`
+	AudienceFilterID      *string                   `json:"audienceFilterId,omitempty"`
+	IgnoreSendingCalendar *bool                     `json:"ignoreSendingCalendar,omitempty"`
 }
 
 // ListSequencesRequest is the request to list sequences.
@@ -949,8 +2172,8 @@ type ArchiveSequenceResponse struct {
 
 // CreateNodeRequest is the request to create a node.
 type CreateNodeRequest struct {
-	ID        string // sequence ID
-	NodeType  SequenceNodeType       `json:"nodeType"`
+	ID        string                 `validate:"required"` // sequence ID
+	NodeType  SequenceNodeType       `json:"nodeType" validate:"required"`
 	Name      string                 `json:"name"`
 	PositionX float64                `json:"positionX"`
 	PositionY float64                `json:"positionY"`
@@ -960,8 +2183,8 @@ type CreateNodeRequest struct {
 
 // UpdateNodeRequest is the request to update a node.
 type UpdateNodeRequest struct {
-	ID        string // sequence ID
-	NodeID    string
+	ID        string                 `validate:"required"` // sequence ID
+	NodeID    string                 `validate:"required"`
 	Name      *string                `json:"name,omitempty"`
 	PositionX *float64               `json:"positionX,omitempty"`
 	PositionY *float64               `json:"positionY,omitempty"`
@@ -971,8 +2194,8 @@ type UpdateNodeRequest struct {
 
 // UpdateNodePositionRequest is the request to update a node's position.
 type UpdateNodePositionRequest struct {
-	ID        string // sequence ID
-	NodeID    string
+	ID        string  `validate:"required"` // sequence ID
+	NodeID    string  `validate:"required"`
 	PositionX float64 `json:"positionX"`
 	PositionY float64 `json:"positionY"`
 }
@@ -983,31 +2206,52 @@ type DeleteNodeResponse struct {
 }
 
 // SetNodeEmailRequest is the request to set email content for a node.
+// By default a node follows the latest version of its template. Set
+// TemplateVersion to pin a specific version, or PinTemplateVersion to pin
+// the template's current version.
 type SetNodeEmailRequest struct {
-	NodeID      string
-	Subject     *string                `json:"subject,omitempty"`
-	PreviewText *string                `json:"previewText,omitempty"`
-	HTML        *string                `json:"html,omitempty"`
-	Text        *string                `json:"text,omitempty"`
-	TemplateID  *string                `json:"templateId,omitempty"`
-	MailyJSON   map[string]interface{} `json:"mailyJson,omitempty"`
-	FromEmail   *string                `json:"fromEmail,omitempty"`
-	FromName    *string                `json:"fromName,omitempty"`
-	ReplyTo     *string                `json:"replyTo,omitempty"`
+	NodeID             string                 `validate:"required"`
+	Subject            *string                `json:"subject,omitempty"`
+	PreviewText        *string                `json:"previewText,omitempty"`
+	HTML               *string                `json:"html,omitempty"`
+	Text               *string                `json:"text,omitempty"`
+	TemplateID         *string                `json:"templateId,omitempty"`
+	TemplateVersion    *int                   `json:"templateVersion,omitempty"`
+	PinTemplateVersion *bool                  `json:"pinTemplateVersion,omitempty"`
+	MailyJSON          map[string]interface{} `json:"mailyJson,omitempty"`
+	FromEmail          *string                `json:"fromEmail,omitempty"`
+	FromName           *string                `json:"fromName,omitempty"`
+	ReplyTo            *string                `json:"replyTo,omitempty"`
+}
+
+// RepinSequenceTemplatesRequest is the request to re-pin the template
+// versions used by a sequence's email nodes. TemplateID and NodeIDs narrow
+// which nodes are updated. Version nil pins the latest version.
+type RepinSequenceTemplatesRequest struct {
+	TemplateID *string  `json:"templateId,omitempty"`
+	NodeIDs    []string `json:"nodeIds,omitempty"`
+	Version    *int     `json:"version,omitempty"`
+}
+
+// RepinSequenceTemplatesResponse is the response when re-pinning template versions.
+type RepinSequenceTemplatesResponse struct {
+	Success      bool           `json:"success"`
+	UpdatedCount int            `json:"updatedCount"`
+	Nodes        []SequenceNode `json:"nodes"`
 }
 
 // SetNodeTimerRequest is the request to set timer configuration for a node.
 type SetNodeTimerRequest struct {
-	NodeID            string
-	DelayAmount       int    `json:"delayAmount"`
-	DelayUnit         string `json:"delayUnit"` // minutes, hours, days, weeks
+	NodeID            string  `validate:"required"`
+	DelayAmount       int     `json:"delayAmount"`
+	DelayUnit         string  `json:"delayUnit" validate:"required"` // minutes, hours, days, weeks
 	WaitUntilTime     *string `json:"waitUntilTime,omitempty"`
 	WaitUntilTimezone *string `json:"waitUntilTimezone,omitempty"`
 }
 
 // SetNodeFilterRequest is the request to set filter configuration for a node.
 type SetNodeFilterRequest struct {
-	NodeID         string
+	NodeID         string                 `validate:"required"`
 	Conditions     map[string]interface{} `json:"conditions"`
 	NonMatchAction *string                `json:"nonMatchAction,omitempty"` // stop, continue
 }
@@ -1020,8 +2264,8 @@ type BranchCondition struct {
 
 // SetNodeBranchRequest is the request to set branch configuration for a node.
 type SetNodeBranchRequest struct {
-	NodeID           string
-	Branches         []BranchCondition `json:"branches"`
+	NodeID           string            `validate:"required"`
+	Branches         []BranchCondition `json:"branches" validate:"required"`
 	HasDefaultBranch *bool             `json:"hasDefaultBranch,omitempty"`
 }
 
@@ -1033,16 +2277,57 @@ type ExperimentVariant struct {
 
 // SetNodeExperimentRequest is the request to set experiment configuration for a node.
 type SetNodeExperimentRequest struct {
-	NodeID     string
+	NodeID     string              `validate:"required"`
 	SampleSize *int                `json:"sampleSize,omitempty"`
-	Variants   []ExperimentVariant `json:"variants"`
+	Variants   []ExperimentVariant `json:"variants" validate:"required"`
+}
+
+// WebhookMethod is the HTTP method a webhook node uses.
+type WebhookMethod string
+
+const (
+	WebhookMethodGet    WebhookMethod = "GET"
+	WebhookMethodPost   WebhookMethod = "POST"
+	WebhookMethodPut    WebhookMethod = "PUT"
+	WebhookMethodPatch  WebhookMethod = "PATCH"
+	WebhookMethodDelete WebhookMethod = "DELETE"
+)
+
+// WebhookFailureAction controls how an entry proceeds when a webhook node's
+// request fails after all retries.
+type WebhookFailureAction string
+
+const (
+	WebhookFailureContinue WebhookFailureAction = "continue"
+	WebhookFailureStop     WebhookFailureAction = "stop"
+	WebhookFailureBranch   WebhookFailureAction = "branch"
+)
+
+// SetNodeWebhookRequest is the request to configure a webhook node, which
+// calls an external URL when a contact reaches it. PayloadTemplate is a JSON
+// body that may reference contact fields and event properties with template
+// variables, e.g. {"email": "{{contact.email}}"}. When Secret is set, each
+// request is signed the same way as webhook endpoint deliveries (see
+// WebhookSignatureHeader). With OnFailure set to branch, failed requests
+// follow the node's "no" connection and successful ones its "yes"
+// connection.
+type SetNodeWebhookRequest struct {
+	NodeID          string                `json:"-" validate:"required"`
+	URL             string                `json:"url" validate:"required"`
+	Method          WebhookMethod         `json:"method" validate:"required"`
+	Headers         map[string]string     `json:"headers,omitempty"`
+	PayloadTemplate *string               `json:"payloadTemplate,omitempty"`
+	Secret          *string               `json:"secret,omitempty"`
+	TimeoutSeconds  *int                  `json:"timeoutSeconds,omitempty"`
+	MaxRetries      *int                  `json:"maxRetries,omitempty"`
+	OnFailure       *WebhookFailureAction `json:"onFailure,omitempty"`
 }
 
 // CreateConnectionRequest is the request to create a connection.
 type CreateConnectionRequest struct {
-	ID             string // sequence ID
-	SourceNodeID   string          `json:"sourceNodeId"`
-	TargetNodeID   string          `json:"targetNodeId"`
+	ID             string          `validate:"required"` // sequence ID
+	SourceNodeID   string          `json:"sourceNodeId" validate:"required"`
+	TargetNodeID   string          `json:"targetNodeId" validate:"required"`
 	ConnectionType *ConnectionType `json:"connectionType,omitempty"`
 	Label          *string         `json:"label,omitempty"`
 }
@@ -1078,7 +2363,7 @@ type SequenceEntry struct {
 
 // ListSequenceEntriesRequest is the request to list sequence entries.
 type ListSequenceEntriesRequest struct {
-	ID     string // sequence ID
+	ID     string               `validate:"required"` // sequence ID
 	Status *SequenceEntryStatus `url:"status,omitempty"`
 	Limit  *int                 `url:"limit,omitempty"`
 	Offset *int                 `url:"offset,omitempty"`
@@ -1094,14 +2379,14 @@ type ListSequenceEntriesResponse struct {
 
 // AddContactToSequenceRequest is the request to add a contact to a sequence.
 type AddContactToSequenceRequest struct {
-	ID        string // sequence ID
-	ContactID string `json:"contactId"`
+	ID        string `validate:"required"` // sequence ID
+	ContactID string `json:"contactId" validate:"required"`
 }
 
 // RemoveContactFromSequenceRequest is the request to remove a contact from a sequence.
 type RemoveContactFromSequenceRequest struct {
-	ID      string // sequence ID
-	EntryID string `json:"entryId"`
+	ID      string  `validate:"required"` // sequence ID
+	EntryID string  `json:"entryId" validate:"required"`
 	Reason  *string `json:"reason,omitempty"`
 }
 
@@ -1110,6 +2395,164 @@ type RemoveContactFromSequenceResponse struct {
 	Success bool `json:"success"`
 }
 
+// AddContactsToSequenceRequest is the request to enroll contacts in a
+// sequence in bulk. Set ContactIDs, AudienceID or both.
+type AddContactsToSequenceRequest struct {
+	ID         string   `json:"-" validate:"required"` // sequence ID
+	ContactIDs []string `json:"contactIds,omitempty"`
+	AudienceID *string  `json:"audienceId,omitempty"`
+}
+
+// RemoveContactsFromSequenceRequest is the request to remove contacts from a
+// sequence in bulk. Set ContactIDs, AudienceID or both.
+type RemoveContactsFromSequenceRequest struct {
+	ID         string   `json:"-" validate:"required"` // sequence ID
+	ContactIDs []string `json:"contactIds,omitempty"`
+	AudienceID *string  `json:"audienceId,omitempty"`
+	Reason     *string  `json:"reason,omitempty"`
+}
+
+// SequenceContactsJobStatus is the status of a bulk sequence contacts job.
+type SequenceContactsJobStatus string
+
+const (
+	SequenceContactsJobPending    SequenceContactsJobStatus = "pending"
+	SequenceContactsJobProcessing SequenceContactsJobStatus = "processing"
+	SequenceContactsJobCompleted  SequenceContactsJobStatus = "completed"
+	SequenceContactsJobFailed     SequenceContactsJobStatus = "failed"
+)
+
+// SequenceContactsJob is an asynchronous job adding or removing contacts in a
+// sequence. Skipped counts contacts that were already in the sequence when
+// adding, or not in it when removing.
+type SequenceContactsJob struct {
+	ID          string                    `json:"id"`
+	SequenceID  string                    `json:"sequenceId"`
+	Operation   string                    `json:"operation"` // "add" or "remove"
+	Status      SequenceContactsJobStatus `json:"status"`
+	Total       int                       `json:"total"`
+	Processed   int                       `json:"processed"`
+	Succeeded   int                       `json:"succeeded"`
+	Skipped     int                       `json:"skipped"`
+	Failed      int                       `json:"failed"`
+	Error       *string                   `json:"error"`
+	CreatedAt   time.Time                 `json:"createdAt"`
+	CompletedAt *time.Time                `json:"completedAt"`
+}
+
+// SequenceRevisionSource describes what created a sequence revision.
+type SequenceRevisionSource string
+
+const (
+	SequenceRevisionEdit     SequenceRevisionSource = "edit"
+	SequenceRevisionPublish  SequenceRevisionSource = "publish"
+	SequenceRevisionImport   SequenceRevisionSource = "import"
+	SequenceRevisionRollback SequenceRevisionSource = "rollback"
+)
+
+// SequenceRevision is a saved version of a sequence's settings and graph.
+// Snapshot is only populated by GetRevision.
+type SequenceRevision struct {
+	ID              string                 `json:"id"`
+	SequenceID      string                 `json:"sequenceId"`
+	Revision        int                    `json:"revision"`
+	Source          SequenceRevisionSource `json:"source"`
+	Published       bool                   `json:"published"`
+	Snapshot        *SequenceExport        `json:"snapshot,omitempty"`
+	CreatedByUserID *string                `json:"createdByUserId"`
+	CreatedAt       time.Time              `json:"createdAt"`
+}
+
+// ListSequenceRevisionsRequest is the request to list sequence revisions.
+type ListSequenceRevisionsRequest struct {
+	ID     string `json:"-" validate:"required"`
+	Limit  *int   `url:"limit,omitempty"`
+	Offset *int   `url:"offset,omitempty"`
+}
+
+// ListSequenceRevisionsResponse is the response when listing sequence
+// revisions, newest first.
+type ListSequenceRevisionsResponse struct {
+	Revisions []SequenceRevision `json:"revisions"`
+	Total     int                `json:"total"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+}
+
+// RollbackSequenceResponse is the response when rolling back a sequence.
+type RollbackSequenceResponse struct {
+	Sequence SequenceWithNodes `json:"sequence"`
+	Revision SequenceRevision  `json:"revision"`
+}
+
+// SimulationContact is the test contact run through a sequence simulation.
+// Set ContactID to simulate an existing contact, or Email and the other
+// fields to describe a contact that doesn't exist.
+type SimulationContact struct {
+	ContactID *string                `json:"contactId,omitempty"`
+	Email     string                 `json:"email,omitempty"`
+	FirstName *string                `json:"firstName,omitempty"`
+	LastName  *string                `json:"lastName,omitempty"`
+	Timezone  *string                `json:"timezone,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// SimulatedEvent is an event the test contact performs during a simulation,
+// AfterMinutes after entering the sequence.
+type SimulatedEvent struct {
+	EventName    string                 `json:"eventName"`
+	Properties   map[string]interface{} `json:"properties,omitempty"`
+	AfterMinutes int                    `json:"afterMinutes"`
+}
+
+// SimulateSequenceRequest is the request to dry-run a contact through a
+// sequence. MaxSteps stops the simulation after that many nodes.
+type SimulateSequenceRequest struct {
+	ID       string            `json:"-" validate:"required"`
+	Contact  SimulationContact `json:"contact"`
+	Events   []SimulatedEvent  `json:"events,omitempty"`
+	MaxSteps *int              `json:"maxSteps,omitempty"`
+}
+
+// SimulationOutcome is how a sequence simulation ended.
+type SimulationOutcome string
+
+const (
+	SimulationOutcomeCompleted SimulationOutcome = "completed"
+	SimulationOutcomeExited    SimulationOutcome = "exited"
+	SimulationOutcomeWaiting   SimulationOutcome = "waiting"
+	SimulationOutcomeMaxSteps  SimulationOutcome = "max_steps"
+)
+
+// SimulationStep is a node the test contact passed through. Connection is
+// the path taken out of the node, e.g. ConnectionYes after a filter.
+type SimulationStep struct {
+	NodeID       string           `json:"nodeId"`
+	NodeType     SequenceNodeType `json:"nodeType"`
+	NodeName     string           `json:"nodeName"`
+	AfterMinutes int              `json:"afterMinutes"`
+	Connection   *ConnectionType  `json:"connection,omitempty"`
+	Detail       *string          `json:"detail,omitempty"`
+}
+
+// SimulatedEmail is an email that would have been sent during a simulation.
+type SimulatedEmail struct {
+	NodeID           string   `json:"nodeId"`
+	To               string   `json:"to"`
+	Subject          string   `json:"subject"`
+	HTML             string   `json:"html"`
+	Text             *string  `json:"text,omitempty"`
+	AfterMinutes     int      `json:"afterMinutes"`
+	MissingVariables []string `json:"missingVariables,omitempty"`
+}
+
+// SequenceSimulation is the result of a sequence simulation.
+type SequenceSimulation struct {
+	Outcome SimulationOutcome `json:"outcome"`
+	Steps   []SimulationStep  `json:"steps"`
+	Emails  []SimulatedEmail  `json:"emails"`
+}
+
 // SequenceAnalyticsResponse contains sequence analytics.
 type SequenceAnalyticsResponse struct {
 	Sequence struct {
@@ -1164,14 +2607,14 @@ type MailEvent struct {
 type CreateEventRequest struct {
 	ProjectSlug      *string                `json:"projectSlug,omitempty"`
 	Environment      *types.Environment     `json:"environment,omitempty"`
-	Name             string                 `json:"name"`
+	Name             string                 `json:"name" validate:"required"`
 	Description      *string                `json:"description,omitempty"`
 	PropertiesSchema *EventPropertiesSchema `json:"propertiesSchema,omitempty"`
 }
 
 // UpdateEventRequest is the request to update an event.
 type UpdateEventRequest struct {
-	ID               string
+	ID               string                 `validate:"required"`
 	Name             *string                `json:"name,omitempty"`
 	Description      *string                `json:"description,omitempty"`
 	PropertiesSchema *EventPropertiesSchema `json:"propertiesSchema,omitempty"`
@@ -1202,17 +2645,35 @@ type DeleteEventResponse struct {
 // TrackEventRequest is the request to track an event.
 type TrackEventRequest struct {
 	Environment  *types.Environment     `json:"environment,omitempty"`
-	EventName    string                 `json:"eventName"`
+	EventName    string                 `json:"eventName" validate:"required"`
 	ContactID    *string                `json:"contactId,omitempty"`
 	ContactEmail *string                `json:"contactEmail,omitempty"`
 	Properties   map[string]interface{} `json:"properties,omitempty"`
 }
 
+// TriggeredSequence is a sequence a tracked event entered the contact into.
+type TriggeredSequence struct {
+	SequenceID string `json:"sequenceId"`
+	EntryID    string `json:"entryId"`
+}
+
+// SuppressedSequence is a sequence whose trigger matched a tracked event but
+// did not fire because of its trigger frequency, e.g. a "once" sequence the
+// contact already entered.
+type SuppressedSequence struct {
+	SequenceID string                   `json:"sequenceId"`
+	Frequency  SequenceTriggerFrequency `json:"frequency"`
+}
+
 // TrackEventResponse is the response when tracking an event.
+// TriggeredSequences lists the sequences the event entered the contact into,
+// and SuppressedSequences those whose frequency rules prevented it.
 type TrackEventResponse struct {
-	Success           bool    `json:"success"`
-	EventOccurrenceID *string `json:"eventOccurrenceId,omitempty"`
-	Error             *string `json:"error,omitempty"`
+	Success             bool                 `json:"success"`
+	EventOccurrenceID   *string              `json:"eventOccurrenceId,omitempty"`
+	Error               *string              `json:"error,omitempty"`
+	TriggeredSequences  []TriggeredSequence  `json:"triggeredSequences,omitempty"`
+	SuppressedSequences []SuppressedSequence `json:"suppressedSequences,omitempty"`
 }
 
 // BatchTrackEventInput represents a single event in a batch track request.
@@ -1227,14 +2688,16 @@ type BatchTrackEventInput struct {
 // BatchTrackEventsRequest is the request to track multiple events.
 type BatchTrackEventsRequest struct {
 	Environment *types.Environment     `json:"environment,omitempty"`
-	Events      []BatchTrackEventInput `json:"events"`
+	Events      []BatchTrackEventInput `json:"events" validate:"required"`
 }
 
 // BatchTrackEventResult represents the result of tracking a single event in a batch.
 type BatchTrackEventResult struct {
-	Success           bool    `json:"success"`
-	EventOccurrenceID *string `json:"eventOccurrenceId,omitempty"`
-	Error             *string `json:"error,omitempty"`
+	Success             bool                 `json:"success"`
+	EventOccurrenceID   *string              `json:"eventOccurrenceId,omitempty"`
+	Error               *string              `json:"error,omitempty"`
+	TriggeredSequences  []TriggeredSequence  `json:"triggeredSequences,omitempty"`
+	SuppressedSequences []SuppressedSequence `json:"suppressedSequences,omitempty"`
 }
 
 // BatchTrackEventsResponse is the response when tracking multiple events.
@@ -1274,6 +2737,23 @@ type ListEventOccurrencesResponse struct {
 	Offset      int               `json:"offset"`
 }
 
+// DeleteEventOccurrencesRequest selects the event occurrences to delete.
+// Filters are combined, so EventID with EndDate deletes that event's
+// occurrences recorded before EndDate.
+type DeleteEventOccurrencesRequest struct {
+	EventID   *string    `json:"eventId,omitempty"`
+	ContactID *string    `json:"contactId,omitempty"`
+	StartDate *time.Time `json:"startDate,omitempty"`
+	EndDate   *time.Time `json:"endDate,omitempty"`
+}
+
+// DeleteEventOccurrencesResponse is the response when deleting event
+// occurrences.
+type DeleteEventOccurrencesResponse struct {
+	Success bool `json:"success"`
+	Deleted int  `json:"deleted"`
+}
+
 // EventAnalyticsResponse contains event analytics.
 type EventAnalyticsResponse struct {
 	TotalReceived  int        `json:"totalReceived"`
@@ -1284,3 +2764,365 @@ type EventAnalyticsResponse struct {
 		Count int    `json:"count"`
 	} `json:"dailyCounts"`
 }
+
+// SendingHours is a daily window, in the calendar's timezone, during which
+// emails may be sent. Start and End use 24-hour "HH:MM" format.
+type SendingHours struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// BlackoutDate is a date on which no emails are sent. Date uses "YYYY-MM-DD"
+// format. When Regions is set, the blackout only applies to recipients in
+// those regions.
+type BlackoutDate struct {
+	Date    string   `json:"date"`
+	Name    *string  `json:"name,omitempty"`
+	Regions []string `json:"regions,omitempty"`
+}
+
+// SendingCalendar controls when a project's emails may be sent. Scheduled
+// sends, broadcasts, campaigns and sequence steps that fall outside the
+// calendar are deferred to the next allowed time.
+type SendingCalendar struct {
+	ProjectSlug     string         `json:"projectSlug"`
+	Environment     string         `json:"environment"`
+	Enabled         bool           `json:"enabled"`
+	Timezone        string         `json:"timezone"`
+	AllowedWeekdays []time.Weekday `json:"allowedWeekdays"`
+	AllowedHours    *SendingHours  `json:"allowedHours,omitempty"`
+	BlackoutDates   []BlackoutDate `json:"blackoutDates"`
+	HolidayRegions  []string       `json:"holidayRegions"`
+	UpdatedAt       *time.Time     `json:"updatedAt,omitempty"`
+}
+
+// GetSendingCalendarRequest is the request to get a sending calendar.
+type GetSendingCalendarRequest struct {
+	ProjectSlug *string            `url:"projectSlug,omitempty"`
+	Environment *types.Environment `url:"environment,omitempty"`
+}
+
+// UpdateSendingCalendarRequest is the request to update a sending calendar.
+// HolidayRegions takes ISO 3166-1 alpha-2 country codes; public holidays in
+// those regions are treated as blackout dates.
+type UpdateSendingCalendarRequest struct {
+	ProjectSlug     *string            `json:"projectSlug,omitempty"`
+	Environment     *types.Environment `json:"environment,omitempty"`
+	Enabled         *bool              `json:"enabled,omitempty"`
+	Timezone        *string            `json:"timezone,omitempty"`
+	AllowedWeekdays []time.Weekday     `json:"allowedWeekdays,omitempty"`
+	AllowedHours    *SendingHours      `json:"allowedHours,omitempty"`
+	BlackoutDates   []BlackoutDate     `json:"blackoutDates,omitempty"`
+	HolidayRegions  []string           `json:"holidayRegions,omitempty"`
+}
+
+// CheckSendingWindowRequest is the request to check a time against the
+// sending calendar. Region optionally applies region-specific blackouts.
+type CheckSendingWindowRequest struct {
+	ProjectSlug *string            `url:"projectSlug,omitempty"`
+	Environment *types.Environment `url:"environment,omitempty"`
+	At          time.Time          `url:"at"`
+	Region      *string            `url:"region,omitempty"`
+}
+
+// CheckSendingWindowResponse reports whether sending is allowed at a time and,
+// if not, why and when the next allowed time is.
+type CheckSendingWindowResponse struct {
+	Allowed       bool       `json:"allowed"`
+	Reason        *string    `json:"reason,omitempty"`
+	NextAllowedAt *time.Time `json:"nextAllowedAt,omitempty"`
+}
+
+// PlacementTestStatus represents the status of an inbox placement test.
+type PlacementTestStatus string
+
+const (
+	PlacementTestStatusPending   PlacementTestStatus = "pending"
+	PlacementTestStatusRunning   PlacementTestStatus = "running"
+	PlacementTestStatusCompleted PlacementTestStatus = "completed"
+	PlacementTestStatusFailed    PlacementTestStatus = "failed"
+)
+
+// Placement is where a message landed in a seed mailbox.
+type Placement string
+
+const (
+	PlacementInbox      Placement = "inbox"
+	PlacementSpam       Placement = "spam"
+	PlacementPromotions Placement = "promotions"
+	PlacementMissing    Placement = "missing"
+)
+
+// CreatePlacementTestRequest is the request to create an inbox placement test.
+// Set either TemplateID or HTML.
+type CreatePlacementTestRequest struct {
+	ProjectSlug       *string                `json:"projectSlug,omitempty"`
+	Environment       *types.Environment     `json:"environment,omitempty"`
+	From              interface{}            `json:"from" validate:"required"` // string or EmailAddress
+	Subject           string                 `json:"subject" validate:"required"`
+	TemplateID        *string                `json:"templateId,omitempty"`
+	TemplateVariables map[string]interface{} `json:"templateVariables,omitempty"`
+	HTML              *string                `json:"html,omitempty"`
+	Text              *string                `json:"text,omitempty"`
+	Providers         []MailboxProvider      `json:"providers,omitempty"`
+}
+
+// PlacementResult is the placement of a message in a single seed mailbox.
+type PlacementResult struct {
+	Provider    MailboxProvider `json:"provider"`
+	SeedAddress string          `json:"seedAddress"`
+	Placement   Placement       `json:"placement"`
+	ReceivedAt  *time.Time      `json:"receivedAt,omitempty"`
+}
+
+// PlacementSummary counts seed mailboxes by placement.
+type PlacementSummary struct {
+	Inbox      int `json:"inbox"`
+	Spam       int `json:"spam"`
+	Promotions int `json:"promotions"`
+	Missing    int `json:"missing"`
+}
+
+// PlacementTest is an inbox placement test sent to a list of seed mailboxes.
+type PlacementTest struct {
+	ID          string              `json:"id"`
+	Status      PlacementTestStatus `json:"status"`
+	Subject     string              `json:"subject"`
+	TemplateID  *string             `json:"templateId,omitempty"`
+	Summary     PlacementSummary    `json:"summary"`
+	Results     []PlacementResult   `json:"results"`
+	CreatedAt   time.Time           `json:"createdAt"`
+	CompletedAt *time.Time          `json:"completedAt,omitempty"`
+}
+
+// MailUsage is the organization's email quota for the current billing
+// period. DailyLimit is nil if the plan has no daily cap.
+// ProjectedExhaustionAt is when the quota runs out at the current sending
+// rate, or nil if it is not expected to run out before PeriodEnd.
+type MailUsage struct {
+	Plan                  string     `json:"plan"`
+	PeriodStart           time.Time  `json:"periodStart"`
+	PeriodEnd             time.Time  `json:"periodEnd"`
+	MonthlyLimit          int        `json:"monthlyLimit"`
+	DailyLimit            *int       `json:"dailyLimit,omitempty"`
+	SentThisPeriod        int        `json:"sentThisPeriod"`
+	SentToday             int        `json:"sentToday"`
+	Remaining             int        `json:"remaining"`
+	ProjectedExhaustionAt *time.Time `json:"projectedExhaustionAt,omitempty"`
+}
+
+// SpamRuleHit is a spam rule that matched a message. Score is the rule's
+// contribution to the total; negative scores count in the message's favour.
+type SpamRuleHit struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Score       float64 `json:"score"`
+}
+
+// SpamScoreResponse is the result of a spam score check. IsSpam reports
+// whether Score reaches Threshold.
+type SpamScoreResponse struct {
+	Score     float64       `json:"score"`
+	Threshold float64       `json:"threshold"`
+	IsSpam    bool          `json:"isSpam"`
+	Rules     []SpamRuleHit `json:"rules"`
+}
+
+// WebhookEndpoint is a URL that receives mail webhook events.
+type WebhookEndpoint struct {
+	ID          string             `json:"id"`
+	URL         string             `json:"url"`
+	Description *string            `json:"description"`
+	Events      []WebhookEventType `json:"events"`
+	Enabled     bool               `json:"enabled"`
+	Secret      *string            `json:"secret,omitempty"`
+	Environment string             `json:"environment"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	UpdatedAt   *time.Time         `json:"updatedAt"`
+}
+
+// CreateWebhookRequest is the request to create a webhook endpoint. An empty
+// Events list subscribes to all event types.
+type CreateWebhookRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	URL         string             `json:"url" validate:"required"`
+	Description *string            `json:"description,omitempty"`
+	Events      []WebhookEventType `json:"events,omitempty"`
+	Enabled     *bool              `json:"enabled,omitempty"`
+}
+
+// UpdateWebhookRequest is the request to update a webhook endpoint.
+type UpdateWebhookRequest struct {
+	ID          string             `json:"-" validate:"required"`
+	URL         *string            `json:"url,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Events      []WebhookEventType `json:"events,omitempty"`
+	Enabled     *bool              `json:"enabled,omitempty"`
+}
+
+// ListWebhooksRequest is the request to list webhook endpoints.
+type ListWebhooksRequest struct {
+	Environment *types.Environment `url:"environment,omitempty"`
+	Limit       *int               `url:"limit,omitempty"`
+	Offset      *int               `url:"offset,omitempty"`
+}
+
+// ListWebhooksResponse is the response when listing webhook endpoints.
+type ListWebhooksResponse struct {
+	Webhooks []WebhookEndpoint `json:"webhooks"`
+	Total    int               `json:"total"`
+}
+
+// DeleteWebhookResponse is the response when deleting a webhook endpoint.
+type DeleteWebhookResponse struct {
+	Success bool `json:"success"`
+}
+
+// RotateWebhookSecretResponse is the response when rotating a webhook secret.
+type RotateWebhookSecretResponse struct {
+	Secret                  string     `json:"secret"`
+	PreviousSecretExpiresAt *time.Time `json:"previousSecretExpiresAt"`
+}
+
+// WebhookDeliveryStatus represents the status of a webhook delivery attempt.
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is a single attempt to deliver an event to an endpoint.
+type WebhookDelivery struct {
+	ID             string                `json:"id"`
+	WebhookID      string                `json:"webhookId"`
+	EventID        string                `json:"eventId"`
+	EventType      WebhookEventType      `json:"eventType"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempt        int                   `json:"attempt"`
+	ResponseStatus *int                  `json:"responseStatus"`
+	ResponseBody   *string               `json:"responseBody"`
+	Error          *string               `json:"error"`
+	DurationMs     *int                  `json:"durationMs"`
+	NextRetryAt    *time.Time            `json:"nextRetryAt"`
+	CreatedAt      time.Time             `json:"createdAt"`
+}
+
+// ListWebhookDeliveriesRequest is the request to list delivery attempts.
+type ListWebhookDeliveriesRequest struct {
+	WebhookID string                 `validate:"required"`
+	Status    *WebhookDeliveryStatus `url:"status,omitempty"`
+	EventType *WebhookEventType      `url:"eventType,omitempty"`
+	Limit     *int                   `url:"limit,omitempty"`
+	Offset    *int                   `url:"offset,omitempty"`
+}
+
+// ListWebhookDeliveriesResponse is the response when listing delivery attempts.
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	Total      int               `json:"total"`
+}
+
+// ProviderType identifies the backend of a sending provider.
+type ProviderType string
+
+const (
+	ProviderSES      ProviderType = "ses"
+	ProviderSMTP     ProviderType = "smtp"
+	ProviderPostmark ProviderType = "postmark"
+)
+
+// SESCredentials holds the credentials for sending through your own Amazon
+// SES account. The secret access key is never returned.
+type SESCredentials struct {
+	AccessKeyID      string  `json:"accessKeyId"`
+	SecretAccessKey  string  `json:"secretAccessKey,omitempty"`
+	Region           string  `json:"region"`
+	ConfigurationSet *string `json:"configurationSet,omitempty"`
+}
+
+// Provider is a sending backend configured for an environment, used instead
+// of the built-in one for sends it is routed. Secrets are never returned: the
+// SES secret key and SMTP password are omitted and only the last four
+// characters of an API key are included.
+type Provider struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Type        ProviderType    `json:"type"`
+	Environment string          `json:"environment"`
+	SES         *SESCredentials `json:"ses,omitempty"`
+	SMTP        *SMTPServer     `json:"smtp,omitempty"`
+	APIKeyLast4 *string         `json:"apiKeyLast4,omitempty"`
+	IsDefault   bool            `json:"isDefault"`
+	Enabled     bool            `json:"enabled"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   *time.Time      `json:"updatedAt"`
+}
+
+// CreateProviderRequest is the request to configure a sending provider. Set
+// SES for ProviderSES, SMTP for ProviderSMTP and APIKey for ProviderPostmark.
+// A default provider handles every send that no route matches.
+type CreateProviderRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	Name        string             `json:"name" validate:"required"`
+	Type        ProviderType       `json:"type" validate:"required"`
+	SES         *SESCredentials    `json:"ses,omitempty"`
+	SMTP        *SMTPServer        `json:"smtp,omitempty"`
+	APIKey      *string            `json:"apiKey,omitempty"`
+	IsDefault   *bool              `json:"isDefault,omitempty"`
+	Enabled     *bool              `json:"enabled,omitempty"`
+}
+
+// UpdateProviderRequest is the request to update a sending provider.
+type UpdateProviderRequest struct {
+	ID        string          `json:"-" validate:"required"`
+	Name      *string         `json:"name,omitempty"`
+	SES       *SESCredentials `json:"ses,omitempty"`
+	SMTP      *SMTPServer     `json:"smtp,omitempty"`
+	APIKey    *string         `json:"apiKey,omitempty"`
+	IsDefault *bool           `json:"isDefault,omitempty"`
+	Enabled   *bool           `json:"enabled,omitempty"`
+}
+
+// ListProvidersRequest is the request to list sending providers.
+type ListProvidersRequest struct {
+	Environment *types.Environment `url:"environment,omitempty"`
+}
+
+// ListProvidersResponse is the response when listing sending providers.
+type ListProvidersResponse struct {
+	Providers []Provider `json:"providers"`
+}
+
+// DeleteProviderResponse is the response when deleting a sending provider.
+type DeleteProviderResponse struct {
+	Success bool `json:"success"`
+}
+
+// TestProviderResponse is the result of checking a provider's credentials.
+type TestProviderResponse struct {
+	Success bool    `json:"success"`
+	Error   *string `json:"error,omitempty"`
+}
+
+// ProviderRoute sends emails matching Tag or sender Domain through a
+// provider. When both are set, both must match. Routes are evaluated in
+// order and the first match wins.
+type ProviderRoute struct {
+	ProviderID string  `json:"providerId"`
+	Tag        *string `json:"tag,omitempty"`
+	Domain     *string `json:"domain,omitempty"`
+}
+
+// SetProviderRoutesRequest replaces the routes of an environment.
+type SetProviderRoutesRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	Routes      []ProviderRoute    `json:"routes"`
+}
+
+// ProviderRoutesResponse lists the routes of an environment in evaluation
+// order.
+type ProviderRoutesResponse struct {
+	Routes []ProviderRoute `json:"routes"`
+}
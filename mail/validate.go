@@ -0,0 +1,216 @@
+package mail
+
+import (
+	"strings"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+func init() {
+	types.RegisterEnum(MissingVariableError, MissingVariableEmpty, MissingVariableKeep)
+	types.RegisterEnum(
+		EmailStatusPending,
+		EmailStatusSent,
+		EmailStatusDelivered,
+		EmailStatusBounced,
+		EmailStatusFailed,
+		EmailStatusDeferred,
+		EmailStatusOpened,
+		EmailStatusClicked,
+		EmailStatusComplained,
+		EmailStatusUnsubscribed,
+	)
+	types.RegisterEnum(
+		FallbackProviderSMTP,
+		FallbackProviderSendGrid,
+		FallbackProviderPostmark,
+		FallbackProviderMailgun,
+	)
+	types.RegisterEnum(
+		TemplateFieldName,
+		TemplateFieldSlug,
+		TemplateFieldSlugAliases,
+		TemplateFieldDescription,
+		TemplateFieldSubject,
+		TemplateFieldPreviewText,
+		TemplateFieldHTML,
+		TemplateFieldText,
+		TemplateFieldMJML,
+		TemplateFieldMailyJSON,
+		TemplateFieldVariablesSchema,
+		TemplateFieldIsActive,
+		TemplateFieldVersion,
+		TemplateFieldCreatedAt,
+		TemplateFieldUpdatedAt,
+	)
+	types.RegisterEnum(MJMLValidationStrict, MJMLValidationSoft, MJMLValidationSkip)
+	types.RegisterEnum(PartialKindPartial, PartialKindLayout)
+	types.RegisterEnum(
+		ContactStatusSubscribed,
+		ContactStatusUnsubscribed,
+		ContactStatusBounced,
+		ContactStatusComplained,
+	)
+	types.RegisterEnum(ContactExpandEngagement)
+	types.RegisterEnum(ExportFormatCSV, ExportFormatNDJSON)
+	types.RegisterEnum(
+		CampaignStatusDraft,
+		CampaignStatusScheduled,
+		CampaignStatusSending,
+		CampaignStatusSent,
+		CampaignStatusPaused,
+		CampaignStatusCancelled,
+		CampaignStatusFailed,
+	)
+	types.RegisterEnum(TimezoneResolutionContact, TimezoneResolutionInferred)
+	types.RegisterEnum(
+		SequenceTriggerManual,
+		SequenceTriggerEventReceived,
+		SequenceTriggerContactAdded,
+		SequenceTriggerAPI,
+		SequenceTriggerScheduled,
+	)
+	types.RegisterEnum(SequenceTriggerOnce, SequenceTriggerAlways)
+	types.RegisterEnum(SequenceStatusDraft, SequenceStatusActive, SequenceStatusPaused, SequenceStatusArchived)
+	types.RegisterEnum(
+		SequenceNodeTrigger,
+		SequenceNodeEmail,
+		SequenceNodeTimer,
+		SequenceNodeFilter,
+		SequenceNodeBranch,
+		SequenceNodeExperiment,
+		SequenceNodeExit,
+		SequenceNodeAddToList,
+		SequenceNodeUpdateContact,
+		SequenceNodeWebhook,
+	)
+	types.RegisterEnum(
+		WebhookMethodGet,
+		WebhookMethodPost,
+		WebhookMethodPut,
+		WebhookMethodPatch,
+		WebhookMethodDelete,
+	)
+	types.RegisterEnum(WebhookFailureContinue, WebhookFailureStop, WebhookFailureBranch)
+	types.RegisterEnum(ConnectionDefault, ConnectionYes, ConnectionNo, ConnectionBranch, ConnectionVariant)
+	types.RegisterEnum(
+		SequenceEntryStatusActive,
+		SequenceEntryStatusPaused,
+		SequenceEntryStatusCompleted,
+		SequenceEntryStatusStopped,
+		SequenceEntryStatusFailed,
+	)
+	types.RegisterEnum(
+		MailboxProviderGmail,
+		MailboxProviderOutlook,
+		MailboxProviderYahoo,
+		MailboxProviderApple,
+		MailboxProviderOther,
+	)
+	types.RegisterEnum(
+		WebhookEventEmailSent,
+		WebhookEventEmailDelivered,
+		WebhookEventEmailOpened,
+		WebhookEventEmailClicked,
+		WebhookEventEmailBounced,
+		WebhookEventEmailComplained,
+		WebhookEventEmailFailed,
+		WebhookEventContactUnsubscribed,
+	)
+	types.RegisterEnum(WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed)
+	types.RegisterEnum(ProviderSES, ProviderSMTP, ProviderPostmark)
+}
+
+// Validate implements types.Validator. HTML conflicts with TemplateID and
+// TemplateSlug, which are themselves mutually exclusive.
+func (r *SendEmailRequest) Validate() error {
+	html := types.Field("html", r.HTML != nil)
+	templateID := types.Field("templateId", r.TemplateID != nil)
+	templateSlug := types.Field("templateSlug", r.TemplateSlug != nil)
+	return types.CheckFields(r,
+		types.MutuallyExclusive(templateID, templateSlug),
+		types.ConflictsWith(html, templateID, templateSlug),
+		types.ConflictsWith(types.Field("replyToEmailId", r.ReplyToEmailID != nil),
+			types.Field("headers.In-Reply-To", hasHeader(r.Headers, "In-Reply-To")),
+			types.Field("headers.References", hasHeader(r.Headers, "References")),
+		),
+	)
+}
+
+// hasHeader reports whether headers sets name, which is matched
+// case-insensitively as header names are.
+func hasHeader(headers map[string]string, name string) bool {
+	for k := range headers {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate implements types.Validator. Exactly one of Content, Path, AssetID,
+// PrivateFileID and Hash is required.
+func (a *Attachment) Validate() error {
+	content := types.Field("content", a.Content != "")
+	path := types.Field("path", a.Path != "")
+	assetID := types.Field("assetId", a.AssetID != "")
+	privateFileID := types.Field("privateFileId", a.PrivateFileID != "")
+	hash := types.Field("hash", a.Hash != "")
+	return types.CheckFields(a,
+		types.MutuallyExclusive(content, path, assetID, privateFileID, hash),
+		types.RequireOne(content, path, assetID, privateFileID, hash),
+	)
+}
+
+// Validate implements types.Validator. HTML, TemplateID and TemplateSlug are
+// checked as for SendEmailRequest.
+func (r *SendBroadcastEmailRequest) Validate() error {
+	html := types.Field("html", r.HTML != nil)
+	templateID := types.Field("templateId", r.TemplateID != nil)
+	templateSlug := types.Field("templateSlug", r.TemplateSlug != nil)
+	return types.CheckFields(r,
+		types.MutuallyExclusive(templateID, templateSlug),
+		types.ConflictsWith(html, templateID, templateSlug),
+	)
+}
+
+// Validate implements types.Validator. HTML or MJML is required.
+func (r *CreateTemplateRequest) Validate() error {
+	return types.CheckFields(r,
+		types.RequireOne(types.Field("html", r.HTML != ""), types.Field("mjml", r.MJML != nil)),
+	)
+}
+
+// Validate implements types.Validator. AudienceID and SegmentID are mutually
+// exclusive, as are TemplateID and HTML.
+func (r *CreateCampaignRequest) Validate() error {
+	return types.CheckFields(r,
+		types.MutuallyExclusive(types.Field("audienceId", r.AudienceID != nil), types.Field("segmentId", r.SegmentID != nil)),
+		types.MutuallyExclusive(types.Field("templateId", r.TemplateID != nil), types.Field("html", r.HTML != nil)),
+	)
+}
+
+// Validate implements types.Validator. ContactIDs or AudienceID is required.
+func (r *AddContactsToSequenceRequest) Validate() error {
+	return types.CheckFields(r,
+		types.RequireOne(types.Field("contactIds", len(r.ContactIDs) > 0), types.Field("audienceId", r.AudienceID != nil)),
+	)
+}
+
+// Validate implements types.Validator. ContactIDs or AudienceID is required.
+func (r *RemoveContactsFromSequenceRequest) Validate() error {
+	return types.CheckFields(r,
+		types.RequireOne(types.Field("contactIds", len(r.ContactIDs) > 0), types.Field("audienceId", r.AudienceID != nil)),
+	)
+}
+
+// Validate implements types.Validator. Exactly one of TemplateID and HTML is
+// required.
+func (r *CreatePlacementTestRequest) Validate() error {
+	templateID := types.Field("templateId", r.TemplateID != nil)
+	html := types.Field("html", r.HTML != nil)
+	return types.CheckFields(r,
+		types.MutuallyExclusive(templateID, html),
+		types.RequireOne(templateID, html),
+	)
+}
@@ -0,0 +1,100 @@
+package mail
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendEmailRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  SendEmailRequest
+		err  string
+	}{
+		{name: "html", req: SendEmailRequest{From: "a@example.com", To: "b@example.com", HTML: ptr("<p>Hi</p>")}},
+		{name: "template", req: SendEmailRequest{From: "a@example.com", To: "b@example.com", TemplateID: ptr("tpl-1"), Text: ptr("Hi")}},
+		{name: "missing recipients", req: SendEmailRequest{From: "a@example.com"}, err: "to: required"},
+		{
+			name: "html with template",
+			req:  SendEmailRequest{From: "a@example.com", To: "b@example.com", HTML: ptr("<p>Hi</p>"), TemplateSlug: ptr("welcome")},
+			err:  "html: cannot be set with templateSlug",
+		},
+		{
+			name: "template id and slug",
+			req:  SendEmailRequest{From: "a@example.com", To: "b@example.com", TemplateID: ptr("tpl-1"), TemplateSlug: ptr("welcome")},
+			err:  "templateId: cannot be set with templateSlug",
+		},
+		{
+			name: "environment",
+			req:  SendEmailRequest{From: "a@example.com", To: "b@example.com", Environment: ptr(types.Environment("staging"))},
+			err:  `environment: "staging" is not one of sandbox, production`,
+		},
+		{name: "reply", req: SendEmailRequest{From: "a@example.com", To: "b@example.com", ReplyToEmailID: ptr("email-1"), Text: ptr("Thanks")}},
+		{
+			name: "reply with threading headers",
+			req: SendEmailRequest{
+				From: "a@example.com", To: "b@example.com", ReplyToEmailID: ptr("email-1"),
+				Headers: map[string]string{"in-reply-to": "<abc@example.com>"},
+			},
+			err: "replyToEmailId: cannot be set with headers.In-Reply-To",
+		},
+		{
+			name: "attachment without content",
+			req:  SendEmailRequest{From: "a@example.com", To: "b@example.com", Attachments: []Attachment{{Filename: "a.pdf"}}},
+			err:  "attachments[0].content, path, assetId, privateFileId or hash: one is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidRequest)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestSendBatchEmailRequest_Validate(t *testing.T) {
+	err := types.Validate(&SendBatchEmailRequest{Emails: []SendEmailRequest{
+		{From: "a@example.com", To: "b@example.com", HTML: ptr("<p>Hi</p>")},
+		{From: "a@example.com", HTML: ptr("<p>Hi</p>"), TemplateID: ptr("tpl-1")},
+	}})
+
+	var verr *types.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, []types.FieldError{
+		{Field: "emails[1].to", Message: "required"},
+		{Field: "emails[1].html", Message: "cannot be set with templateId"},
+	}, verr.Fields)
+}
+
+func TestClient_Send_InvalidRequest(t *testing.T) {
+	mailClient, server := setupTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("invalid request was sent")
+	})
+	defer server.Close()
+
+	_, err := mailClient.Send(context.Background(), &SendEmailRequest{To: "b@example.com", HTML: ptr("<p>Hi</p>")})
+
+	assert.ErrorIs(t, err, types.ErrInvalidRequest)
+	assert.EqualError(t, err, "stack0: invalid SendEmailRequest: from: required")
+}
+
+func TestSequencesClient_AddContacts_InvalidRequest(t *testing.T) {
+	sequencesClient, server := setupSequencesTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("invalid request was sent")
+	})
+	defer server.Close()
+
+	_, err := sequencesClient.AddContacts(context.Background(), &AddContactsToSequenceRequest{})
+
+	assert.EqualError(t, err, "stack0: invalid AddContactsToSequenceRequest: ID: required; contactIds or audienceId: one is required")
+}
@@ -0,0 +1,292 @@
+package mail
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WebhookSignatureHeader is the header carrying the webhook signature, in the
+// form "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">".
+const WebhookSignatureHeader = "Stack0-Signature"
+
+// DefaultWebhookTolerance is the maximum age of a webhook timestamp accepted
+// by ParseWebhook, to limit replay of captured deliveries. Use
+// WithWebhookTolerance to change it for a call.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+var (
+	// ErrWebhookSecretEmpty is returned when ParseWebhook is called without a
+	// secret, which would make any signature computed with an empty key pass.
+	ErrWebhookSecretEmpty = errors.New("webhook secret is empty")
+	// ErrWebhookSignature is returned when the signature header is missing,
+	// malformed or does not match the payload.
+	ErrWebhookSignature = errors.New("invalid webhook signature")
+	// ErrWebhookTimestamp is returned when the signed timestamp is outside
+	// the tolerance.
+	ErrWebhookTimestamp = errors.New("webhook timestamp outside tolerance")
+)
+
+// WebhookEventType is the type of a mail webhook event.
+type WebhookEventType string
+
+const (
+	WebhookEventEmailSent           WebhookEventType = "email.sent"
+	WebhookEventEmailDelivered      WebhookEventType = "email.delivered"
+	WebhookEventEmailOpened         WebhookEventType = "email.opened"
+	WebhookEventEmailClicked        WebhookEventType = "email.clicked"
+	WebhookEventEmailBounced        WebhookEventType = "email.bounced"
+	WebhookEventEmailComplained     WebhookEventType = "email.complained"
+	WebhookEventEmailFailed         WebhookEventType = "email.failed"
+	WebhookEventContactUnsubscribed WebhookEventType = "contact.unsubscribed"
+)
+
+// WebhookEvent is implemented by every event returned from ParseWebhook.
+// Use a type switch to handle specific events.
+type WebhookEvent interface {
+	EventType() WebhookEventType
+}
+
+// WebhookEventMeta holds the fields common to all webhook events.
+type WebhookEventMeta struct {
+	ID        string           `json:"id"`
+	Type      WebhookEventType `json:"type"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+// EventType returns the event type.
+func (m WebhookEventMeta) EventType() WebhookEventType {
+	return m.Type
+}
+
+// EmailEventData is the email a webhook event refers to.
+type EmailEventData struct {
+	EmailID   string                 `json:"emailId"`
+	MessageID *string                `json:"messageId,omitempty"`
+	From      string                 `json:"from"`
+	To        string                 `json:"to"`
+	Subject   string                 `json:"subject"`
+	Tags      []string               `json:"tags,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// EmailSentEvent is sent when an email is accepted for delivery.
+type EmailSentEvent struct {
+	WebhookEventMeta
+	Data EmailEventData `json:"data"`
+}
+
+// EmailDeliveredEvent is sent when an email is delivered to the recipient's server.
+type EmailDeliveredEvent struct {
+	WebhookEventMeta
+	Data EmailEventData `json:"data"`
+}
+
+// EmailOpenedData is the payload of an email.opened event.
+type EmailOpenedData struct {
+	EmailEventData
+	UserAgent *string `json:"userAgent,omitempty"`
+	IPAddress *string `json:"ipAddress,omitempty"`
+}
+
+// EmailOpenedEvent is sent when a recipient opens an email.
+type EmailOpenedEvent struct {
+	WebhookEventMeta
+	Data EmailOpenedData `json:"data"`
+}
+
+// EmailClickedData is the payload of an email.clicked event.
+type EmailClickedData struct {
+	EmailEventData
+	URL       string  `json:"url"`
+	UserAgent *string `json:"userAgent,omitempty"`
+	IPAddress *string `json:"ipAddress,omitempty"`
+}
+
+// EmailClickedEvent is sent when a recipient clicks a tracked link.
+type EmailClickedEvent struct {
+	WebhookEventMeta
+	Data EmailClickedData `json:"data"`
+}
+
+// EmailBouncedData is the payload of an email.bounced event.
+type EmailBouncedData struct {
+	EmailEventData
+	BounceType string  `json:"bounceType"`
+	Reason     *string `json:"reason,omitempty"`
+}
+
+// EmailBouncedEvent is sent when an email bounces.
+type EmailBouncedEvent struct {
+	WebhookEventMeta
+	Data EmailBouncedData `json:"data"`
+}
+
+// EmailComplainedEvent is sent when a recipient marks an email as spam.
+type EmailComplainedEvent struct {
+	WebhookEventMeta
+	Data EmailEventData `json:"data"`
+}
+
+// EmailFailedData is the payload of an email.failed event.
+type EmailFailedData struct {
+	EmailEventData
+	Error string `json:"error"`
+}
+
+// EmailFailedEvent is sent when an email could not be sent.
+type EmailFailedEvent struct {
+	WebhookEventMeta
+	Data EmailFailedData `json:"data"`
+}
+
+// ContactUnsubscribedData is the payload of a contact.unsubscribed event.
+type ContactUnsubscribedData struct {
+	ContactID  string  `json:"contactId"`
+	Email      string  `json:"email"`
+	AudienceID *string `json:"audienceId,omitempty"`
+	EmailID    *string `json:"emailId,omitempty"`
+	Reason     *string `json:"reason,omitempty"`
+}
+
+// ContactUnsubscribedEvent is sent when a contact unsubscribes.
+type ContactUnsubscribedEvent struct {
+	WebhookEventMeta
+	Data ContactUnsubscribedData `json:"data"`
+}
+
+// UnknownWebhookEvent is returned for event types this SDK version does not
+// recognise. Data holds the raw payload.
+type UnknownWebhookEvent struct {
+	WebhookEventMeta
+	Data json.RawMessage `json:"data"`
+}
+
+// WebhookOption configures ParseWebhook and ParseWebhookPayload.
+type WebhookOption func(*webhookOptions)
+
+type webhookOptions struct {
+	tolerance time.Duration
+}
+
+// WithWebhookTolerance sets the maximum age of a webhook timestamp, in place
+// of DefaultWebhookTolerance.
+func WithWebhookTolerance(d time.Duration) WebhookOption {
+	return func(o *webhookOptions) {
+		o.tolerance = d
+	}
+}
+
+// ParseWebhook reads the body of a mail webhook request, verifies its
+// signature against secret and decodes it into a typed event.
+func ParseWebhook(r *http.Request, secret string, opts ...WebhookOption) (WebhookEvent, error) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook body: %w", err)
+	}
+	return ParseWebhookPayload(payload, r.Header.Get(WebhookSignatureHeader), secret, opts...)
+}
+
+// ParseWebhookPayload is like ParseWebhook for callers that have already read
+// the request body.
+func ParseWebhookPayload(payload []byte, signature, secret string, opts ...WebhookOption) (WebhookEvent, error) {
+	o := webhookOptions{tolerance: DefaultWebhookTolerance}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if err := verifyWebhookSignature(payload, signature, secret, time.Now(), o.tolerance); err != nil {
+		return nil, err
+	}
+
+	var meta WebhookEventMeta
+	if err := json.Unmarshal(payload, &meta); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook: %w", err)
+	}
+
+	var event WebhookEvent
+	switch meta.Type {
+	case WebhookEventEmailSent:
+		event = &EmailSentEvent{}
+	case WebhookEventEmailDelivered:
+		event = &EmailDeliveredEvent{}
+	case WebhookEventEmailOpened:
+		event = &EmailOpenedEvent{}
+	case WebhookEventEmailClicked:
+		event = &EmailClickedEvent{}
+	case WebhookEventEmailBounced:
+		event = &EmailBouncedEvent{}
+	case WebhookEventEmailComplained:
+		event = &EmailComplainedEvent{}
+	case WebhookEventEmailFailed:
+		event = &EmailFailedEvent{}
+	case WebhookEventContactUnsubscribed:
+		event = &ContactUnsubscribedEvent{}
+	default:
+		event = &UnknownWebhookEvent{}
+	}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, fmt.Errorf("failed to decode %s webhook: %w", meta.Type, err)
+	}
+	return event, nil
+}
+
+func verifyWebhookSignature(payload []byte, header, secret string, now time.Time, tolerance time.Duration) error {
+	if secret == "" {
+		return ErrWebhookSecretEmpty
+	}
+
+	var timestamp string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return ErrWebhookSignature
+	}
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrWebhookSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	expected := mac.Sum(nil)
+
+	valid := false
+	for _, sig := range signatures {
+		decoded, err := hex.DecodeString(sig)
+		if err == nil && hmac.Equal(decoded, expected) {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return ErrWebhookSignature
+	}
+
+	age := now.Sub(time.Unix(ts, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrWebhookTimestamp
+	}
+	return nil
+}
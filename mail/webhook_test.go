@@ -0,0 +1,120 @@
+package mail
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signWebhook(secret, payload string, ts time.Time) string {
+	t := strconv.FormatInt(ts.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t + "." + payload))
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func newWebhookRequest(payload, signature string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/webhooks/stack0", strings.NewReader(payload))
+	r.Header.Set(WebhookSignatureHeader, signature)
+	return r
+}
+
+func TestParseWebhook(t *testing.T) {
+	const secret = "whsec_test"
+
+	t.Run("typed events", func(t *testing.T) {
+		tests := []struct {
+			payload string
+			check   func(t *testing.T, event WebhookEvent)
+		}{
+			{
+				payload: `{"id":"evt_1","type":"email.delivered","createdAt":"2024-03-01T10:00:00Z","data":{"emailId":"email-1","to":"a@example.com"}}`,
+				check: func(t *testing.T, event WebhookEvent) {
+					e, ok := event.(*EmailDeliveredEvent)
+					require.True(t, ok)
+					assert.Equal(t, "evt_1", e.ID)
+					assert.Equal(t, "email-1", e.Data.EmailID)
+				},
+			},
+			{
+				payload: `{"id":"evt_2","type":"email.clicked","data":{"emailId":"email-1","url":"https://example.com/pricing"}}`,
+				check: func(t *testing.T, event WebhookEvent) {
+					e, ok := event.(*EmailClickedEvent)
+					require.True(t, ok)
+					assert.Equal(t, "https://example.com/pricing", e.Data.URL)
+					assert.Equal(t, "email-1", e.Data.EmailID)
+				},
+			},
+			{
+				payload: `{"id":"evt_3","type":"email.bounced","data":{"emailId":"email-2","bounceType":"hard","reason":"mailbox does not exist"}}`,
+				check: func(t *testing.T, event WebhookEvent) {
+					e, ok := event.(*EmailBouncedEvent)
+					require.True(t, ok)
+					assert.Equal(t, "hard", e.Data.BounceType)
+					assert.Equal(t, "mailbox does not exist", *e.Data.Reason)
+				},
+			},
+			{
+				payload: `{"id":"evt_4","type":"contact.unsubscribed","data":{"contactId":"contact-1","email":"a@example.com"}}`,
+				check: func(t *testing.T, event WebhookEvent) {
+					e, ok := event.(*ContactUnsubscribedEvent)
+					require.True(t, ok)
+					assert.Equal(t, "contact-1", e.Data.ContactID)
+				},
+			},
+			{
+				payload: `{"id":"evt_5","type":"email.new_thing","data":{"foo":"bar"}}`,
+				check: func(t *testing.T, event WebhookEvent) {
+					e, ok := event.(*UnknownWebhookEvent)
+					require.True(t, ok)
+					assert.Equal(t, WebhookEventType("email.new_thing"), e.EventType())
+					assert.JSONEq(t, `{"foo":"bar"}`, string(e.Data))
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			event, err := ParseWebhook(newWebhookRequest(tt.payload, signWebhook(secret, tt.payload, time.Now())), secret)
+			require.NoError(t, err)
+			tt.check(t, event)
+		}
+	})
+
+	t.Run("rejects bad signature", func(t *testing.T) {
+		payload := `{"id":"evt_1","type":"email.delivered","data":{}}`
+
+		_, err := ParseWebhook(newWebhookRequest(payload, signWebhook("wrong", payload, time.Now())), secret)
+		assert.ErrorIs(t, err, ErrWebhookSignature)
+
+		_, err = ParseWebhook(newWebhookRequest(payload, ""), secret)
+		assert.ErrorIs(t, err, ErrWebhookSignature)
+	})
+
+	t.Run("rejects stale timestamp", func(t *testing.T) {
+		payload := `{"id":"evt_1","type":"email.delivered","data":{}}`
+
+		_, err := ParseWebhook(newWebhookRequest(payload, signWebhook(secret, payload, time.Now().Add(-time.Hour))), secret)
+		assert.ErrorIs(t, err, ErrWebhookTimestamp)
+
+		_, err = ParseWebhook(newWebhookRequest(payload, signWebhook(secret, payload, time.Now().Add(-time.Hour))), secret,
+			WithWebhookTolerance(2*time.Hour))
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects empty secret", func(t *testing.T) {
+		payload := `{"id":"evt_1","type":"email.delivered","data":{}}`
+
+		_, err := ParseWebhook(newWebhookRequest(payload, signWebhook("", payload, time.Now())), "")
+		assert.ErrorIs(t, err, ErrWebhookSecretEmpty)
+	})
+}
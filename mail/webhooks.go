@@ -0,0 +1,147 @@
+package mail
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// WebhooksClient handles webhook endpoint operations.
+type WebhooksClient struct {
+	http *client.HTTPClient
+}
+
+// NewWebhooksClient creates a new webhooks client.
+func NewWebhooksClient(http *client.HTTPClient) *WebhooksClient {
+	return &WebhooksClient{http: http}
+}
+
+// Create creates a webhook endpoint. The signing secret is only returned in
+// the response to Create and RotateSecret.
+func (c *WebhooksClient) Create(ctx context.Context, req *CreateWebhookRequest) (*WebhookEndpoint, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp WebhookEndpoint
+	if err := c.http.Post(ctx, "/mail/webhooks", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// List lists webhook endpoints.
+func (c *WebhooksClient) List(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Offset != nil {
+			params.Set("offset", strconv.Itoa(*req.Offset))
+		}
+	}
+
+	path := "/mail/webhooks"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListWebhooksResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Get retrieves a webhook endpoint by ID.
+func (c *WebhooksClient) Get(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	var resp WebhookEndpoint
+	if err := c.http.Get(ctx, "/mail/webhooks/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Update updates a webhook endpoint.
+func (c *WebhooksClient) Update(ctx context.Context, req *UpdateWebhookRequest) (*WebhookEndpoint, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	var resp WebhookEndpoint
+	if err := c.http.Put(ctx, "/mail/webhooks/"+req.ID, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Delete deletes a webhook endpoint.
+func (c *WebhooksClient) Delete(ctx context.Context, id string) (*DeleteWebhookResponse, error) {
+	var resp DeleteWebhookResponse
+	if err := c.http.Delete(ctx, "/mail/webhooks/"+id, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RotateSecret generates a new signing secret for a webhook endpoint. The
+// previous secret stays valid until PreviousSecretExpiresAt so receivers can
+// be updated without dropping deliveries.
+func (c *WebhooksClient) RotateSecret(ctx context.Context, id string) (*RotateWebhookSecretResponse, error) {
+	var resp RotateWebhookSecretResponse
+	if err := c.http.Post(ctx, "/mail/webhooks/"+id+"/rotate-secret", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListDeliveries lists recent delivery attempts for a webhook endpoint.
+func (c *WebhooksClient) ListDeliveries(ctx context.Context, req *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	if req.Status != nil {
+		params.Set("status", string(*req.Status))
+	}
+	if req.EventType != nil {
+		params.Set("eventType", string(*req.EventType))
+	}
+	if req.Limit != nil {
+		params.Set("limit", strconv.Itoa(*req.Limit))
+	}
+	if req.Offset != nil {
+		params.Set("offset", strconv.Itoa(*req.Offset))
+	}
+
+	path := "/mail/webhooks/" + req.WebhookID + "/deliveries"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp ListWebhookDeliveriesResponse
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RetryDelivery re-sends a previous delivery attempt.
+func (c *WebhooksClient) RetryDelivery(ctx context.Context, webhookID, deliveryID string) (*WebhookDelivery, error) {
+	var resp WebhookDelivery
+	if err := c.http.Post(ctx, "/mail/webhooks/"+webhookID+"/deliveries/"+deliveryID+"/retry", map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
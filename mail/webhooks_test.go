@@ -0,0 +1,177 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWebhooksTestClient(t *testing.T, handler http.HandlerFunc) (*WebhooksClient, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewWebhooksClient(httpClient), server
+}
+
+func TestWebhooksClient_Create(t *testing.T) {
+	webhooksClient, server := setupWebhooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/webhooks", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/hooks", body["url"])
+		assert.Equal(t, []interface{}{"email.bounced", "email.complained"}, body["events"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(WebhookEndpoint{
+			ID:      "wh-123",
+			URL:     "https://example.com/hooks",
+			Events:  []WebhookEventType{WebhookEventEmailBounced, WebhookEventEmailComplained},
+			Enabled: true,
+			Secret:  ptr("whsec_abc"),
+		})
+	})
+	defer server.Close()
+
+	resp, err := webhooksClient.Create(context.Background(), &CreateWebhookRequest{
+		URL:    "https://example.com/hooks",
+		Events: []WebhookEventType{WebhookEventEmailBounced, WebhookEventEmailComplained},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "wh-123", resp.ID)
+	assert.Equal(t, "whsec_abc", *resp.Secret)
+}
+
+func TestWebhooksClient_List(t *testing.T) {
+	webhooksClient, server := setupWebhooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/webhooks", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListWebhooksResponse{
+			Webhooks: []WebhookEndpoint{{ID: "wh-1"}, {ID: "wh-2"}},
+			Total:    2,
+		})
+	})
+	defer server.Close()
+
+	resp, err := webhooksClient.List(context.Background(), &ListWebhooksRequest{Limit: ptr(10)})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Webhooks, 2)
+	assert.Equal(t, 2, resp.Total)
+}
+
+func TestWebhooksClient_Update(t *testing.T) {
+	webhooksClient, server := setupWebhooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/mail/webhooks/wh-123", r.URL.Path)
+
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, false, body["enabled"])
+		assert.NotContains(t, body, "ID")
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(WebhookEndpoint{ID: "wh-123", Enabled: false})
+	})
+	defer server.Close()
+
+	resp, err := webhooksClient.Update(context.Background(), &UpdateWebhookRequest{
+		ID:      "wh-123",
+		Enabled: ptr(false),
+	})
+
+	require.NoError(t, err)
+	assert.False(t, resp.Enabled)
+}
+
+func TestWebhooksClient_Delete(t *testing.T) {
+	webhooksClient, server := setupWebhooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/mail/webhooks/wh-123", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(DeleteWebhookResponse{Success: true})
+	})
+	defer server.Close()
+
+	resp, err := webhooksClient.Delete(context.Background(), "wh-123")
+
+	require.NoError(t, err)
+	assert.True(t, resp.Success)
+}
+
+func TestWebhooksClient_RotateSecret(t *testing.T) {
+	webhooksClient, server := setupWebhooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/webhooks/wh-123/rotate-secret", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(RotateWebhookSecretResponse{Secret: "whsec_new"})
+	})
+	defer server.Close()
+
+	resp, err := webhooksClient.RotateSecret(context.Background(), "wh-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "whsec_new", resp.Secret)
+}
+
+func TestWebhooksClient_ListDeliveries(t *testing.T) {
+	webhooksClient, server := setupWebhooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/mail/webhooks/wh-123/deliveries", r.URL.Path)
+		assert.Equal(t, "failed", r.URL.Query().Get("status"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListWebhookDeliveriesResponse{
+			Deliveries: []WebhookDelivery{{
+				ID:             "del-1",
+				WebhookID:      "wh-123",
+				EventType:      WebhookEventEmailDelivered,
+				Status:         WebhookDeliveryStatusFailed,
+				Attempt:        3,
+				ResponseStatus: ptr(500),
+			}},
+			Total: 1,
+		})
+	})
+	defer server.Close()
+
+	status := WebhookDeliveryStatusFailed
+	resp, err := webhooksClient.ListDeliveries(context.Background(), &ListWebhookDeliveriesRequest{
+		WebhookID: "wh-123",
+		Status:    &status,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Deliveries, 1)
+	assert.Equal(t, 500, *resp.Deliveries[0].ResponseStatus)
+}
+
+func TestWebhooksClient_RetryDelivery(t *testing.T) {
+	webhooksClient, server := setupWebhooksTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/mail/webhooks/wh-123/deliveries/del-1/retry", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(WebhookDelivery{ID: "del-2", Status: WebhookDeliveryStatusPending, Attempt: 4})
+	})
+	defer server.Close()
+
+	resp, err := webhooksClient.RetryDelivery(context.Background(), "wh-123", "del-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, WebhookDeliveryStatusPending, resp.Status)
+}
@@ -0,0 +1,95 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stack0/sdk-go/client"
+)
+
+// Client reads daily quotas and paces large submissions to stay within them.
+type Client struct {
+	http *client.HTTPClient
+}
+
+// NewClient creates a new quota client.
+func NewClient(http *client.HTTPClient) *Client {
+	return &Client{http: http}
+}
+
+// Get retrieves the current daily quota for each metered resource.
+func (c *Client) Get(ctx context.Context, req *GetQuotaRequest) (*Quota, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.ProjectID != nil {
+			params.Set("projectId", *req.ProjectID)
+		}
+	}
+
+	path := "/quota"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var resp Quota
+	if err := c.http.Get(ctx, path, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Preview fetches the current quota, as Get does for req, and returns the
+// plan Run would follow for total items, without submitting anything.
+func (c *Client) Preview(ctx context.Context, req *GetQuotaRequest, resource Resource, total int, opts *PlanOptions) (*Plan, error) {
+	q, err := c.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	rq := q.For(resource)
+	if rq == nil {
+		return nil, fmt.Errorf("no quota reported for %s", resource)
+	}
+	return NewPlan(total, *rq, time.Now(), opts)
+}
+
+// Run submits items in batches that fit the daily quota for resource, as Get
+// reports it for req, waiting for the quota to reset when today's allowance
+// is used up. submit is
+// called once per batch, in order; Run stops at the first error. It returns
+// the plan that was followed.
+//
+// For example, to split a large screenshot batch:
+//
+//	plan, err := quota.Run(ctx, client.Quota, nil, quota.ResourceScreenshots, urls, nil,
+//		func(ctx context.Context, batch []string) error {
+//			_, err := client.Screenshots.Batch(ctx, &screenshots.CreateBatchScreenshotsRequest{URLs: batch})
+//			return err
+//		})
+func Run[T any](ctx context.Context, c *Client, req *GetQuotaRequest, resource Resource, items []T, opts *PlanOptions, submit func(ctx context.Context, batch []T) error) (*Plan, error) {
+	plan, err := c.Preview(ctx, req, resource, len(items), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range plan.Batches {
+		if wait := time.Until(b.NotBefore); wait > 0 {
+			select {
+			case <-ctx.Done():
+				return plan, ctx.Err()
+			case <-time.After(wait):
+			}
+		}
+		if err := submit(ctx, items[b.Offset:b.Offset+b.Size]); err != nil {
+			return plan, err
+		}
+	}
+	return plan, nil
+}
@@ -0,0 +1,157 @@
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupQuotaTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	httpClient := client.New("test-api-key", server.URL)
+	return NewClient(httpClient), server
+}
+
+func TestClient_Get(t *testing.T) {
+	quotaClient, server := setupQuotaTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/quota", r.URL.Path)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Quota{
+			Resources: []ResourceQuota{
+				{Resource: ResourceScreenshots, DailyLimit: 1000, UsedToday: 400, Remaining: 600},
+				{Resource: ResourceEmails, DailyLimit: 50000, Remaining: 50000},
+			},
+		})
+	})
+	defer server.Close()
+
+	resp, err := quotaClient.Get(context.Background(), nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.For(ResourceScreenshots))
+	assert.Equal(t, 600, resp.For(ResourceScreenshots).Remaining)
+	assert.Nil(t, resp.For(ResourceExtractions))
+}
+
+func TestNewPlan(t *testing.T) {
+	now := time.Date(2024, 3, 1, 15, 0, 0, 0, time.UTC)
+	resetsAt := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("fits today", func(t *testing.T) {
+		plan, err := NewPlan(250, ResourceQuota{DailyLimit: 1000, Remaining: 600, ResetsAt: resetsAt}, now, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, plan.Days)
+		require.Len(t, plan.Batches, 3)
+		assert.Equal(t, PlannedBatch{Offset: 200, Size: 50, NotBefore: now}, plan.Batches[2])
+	})
+
+	t.Run("spills over resets", func(t *testing.T) {
+		plan, err := NewPlan(2500, ResourceQuota{DailyLimit: 1000, Remaining: 300, ResetsAt: resetsAt}, now, &PlanOptions{MaxBatchSize: 1000})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, plan.Days)
+		assert.Equal(t, []PlannedBatch{
+			{Offset: 0, Size: 300, NotBefore: now},
+			{Offset: 300, Size: 1000, NotBefore: resetsAt},
+			{Offset: 1300, Size: 1000, NotBefore: resetsAt.Add(24 * time.Hour)},
+			{Offset: 2300, Size: 200, NotBefore: resetsAt.Add(48 * time.Hour)},
+		}, plan.Batches)
+	})
+
+	t.Run("reserve", func(t *testing.T) {
+		plan, err := NewPlan(100, ResourceQuota{DailyLimit: 100, Remaining: 60, ResetsAt: resetsAt}, now, &PlanOptions{Reserve: 50})
+
+		require.NoError(t, err)
+		assert.Equal(t, []PlannedBatch{
+			{Offset: 0, Size: 10, NotBefore: now},
+			{Offset: 10, Size: 50, NotBefore: resetsAt},
+			{Offset: 60, Size: 40, NotBefore: resetsAt.Add(24 * time.Hour)},
+		}, plan.Batches)
+	})
+
+	t.Run("reserve exceeds limit", func(t *testing.T) {
+		_, err := NewPlan(10, ResourceQuota{Resource: ResourceEmails, DailyLimit: 100}, now, &PlanOptions{Reserve: 100})
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_Preview(t *testing.T) {
+	quotaClient, server := setupQuotaTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/quota", r.URL.Path)
+		assert.Equal(t, "sandbox", r.URL.Query().Get("environment"))
+		assert.Equal(t, "proj-1", r.URL.Query().Get("projectId"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Quota{
+			Resources: []ResourceQuota{
+				{Resource: ResourceScreenshots, DailyLimit: 100, Remaining: 100, ResetsAt: time.Now().Add(time.Hour)},
+			},
+		})
+	})
+	defer server.Close()
+
+	env := types.EnvironmentSandbox
+	projectID := "proj-1"
+	plan, err := quotaClient.Preview(context.Background(), &GetQuotaRequest{Environment: &env, ProjectID: &projectID}, ResourceScreenshots, 10, nil)
+
+	require.NoError(t, err)
+	assert.Len(t, plan.Batches, 1)
+}
+
+func TestRun(t *testing.T) {
+	quotaClient, server := setupQuotaTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Quota{
+			Resources: []ResourceQuota{
+				{Resource: ResourceExtractions, DailyLimit: 1000, Remaining: 1000, ResetsAt: time.Now().Add(time.Hour)},
+			},
+		})
+	})
+	defer server.Close()
+
+	items := []string{"a", "b", "c", "d", "e"}
+
+	t.Run("submits in batches", func(t *testing.T) {
+		var batches [][]string
+		plan, err := Run(context.Background(), quotaClient, nil, ResourceExtractions, items, &PlanOptions{MaxBatchSize: 2},
+			func(ctx context.Context, batch []string) error {
+				batches = append(batches, batch)
+				return nil
+			})
+
+		require.NoError(t, err)
+		assert.Len(t, plan.Batches, 3)
+		assert.Equal(t, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}, batches)
+	})
+
+	t.Run("stops on error", func(t *testing.T) {
+		calls := 0
+		_, err := Run(context.Background(), quotaClient, nil, ResourceExtractions, items, &PlanOptions{MaxBatchSize: 2},
+			func(ctx context.Context, batch []string) error {
+				calls++
+				return errors.New("rejected")
+			})
+
+		assert.EqualError(t, err, "rejected")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("unknown resource", func(t *testing.T) {
+		_, err := Run(context.Background(), quotaClient, nil, ResourceEmails, items, nil,
+			func(ctx context.Context, batch []string) error { return nil })
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,50 @@
+package quota
+
+import (
+	"fmt"
+	"time"
+)
+
+const defaultMaxBatchSize = 100
+
+// NewPlan splits total items into batches that fit the remaining quota. Items
+// that don't fit today are scheduled at q.ResetsAt and each following day,
+// up to q.DailyLimit per day.
+func NewPlan(total int, q ResourceQuota, now time.Time, opts *PlanOptions) (*Plan, error) {
+	maxBatch := defaultMaxBatchSize
+	reserve := 0
+	if opts != nil {
+		if opts.MaxBatchSize > 0 {
+			maxBatch = opts.MaxBatchSize
+		}
+		reserve = opts.Reserve
+	}
+
+	perDay := q.DailyLimit - reserve
+	if total > 0 && perDay <= 0 {
+		return nil, fmt.Errorf("%s daily limit %d leaves no room after reserve %d", q.Resource, q.DailyLimit, reserve)
+	}
+
+	plan := &Plan{Resource: q.Resource, Total: total}
+	available := q.Remaining - reserve
+	start := now
+	resetsAt := q.ResetsAt
+	offset := 0
+	for offset < total {
+		if available <= 0 {
+			start = resetsAt
+			resetsAt = resetsAt.Add(24 * time.Hour)
+			available = perDay
+			continue
+		}
+		if len(plan.Batches) == 0 || plan.Batches[len(plan.Batches)-1].NotBefore != start {
+			plan.Days++
+		}
+
+		size := min(maxBatch, available, total-offset)
+		plan.Batches = append(plan.Batches, PlannedBatch{Offset: offset, Size: size, NotBefore: start})
+		offset += size
+		available -= size
+	}
+	return plan, nil
+}
@@ -0,0 +1,72 @@
+package quota
+
+import (
+	"time"
+
+	"github.com/stack0/sdk-go/types"
+)
+
+// Resource identifies a metered resource with a daily quota.
+type Resource string
+
+const (
+	ResourceScreenshots Resource = "screenshots"
+	ResourceExtractions Resource = "extractions"
+	ResourceEmails      Resource = "emails"
+)
+
+// ResourceQuota is the daily quota for a single resource.
+type ResourceQuota struct {
+	Resource   Resource  `json:"resource"`
+	DailyLimit int       `json:"dailyLimit"`
+	UsedToday  int       `json:"usedToday"`
+	Remaining  int       `json:"remaining"`
+	ResetsAt   time.Time `json:"resetsAt"`
+}
+
+// Quota is the current quota for every metered resource.
+type Quota struct {
+	Resources []ResourceQuota `json:"resources"`
+}
+
+// For returns the quota for r, or nil if r is not metered.
+func (q *Quota) For(r Resource) *ResourceQuota {
+	for i := range q.Resources {
+		if q.Resources[i].Resource == r {
+			return &q.Resources[i]
+		}
+	}
+	return nil
+}
+
+// GetQuotaRequest is the request for getting quota.
+type GetQuotaRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+}
+
+// PlanOptions configure how work is split into batches.
+type PlanOptions struct {
+	// MaxBatchSize caps the number of items per submission. Defaults to 100.
+	MaxBatchSize int
+	// Reserve is the part of each day's quota left untouched for other
+	// traffic.
+	Reserve int
+}
+
+// PlannedBatch is one submission in a Plan. Items [Offset, Offset+Size)
+// are submitted no earlier than NotBefore.
+type PlannedBatch struct {
+	Offset    int       `json:"offset"`
+	Size      int       `json:"size"`
+	NotBefore time.Time `json:"notBefore"`
+}
+
+// Plan is the pacing for submitting Total items within the daily quota.
+type Plan struct {
+	Resource Resource       `json:"resource"`
+	Total    int            `json:"total"`
+	Batches  []PlannedBatch `json:"batches"`
+	// Days is the number of quota days the plan spans, including today.
+	Days int `json:"days"`
+}
@@ -0,0 +1,161 @@
+package stack0
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"mime"
+	"net/http"
+
+	"github.com/stack0/sdk-go/cdn"
+	"github.com/stack0/sdk-go/mail"
+	"github.com/stack0/sdk-go/screenshots"
+)
+
+// DefaultScreenshotContentID is the content ID used for the inline image when
+// ScreenshotEmailRequest.ContentID is empty.
+const DefaultScreenshotContentID = "screenshot"
+
+// ScreenshotEmailRequest is the request for SendScreenshotEmail.
+//
+// Email is sent as given, with the screenshot added as an inline attachment.
+// Its HTML should reference the image as <img src="cid:ContentID">; if HTML,
+// TemplateID and TemplateSlug are all unset, a body containing only the image
+// is used.
+//
+// Screenshot and Email are validated along with the request, and Email again
+// when it is sent, once the screenshot is attached.
+type ScreenshotEmailRequest struct {
+	Screenshot  *screenshots.CreateScreenshotRequest `validate:"required,dive"`
+	Wait        *screenshots.CaptureAndWaitOptions
+	ProjectSlug string `validate:"required"`
+	Folder      *string
+	Filename    string
+	ContentID   string
+	Email       *mail.SendEmailRequest `validate:"required,dive"`
+}
+
+// ScreenshotEmailResult holds the resources created by SendScreenshotEmail.
+type ScreenshotEmailResult struct {
+	Screenshot *screenshots.Screenshot
+	Asset      *cdn.Asset
+	Email      *mail.SendEmailResponse
+}
+
+// SendScreenshotEmail captures a screenshot, stores it as a CDN asset in
+// ProjectSlug, and sends it inline in an email. If any step fails, the
+// screenshot and asset created so far are deleted before the error is
+// returned; cleanup runs even when ctx has been cancelled.
+func (c *Client) SendScreenshotEmail(ctx context.Context, req *ScreenshotEmailRequest) (result *ScreenshotEmailResult, err error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, errors.New("SendScreenshotEmail requires a request")
+	}
+
+	result = &ScreenshotEmailResult{}
+	defer func() {
+		if err != nil {
+			if rbErr := c.rollbackScreenshotEmail(context.WithoutCancel(ctx), req, result); rbErr != nil {
+				err = errors.Join(err, rbErr)
+			}
+			result = nil
+		}
+	}()
+
+	shot, err := c.Screenshots.CaptureAndWait(ctx, req.Screenshot, req.Wait)
+	if err != nil {
+		return result, fmt.Errorf("capture screenshot: %w", err)
+	}
+	result.Screenshot = shot
+	if shot.ImageURL == nil {
+		return result, errors.New("capture screenshot: completed without an image URL")
+	}
+
+	var image bytes.Buffer
+	if _, err := c.http.Download(ctx, *shot.ImageURL, &image); err != nil {
+		return result, fmt.Errorf("download screenshot: %w", err)
+	}
+	contentType := screenshotContentType(shot.Format, image.Bytes())
+
+	filename := req.Filename
+	if filename == "" {
+		filename = "screenshot-" + shot.ID + "." + string(shot.Format)
+	}
+	upload, err := c.CDN.GetUploadURL(ctx, &cdn.UploadURLRequest{
+		ProjectSlug: req.ProjectSlug,
+		Filename:    filename,
+		MimeType:    contentType,
+		Size:        int64(image.Len()),
+		Folder:      req.Folder,
+	})
+	if err != nil {
+		return result, fmt.Errorf("store screenshot: %w", err)
+	}
+	// The asset exists once an upload URL is issued, so track it for
+	// rollback before uploading.
+	result.Asset = &cdn.Asset{ID: upload.AssetID}
+	if err := c.http.Upload(ctx, upload.UploadURL, contentType, upload.Headers, &image); err != nil {
+		return result, fmt.Errorf("store screenshot: %w", err)
+	}
+	asset, err := c.CDN.ConfirmUpload(ctx, upload.AssetID)
+	if err != nil {
+		return result, fmt.Errorf("store screenshot: %w", err)
+	}
+	result.Asset = asset
+
+	contentID := req.ContentID
+	if contentID == "" {
+		contentID = DefaultScreenshotContentID
+	}
+	email := *req.Email
+	email.Attachments = append(append([]mail.Attachment(nil), req.Email.Attachments...), mail.Attachment{
+		Filename:    filename,
+		ContentType: contentType,
+		AssetID:     asset.ID,
+		ContentID:   contentID,
+	})
+	if email.HTML == nil && email.TemplateID == nil && email.TemplateSlug == nil {
+		body := `<img src="cid:` + contentID + `" alt="Screenshot of ` + html.EscapeString(shot.URL) + `">`
+		email.HTML = &body
+	}
+
+	sent, err := c.Mail.Send(ctx, &email)
+	if err != nil {
+		return result, fmt.Errorf("send email: %w", err)
+	}
+	result.Email = sent
+	return result, nil
+}
+
+func (c *Client) rollbackScreenshotEmail(ctx context.Context, req *ScreenshotEmailRequest, result *ScreenshotEmailResult) error {
+	var errs []error
+	if result.Asset != nil {
+		if _, err := c.CDN.Delete(ctx, result.Asset.ID); err != nil {
+			errs = append(errs, fmt.Errorf("rollback asset %s: %w", result.Asset.ID, err))
+		}
+	}
+	if result.Screenshot != nil {
+		_, err := c.Screenshots.Delete(ctx, &screenshots.GetScreenshotRequest{
+			ID:          result.Screenshot.ID,
+			Environment: req.Screenshot.Environment,
+			ProjectID:   req.Screenshot.ProjectID,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rollback screenshot %s: %w", result.Screenshot.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// screenshotContentType returns the MIME type of a screenshot image in the
+// given format, sniffing it from the image if the format is unknown.
+func screenshotContentType(format screenshots.ScreenshotFormat, image []byte) string {
+	if contentType := mime.TypeByExtension("." + string(format)); contentType != "" {
+		return contentType
+	}
+	return http.DetectContentType(image)
+}
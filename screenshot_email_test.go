@@ -0,0 +1,190 @@
+package stack0
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stack0/sdk-go/cdn"
+	"github.com/stack0/sdk-go/mail"
+	"github.com/stack0/sdk-go/screenshots"
+	"github.com/stack0/sdk-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type screenshotEmailServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	calls    []string
+	uploaded []byte
+	sent     mail.SendEmailRequest
+	failSend bool
+}
+
+func newScreenshotEmailServer(t *testing.T) *screenshotEmailServer {
+	s := &screenshotEmailServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.calls = append(s.calls, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /webdata/screenshots":
+			json.NewEncoder(w).Encode(screenshots.CreateScreenshotResponse{ID: "ss-1", Status: screenshots.ScreenshotStatusPending})
+		case "GET /webdata/screenshots/ss-1":
+			imageURL := s.URL + "/images/ss-1.png"
+			json.NewEncoder(w).Encode(screenshots.Screenshot{
+				ID:       "ss-1",
+				URL:      "https://example.com/dashboard?range=7d&team=ops",
+				Format:   screenshots.ScreenshotFormatPNG,
+				Status:   screenshots.ScreenshotStatusCompleted,
+				ImageURL: &imageURL,
+			})
+		case "GET /images/ss-1.png":
+			assert.Empty(t, r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "image/png")
+			w.Write([]byte("png-bytes"))
+		case "POST /cdn/upload":
+			json.NewEncoder(w).Encode(cdn.UploadURLResponse{
+				AssetID:   "asset-1",
+				UploadURL: s.URL + "/upload/asset-1",
+				Headers:   map[string]string{"X-Amz-Server-Side-Encryption": "AES256"},
+			})
+		case "PUT /upload/asset-1":
+			assert.Empty(t, r.Header.Get("Authorization"))
+			assert.Equal(t, "image/png", r.Header.Get("Content-Type"))
+			assert.Equal(t, "AES256", r.Header.Get("X-Amz-Server-Side-Encryption"))
+			s.uploaded, _ = io.ReadAll(r.Body)
+		case "POST /cdn/upload/asset-1/confirm":
+			json.NewEncoder(w).Encode(cdn.Asset{ID: "asset-1", CDNURL: "https://cdn.example.com/asset-1.png"})
+		case "POST /mail/send":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&s.sent))
+			if s.failSend {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"code": "INVALID_REQUEST", "message": "invalid recipient"})
+				return
+			}
+			json.NewEncoder(w).Encode(mail.SendEmailResponse{ID: "email-1", Status: "pending"})
+		case "DELETE /cdn/assets/asset-1", "DELETE /webdata/screenshots/ss-1":
+			json.NewEncoder(w).Encode(map[string]bool{"success": true})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+func TestClient_SendScreenshotEmail(t *testing.T) {
+	server := newScreenshotEmailServer(t)
+	defer server.Close()
+	client := New("test-api-key", WithBaseURL(server.URL))
+
+	result, err := client.SendScreenshotEmail(context.Background(), &ScreenshotEmailRequest{
+		Screenshot:  &screenshots.CreateScreenshotRequest{URL: "https://example.com/dashboard"},
+		ProjectSlug: "reports",
+		Email: &mail.SendEmailRequest{
+			From:    "reports@example.com",
+			To:      "team@example.com",
+			Subject: "Daily dashboard",
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "ss-1", result.Screenshot.ID)
+	assert.Equal(t, "asset-1", result.Asset.ID)
+	assert.Equal(t, "email-1", result.Email.ID)
+	assert.Equal(t, []byte("png-bytes"), server.uploaded)
+
+	require.Len(t, server.sent.Attachments, 1)
+	attachment := server.sent.Attachments[0]
+	assert.Equal(t, "asset-1", attachment.AssetID)
+	assert.Equal(t, DefaultScreenshotContentID, attachment.ContentID)
+	assert.Equal(t, "screenshot-ss-1.png", attachment.Filename)
+	require.NotNil(t, server.sent.HTML)
+	assert.Contains(t, *server.sent.HTML, `src="cid:screenshot"`)
+	assert.Contains(t, *server.sent.HTML, `alt="Screenshot of https://example.com/dashboard?range=7d&amp;team=ops"`)
+	assert.NotContains(t, server.calls, "DELETE /cdn/assets/asset-1")
+}
+
+func TestClient_SendScreenshotEmail_TemplateSlug(t *testing.T) {
+	server := newScreenshotEmailServer(t)
+	defer server.Close()
+	client := New("test-api-key", WithBaseURL(server.URL))
+
+	slug := "daily-dashboard"
+	result, err := client.SendScreenshotEmail(context.Background(), &ScreenshotEmailRequest{
+		Screenshot:  &screenshots.CreateScreenshotRequest{URL: "https://example.com/dashboard"},
+		ProjectSlug: "reports",
+		Email: &mail.SendEmailRequest{
+			From:         "reports@example.com",
+			To:           "team@example.com",
+			TemplateSlug: &slug,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "email-1", result.Email.ID)
+	assert.Nil(t, server.sent.HTML)
+	require.NotNil(t, server.sent.TemplateSlug)
+	assert.Equal(t, slug, *server.sent.TemplateSlug)
+	require.Len(t, server.sent.Attachments, 1)
+}
+
+func TestClient_SendScreenshotEmail_Validation(t *testing.T) {
+	server := newScreenshotEmailServer(t)
+	defer server.Close()
+	client := New("test-api-key", WithBaseURL(server.URL))
+
+	_, err := client.SendScreenshotEmail(context.Background(), &ScreenshotEmailRequest{
+		Screenshot: &screenshots.CreateScreenshotRequest{URL: "https://example.com/dashboard"},
+		Email:      &mail.SendEmailRequest{From: "reports@example.com", To: "team@example.com"},
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidRequest)
+	assert.ErrorContains(t, err, "ProjectSlug")
+
+	_, err = client.SendScreenshotEmail(context.Background(), &ScreenshotEmailRequest{
+		Screenshot:  &screenshots.CreateScreenshotRequest{URL: "https://example.com/dashboard"},
+		ProjectSlug: "reports",
+		Email:       &mail.SendEmailRequest{To: "team@example.com"},
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	_, err = client.SendScreenshotEmail(context.Background(), nil)
+	assert.Error(t, err)
+	assert.Empty(t, server.calls)
+}
+
+func TestClient_SendScreenshotEmail_RollsBackOnSendFailure(t *testing.T) {
+	server := newScreenshotEmailServer(t)
+	server.failSend = true
+	defer server.Close()
+	client := New("test-api-key", WithBaseURL(server.URL))
+
+	html := `<p>Today:</p><img src="cid:dash">`
+	req := &mail.SendEmailRequest{
+		From:    "reports@example.com",
+		To:      "not-an-address",
+		Subject: "Daily dashboard",
+		HTML:    &html,
+	}
+	result, err := client.SendScreenshotEmail(context.Background(), &ScreenshotEmailRequest{
+		Screenshot:  &screenshots.CreateScreenshotRequest{URL: "https://example.com/dashboard"},
+		ProjectSlug: "reports",
+		ContentID:   "dash",
+		Email:       req,
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "send email")
+	assert.Nil(t, result)
+	assert.Contains(t, server.calls, "DELETE /cdn/assets/asset-1")
+	assert.Contains(t, server.calls, "DELETE /webdata/screenshots/ss-1")
+	assert.Equal(t, html, *server.sent.HTML)
+	assert.Empty(t, req.Attachments)
+}
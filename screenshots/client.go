@@ -2,9 +2,11 @@ package screenshots
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
-	"net/url"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/stack0/sdk-go/client"
@@ -23,6 +25,10 @@ func NewClient(http *client.HTTPClient) *Client {
 
 // Capture captures a screenshot of a URL.
 func (c *Client) Capture(ctx context.Context, req *CreateScreenshotRequest) (*CreateScreenshotResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp CreateScreenshotResponse
 	if err := c.http.Post(ctx, "/webdata/screenshots", req, &resp); err != nil {
 		return nil, err
@@ -32,7 +38,11 @@ func (c *Client) Capture(ctx context.Context, req *CreateScreenshotRequest) (*Cr
 
 // Get retrieves a screenshot by ID.
 func (c *Client) Get(ctx context.Context, req *GetScreenshotRequest) (*Screenshot, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -54,7 +64,11 @@ func (c *Client) Get(ctx context.Context, req *GetScreenshotRequest) (*Screensho
 
 // List lists screenshots with pagination and filters.
 func (c *Client) List(ctx context.Context, req *ListScreenshotsRequest) (*ListScreenshotsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -68,6 +82,10 @@ func (c *Client) List(ctx context.Context, req *ListScreenshotsRequest) (*ListSc
 		if req.URL != nil {
 			params.Set("url", *req.URL)
 		}
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
+		client.SetMetadataFilter(params, req.Metadata)
 		if req.Limit != nil {
 			params.Set("limit", strconv.Itoa(*req.Limit))
 		}
@@ -88,9 +106,33 @@ func (c *Client) List(ctx context.Context, req *ListScreenshotsRequest) (*ListSc
 	return &resp, nil
 }
 
+// GetMetadataIndex returns the screenshot metadata keys that List can filter
+// on.
+func (c *Client) GetMetadataIndex(ctx context.Context) (*types.MetadataIndex, error) {
+	var resp types.MetadataIndex
+	if err := c.http.Get(ctx, "/webdata/screenshots/metadata-index", &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetMetadataIndex replaces the screenshot metadata keys that List can filter
+// on, up to the index's MaxKeys.
+func (c *Client) SetMetadataIndex(ctx context.Context, keys []string) (*types.MetadataIndex, error) {
+	var resp types.MetadataIndex
+	if err := c.http.Put(ctx, "/webdata/screenshots/metadata-index", map[string][]string{"keys": keys}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // Delete deletes a screenshot.
 func (c *Client) Delete(ctx context.Context, req *GetScreenshotRequest) (*SuccessResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -104,8 +146,8 @@ func (c *Client) Delete(ctx context.Context, req *GetScreenshotRequest) (*Succes
 	}
 
 	body := map[string]interface{}{"id": req.ID}
-	if req.Environment != nil {
-		body["environment"] = *req.Environment
+	if env := c.http.EnvironmentOrDefault(req.Environment); env != "" {
+		body["environment"] = env
 	}
 	if req.ProjectID != nil {
 		body["projectId"] = *req.ProjectID
@@ -118,16 +160,20 @@ func (c *Client) Delete(ctx context.Context, req *GetScreenshotRequest) (*Succes
 	return &resp, nil
 }
 
-// CaptureAndWaitOptions are options for CaptureAndWait.
+// CaptureAndWaitOptions are options for CaptureAndWait. Rate-limit and server
+// errors while polling are retried up to MaxTransientErrors times in a row;
+// see client.PollOptions.
 type CaptureAndWaitOptions struct {
-	PollInterval time.Duration
-	Timeout      time.Duration
+	PollInterval       time.Duration
+	Timeout            time.Duration
+	MaxTransientErrors int
 }
 
 // CaptureAndWait captures a screenshot and waits for completion.
 func (c *Client) CaptureAndWait(ctx context.Context, req *CreateScreenshotRequest, opts *CaptureAndWaitOptions) (*Screenshot, error) {
 	pollInterval := 1 * time.Second
 	timeout := 60 * time.Second
+	maxTransient := 0
 	if opts != nil {
 		if opts.PollInterval > 0 {
 			pollInterval = opts.PollInterval
@@ -135,6 +181,7 @@ func (c *Client) CaptureAndWait(ctx context.Context, req *CreateScreenshotReques
 		if opts.Timeout > 0 {
 			timeout = opts.Timeout
 		}
+		maxTransient = opts.MaxTransientErrors
 	}
 
 	resp, err := c.Capture(ctx, req)
@@ -142,40 +189,38 @@ func (c *Client) CaptureAndWait(ctx context.Context, req *CreateScreenshotReques
 		return nil, err
 	}
 
-	startTime := time.Now()
-	for time.Since(startTime) < timeout {
+	return client.Poll(ctx, client.PollOptions{
+		Interval:           pollInterval,
+		Timeout:            timeout,
+		MaxTransientErrors: maxTransient,
+		TimeoutMessage:     "Screenshot timed out",
+	}, func(ctx context.Context) (*Screenshot, bool, error) {
 		screenshot, err := c.Get(ctx, &GetScreenshotRequest{
 			ID:          resp.ID,
 			Environment: req.Environment,
 			ProjectID:   req.ProjectID,
 		})
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
-		if screenshot.Status == ScreenshotStatusCompleted || screenshot.Status == ScreenshotStatusFailed {
-			if screenshot.Status == ScreenshotStatusFailed {
-				errMsg := "Screenshot failed"
-				if screenshot.Error != nil {
-					errMsg = *screenshot.Error
-				}
-				return nil, errors.New(errMsg)
+		if screenshot.Status == ScreenshotStatusFailed {
+			errMsg := "Screenshot failed"
+			if screenshot.Error != nil {
+				errMsg = *screenshot.Error
 			}
-			return screenshot, nil
+			return nil, false, errors.New(errMsg)
 		}
-
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(pollInterval):
-		}
-	}
-
-	return nil, types.NewTimeoutError("Screenshot timed out")
+		return screenshot, screenshot.Status == ScreenshotStatusCompleted, nil
+	})
 }
 
 // Batch creates a batch screenshot job for multiple URLs.
 func (c *Client) Batch(ctx context.Context, req *CreateBatchScreenshotsRequest) (*CreateBatchResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	var resp CreateBatchResponse
 	if err := c.http.Post(ctx, "/webdata/batch/screenshots", req, &resp); err != nil {
 		return nil, err
@@ -185,7 +230,11 @@ func (c *Client) Batch(ctx context.Context, req *CreateBatchScreenshotsRequest)
 
 // GetBatchJob retrieves a batch job by ID.
 func (c *Client) GetBatchJob(ctx context.Context, req *GetBatchJobRequest) (*BatchScreenshotJob, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -207,7 +256,12 @@ func (c *Client) GetBatchJob(ctx context.Context, req *GetBatchJobRequest) (*Bat
 
 // ListBatchJobs lists batch jobs with pagination and filters.
 func (c *Client) ListBatchJobs(ctx context.Context, req *ListBatchJobsRequest) (*BatchJobsResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("type", "screenshot")
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -218,7 +272,9 @@ func (c *Client) ListBatchJobs(ctx context.Context, req *ListBatchJobsRequest) (
 		if req.Status != nil {
 			params.Set("status", string(*req.Status))
 		}
-		params.Set("type", "screenshot")
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
 		if req.Limit != nil {
 			params.Set("limit", strconv.Itoa(*req.Limit))
 		}
@@ -241,7 +297,11 @@ func (c *Client) ListBatchJobs(ctx context.Context, req *ListBatchJobsRequest) (
 
 // CancelBatchJob cancels a batch job.
 func (c *Client) CancelBatchJob(ctx context.Context, req *GetBatchJobRequest) (*SuccessResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -265,6 +325,7 @@ func (c *Client) CancelBatchJob(ctx context.Context, req *GetBatchJobRequest) (*
 func (c *Client) BatchAndWait(ctx context.Context, req *CreateBatchScreenshotsRequest, opts *CaptureAndWaitOptions) (*BatchScreenshotJob, error) {
 	pollInterval := 2 * time.Second
 	timeout := 300 * time.Second
+	maxTransient := 0
 	if opts != nil {
 		if opts.PollInterval > 0 {
 			pollInterval = opts.PollInterval
@@ -272,6 +333,7 @@ func (c *Client) BatchAndWait(ctx context.Context, req *CreateBatchScreenshotsRe
 		if opts.Timeout > 0 {
 			timeout = opts.Timeout
 		}
+		maxTransient = opts.MaxTransientErrors
 	}
 
 	resp, err := c.Batch(ctx, req)
@@ -279,40 +341,38 @@ func (c *Client) BatchAndWait(ctx context.Context, req *CreateBatchScreenshotsRe
 		return nil, err
 	}
 
-	startTime := time.Now()
-	for time.Since(startTime) < timeout {
+	return client.Poll(ctx, client.PollOptions{
+		Interval:           pollInterval,
+		Timeout:            timeout,
+		MaxTransientErrors: maxTransient,
+		TimeoutMessage:     "Batch job timed out",
+	}, func(ctx context.Context) (*BatchScreenshotJob, bool, error) {
 		job, err := c.GetBatchJob(ctx, &GetBatchJobRequest{
 			ID:          resp.ID,
 			Environment: req.Environment,
 			ProjectID:   req.ProjectID,
 		})
 		if err != nil {
-			return nil, err
-		}
-
-		if job.Status == types.BatchJobStatusCompleted || job.Status == types.BatchJobStatusFailed || job.Status == types.BatchJobStatusCancelled {
-			return job, nil
-		}
-
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case <-time.After(pollInterval):
+			return nil, false, err
 		}
-	}
-
-	return nil, types.NewTimeoutError("Batch job timed out")
+		done := job.Status == types.BatchJobStatusCompleted || job.Status == types.BatchJobStatusFailed || job.Status == types.BatchJobStatusCancelled
+		return job, done, nil
+	})
 }
 
 // CreateSchedule creates a scheduled screenshot job.
 func (c *Client) CreateSchedule(ctx context.Context, req *CreateScreenshotScheduleRequest) (*CreateScheduleResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
 	body := map[string]interface{}{
 		"type": "screenshot",
 		"name": req.Name,
 		"url":  req.URL,
 	}
-	if req.Environment != nil {
-		body["environment"] = *req.Environment
+	if env := c.http.EnvironmentOrDefault(req.Environment); env != "" {
+		body["environment"] = env
 	}
 	if req.ProjectID != nil {
 		body["projectId"] = *req.ProjectID
@@ -329,12 +389,21 @@ func (c *Client) CreateSchedule(ctx context.Context, req *CreateScreenshotSchedu
 	if req.ChangeThreshold != nil {
 		body["changeThreshold"] = *req.ChangeThreshold
 	}
+	if req.RequireApproval != nil {
+		body["requireApproval"] = *req.RequireApproval
+	}
 	if req.WebhookURL != nil {
 		body["webhookUrl"] = *req.WebhookURL
 	}
 	if req.WebhookSecret != nil {
 		body["webhookSecret"] = *req.WebhookSecret
 	}
+	if req.AlertConfig != nil {
+		body["alertConfig"] = req.AlertConfig
+	}
+	if req.Tags != nil {
+		body["tags"] = req.Tags
+	}
 	if req.Metadata != nil {
 		body["metadata"] = req.Metadata
 	}
@@ -348,7 +417,11 @@ func (c *Client) CreateSchedule(ctx context.Context, req *CreateScreenshotSchedu
 
 // UpdateSchedule updates a schedule.
 func (c *Client) UpdateSchedule(ctx context.Context, req *UpdateScreenshotScheduleRequest) (*SuccessResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -380,12 +453,21 @@ func (c *Client) UpdateSchedule(ctx context.Context, req *UpdateScreenshotSchedu
 	if req.ChangeThreshold != nil {
 		body["changeThreshold"] = *req.ChangeThreshold
 	}
+	if req.RequireApproval != nil {
+		body["requireApproval"] = *req.RequireApproval
+	}
 	if req.WebhookURL != nil {
 		body["webhookUrl"] = *req.WebhookURL
 	}
 	if req.WebhookSecret != nil {
 		body["webhookSecret"] = *req.WebhookSecret
 	}
+	if req.AlertConfig != nil {
+		body["alertConfig"] = req.AlertConfig
+	}
+	if req.Tags != nil {
+		body["tags"] = req.Tags
+	}
 	if req.Metadata != nil {
 		body["metadata"] = req.Metadata
 	}
@@ -397,9 +479,93 @@ func (c *Client) UpdateSchedule(ctx context.Context, req *UpdateScreenshotSchedu
 	return &resp, nil
 }
 
+// CreateOrUpdateSchedule creates the screenshot schedule named req.Name, or
+// updates it if one with that name already exists in the environment and
+// project, so repeated runs of the same configuration converge on a single
+// schedule. On update, fields left nil keep their current values. Names are
+// matched exactly; if more than one schedule has the name, an error is
+// returned and nothing is changed.
+func (c *Client) CreateOrUpdateSchedule(ctx context.Context, req *CreateScreenshotScheduleRequest) (*CreateOrUpdateScheduleResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findScheduleByName(ctx, req.Name, req.Environment, req.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		created, err := c.CreateSchedule(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return &CreateOrUpdateScheduleResponse{ID: created.ID, Created: true}, nil
+	}
+
+	var config map[string]interface{}
+	if req.Config != nil {
+		data, err := json.Marshal(req.Config)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, err
+		}
+	}
+	_, err = c.UpdateSchedule(ctx, &UpdateScreenshotScheduleRequest{
+		ID:              existing.ID,
+		Environment:     req.Environment,
+		ProjectID:       req.ProjectID,
+		Frequency:       req.Frequency,
+		Config:          config,
+		DetectChanges:   req.DetectChanges,
+		ChangeThreshold: req.ChangeThreshold,
+		RequireApproval: req.RequireApproval,
+		WebhookURL:      req.WebhookURL,
+		WebhookSecret:   req.WebhookSecret,
+		AlertConfig:     req.AlertConfig,
+		Tags:            req.Tags,
+		Metadata:        req.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CreateOrUpdateScheduleResponse{ID: existing.ID, Created: false}, nil
+}
+
+// findScheduleByName pages through the screenshot schedules in an environment and
+// project and returns the one named name, or nil if there is none.
+func (c *Client) findScheduleByName(ctx context.Context, name string, env *types.Environment, projectID *string) (*ScreenshotSchedule, error) {
+	var found *ScreenshotSchedule
+	list := &ListSchedulesRequest{Environment: env, ProjectID: projectID}
+	for {
+		page, err := c.ListSchedules(ctx, list)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Items {
+			if page.Items[i].Name != name {
+				continue
+			}
+			if found != nil {
+				return nil, fmt.Errorf("stack0: more than one screenshot schedule is named %q", name)
+			}
+			found = &page.Items[i]
+		}
+		if page.NextCursor == nil || *page.NextCursor == "" {
+			return found, nil
+		}
+		list.Cursor = page.NextCursor
+	}
+}
+
 // GetSchedule retrieves a schedule by ID.
 func (c *Client) GetSchedule(ctx context.Context, req *GetScheduleRequest) (*ScreenshotSchedule, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -421,7 +587,12 @@ func (c *Client) GetSchedule(ctx context.Context, req *GetScheduleRequest) (*Scr
 
 // ListSchedules lists schedules with pagination and filters.
 func (c *Client) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (*SchedulesResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("type", "screenshot")
 	if req != nil {
 		if req.Environment != nil {
 			params.Set("environment", string(*req.Environment))
@@ -429,10 +600,12 @@ func (c *Client) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (
 		if req.ProjectID != nil {
 			params.Set("projectId", *req.ProjectID)
 		}
-		params.Set("type", "screenshot")
 		if req.IsActive != nil {
 			params.Set("isActive", strconv.FormatBool(*req.IsActive))
 		}
+		if len(req.Tags) > 0 {
+			params.Set("tags", strings.Join(req.Tags, ","))
+		}
 		if req.Limit != nil {
 			params.Set("limit", strconv.Itoa(*req.Limit))
 		}
@@ -441,13 +614,8 @@ func (c *Client) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (
 		}
 	}
 
-	path := "/webdata/schedules"
-	if len(params) > 0 {
-		path += "?" + params.Encode()
-	}
-
 	var resp SchedulesResponse
-	if err := c.http.Get(ctx, path, &resp); err != nil {
+	if err := c.http.Get(ctx, "/webdata/schedules?"+params.Encode(), &resp); err != nil {
 		return nil, err
 	}
 	return &resp, nil
@@ -455,7 +623,11 @@ func (c *Client) ListSchedules(ctx context.Context, req *ListSchedulesRequest) (
 
 // DeleteSchedule deletes a schedule.
 func (c *Client) DeleteSchedule(ctx context.Context, req *GetScheduleRequest) (*SuccessResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -469,8 +641,8 @@ func (c *Client) DeleteSchedule(ctx context.Context, req *GetScheduleRequest) (*
 	}
 
 	body := map[string]interface{}{"id": req.ID}
-	if req.Environment != nil {
-		body["environment"] = *req.Environment
+	if env := c.http.EnvironmentOrDefault(req.Environment); env != "" {
+		body["environment"] = env
 	}
 	if req.ProjectID != nil {
 		body["projectId"] = *req.ProjectID
@@ -485,7 +657,11 @@ func (c *Client) DeleteSchedule(ctx context.Context, req *GetScheduleRequest) (*
 
 // ToggleSchedule toggles a schedule on or off.
 func (c *Client) ToggleSchedule(ctx context.Context, req *GetScheduleRequest) (*ToggleResponse, error) {
-	params := url.Values{}
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
 	if req.Environment != nil {
 		params.Set("environment", string(*req.Environment))
 	}
@@ -504,3 +680,140 @@ func (c *Client) ToggleSchedule(ctx context.Context, req *GetScheduleRequest) (*
 	}
 	return &resp, nil
 }
+
+// ListScheduleFailures lists failed runs across schedules, newest first.
+// Set ScheduleID to restrict the feed to a single schedule.
+func (c *Client) ListScheduleFailures(ctx context.Context, req *ListScheduleFailuresRequest) (*ScheduleFailuresResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("type", "screenshot")
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.ProjectID != nil {
+			params.Set("projectId", *req.ProjectID)
+		}
+		if req.ScheduleID != nil {
+			params.Set("scheduleId", *req.ScheduleID)
+		}
+		client.SetTime(params, "since", req.Since)
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Cursor != nil {
+			params.Set("cursor", *req.Cursor)
+		}
+	}
+
+	var resp ScheduleFailuresResponse
+	if err := c.http.Get(ctx, "/webdata/schedules/failures?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListScheduleRuns lists schedule runs, newest first. Filter by
+// ApprovalStatusPending to get the runs awaiting review.
+func (c *Client) ListScheduleRuns(ctx context.Context, req *ListScheduleRunsRequest) (*ScheduleRunsResponse, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	params := c.http.EnvironmentQuery()
+	params.Set("type", "screenshot")
+	if req != nil {
+		if req.Environment != nil {
+			params.Set("environment", string(*req.Environment))
+		}
+		if req.ProjectID != nil {
+			params.Set("projectId", *req.ProjectID)
+		}
+		if req.ScheduleID != nil {
+			params.Set("scheduleId", *req.ScheduleID)
+		}
+		if req.ApprovalStatus != nil {
+			params.Set("approvalStatus", string(*req.ApprovalStatus))
+		}
+		if req.Limit != nil {
+			params.Set("limit", strconv.Itoa(*req.Limit))
+		}
+		if req.Cursor != nil {
+			params.Set("cursor", *req.Cursor)
+		}
+	}
+
+	var resp ScheduleRunsResponse
+	if err := c.http.Get(ctx, "/webdata/schedules/runs?"+params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SetBaseline makes a run the baseline that later runs of its schedule are
+// compared against.
+func (c *Client) SetBaseline(ctx context.Context, req *GetScheduleRunRequest) (*ScheduleRun, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	path := c.scheduleRunPath(req.ScheduleID, req.RunID, "baseline", req.Environment, req.ProjectID)
+
+	var resp ScheduleRun
+	if err := c.http.Post(ctx, path, map[string]interface{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ApproveRun approves a run that is pending approval.
+func (c *Client) ApproveRun(ctx context.Context, req *ReviewScheduleRunRequest) (*ScheduleRun, error) {
+	return c.reviewRun(ctx, req, "approve")
+}
+
+// RejectRun rejects a run that is pending approval. The baseline is left
+// unchanged.
+func (c *Client) RejectRun(ctx context.Context, req *ReviewScheduleRunRequest) (*ScheduleRun, error) {
+	return c.reviewRun(ctx, req, "reject")
+}
+
+func (c *Client) reviewRun(ctx context.Context, req *ReviewScheduleRunRequest, action string) (*ScheduleRun, error) {
+	if err := c.http.Validate(req); err != nil {
+		return nil, err
+	}
+
+	path := c.scheduleRunPath(req.ScheduleID, req.RunID, action, req.Environment, req.ProjectID)
+
+	body := map[string]interface{}{}
+	if req.Comment != nil {
+		body["comment"] = *req.Comment
+	}
+	if req.UpdateBaseline != nil {
+		body["updateBaseline"] = *req.UpdateBaseline
+	}
+
+	var resp ScheduleRun
+	if err := c.http.Post(ctx, path, body, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) scheduleRunPath(scheduleID, runID, action string, environment *types.Environment, projectID *string) string {
+	params := c.http.EnvironmentQuery()
+	if environment != nil {
+		params.Set("environment", string(*environment))
+	}
+	if projectID != nil {
+		params.Set("projectId", *projectID)
+	}
+
+	path := "/webdata/schedules/" + scheduleID + "/runs/" + runID + "/" + action
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+	return path
+}
@@ -199,6 +199,39 @@ func TestClient_CaptureAndWait_Success(t *testing.T) {
 	assert.GreaterOrEqual(t, callCount, int32(3))
 }
 
+func TestClient_CaptureAndWait_RateLimited(t *testing.T) {
+	var callCount int32
+
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(CreateScreenshotResponse{ID: "ss-123", Status: ScreenshotStatusPending})
+			return
+		}
+
+		if atomic.AddInt32(&callCount, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Too many requests"})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(Screenshot{ID: "ss-123", Status: ScreenshotStatusCompleted})
+	})
+	defer server.Close()
+
+	resp, err := screenshotsClient.CaptureAndWait(context.Background(), &CreateScreenshotRequest{
+		URL: "https://example.com",
+	}, &CaptureAndWaitOptions{
+		PollInterval: 10 * time.Millisecond,
+		Timeout:      5 * time.Second,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, ScreenshotStatusCompleted, resp.Status)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&callCount))
+}
+
 func TestClient_CaptureAndWait_Failed(t *testing.T) {
 	var callCount int32
 	errorMessage := "Page load failed"
@@ -530,6 +563,94 @@ func TestClient_ListSchedules(t *testing.T) {
 	assert.Len(t, resp.Items, 2)
 }
 
+func TestClient_CreateOrUpdateSchedule(t *testing.T) {
+	t.Run("creates a missing schedule", func(t *testing.T) {
+		screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if r.Method == http.MethodGet {
+				json.NewEncoder(w).Encode(SchedulesResponse{
+					Items: []ScreenshotSchedule{{ID: "sched-1", Name: "Other"}},
+				})
+				return
+			}
+			assert.Equal(t, "/webdata/schedules", r.URL.Path)
+			json.NewEncoder(w).Encode(CreateScheduleResponse{ID: "sched-2"})
+		})
+		defer server.Close()
+
+		resp, err := screenshotsClient.CreateOrUpdateSchedule(context.Background(), &CreateScreenshotScheduleRequest{
+			Name: "Nightly",
+			URL:  "https://example.com",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "sched-2", resp.ID)
+		assert.True(t, resp.Created)
+	})
+
+	t.Run("updates an existing schedule", func(t *testing.T) {
+		var updated bool
+		nextCursor := "page-2"
+		weekly := types.ScheduleFrequencyWeekly
+		fullPage := true
+		screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			if r.Method == http.MethodGet {
+				if r.URL.Query().Get("cursor") == "" {
+					json.NewEncoder(w).Encode(SchedulesResponse{
+						Items:      []ScreenshotSchedule{{ID: "sched-1", Name: "Other"}},
+						NextCursor: &nextCursor,
+					})
+					return
+				}
+				json.NewEncoder(w).Encode(SchedulesResponse{
+					Items: []ScreenshotSchedule{{ID: "sched-2", Name: "Nightly"}},
+				})
+				return
+			}
+			updated = true
+			assert.Equal(t, "/webdata/schedules/sched-2", r.URL.Path)
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "weekly", body["frequency"])
+			assert.Equal(t, map[string]interface{}{"fullPage": true}, body["config"])
+			json.NewEncoder(w).Encode(SuccessResponse{Success: true})
+		})
+		defer server.Close()
+
+		resp, err := screenshotsClient.CreateOrUpdateSchedule(context.Background(), &CreateScreenshotScheduleRequest{
+			Name:      "Nightly",
+			URL:       "https://example.com",
+			Frequency: &weekly,
+			Config:    &BatchScreenshotConfig{FullPage: &fullPage},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, updated)
+		assert.Equal(t, "sched-2", resp.ID)
+		assert.False(t, resp.Created)
+	})
+
+	t.Run("ambiguous name", func(t *testing.T) {
+		screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(SchedulesResponse{
+				Items: []ScreenshotSchedule{{ID: "sched-1", Name: "Nightly"}, {ID: "sched-2", Name: "Nightly"}},
+			})
+		})
+		defer server.Close()
+
+		_, err := screenshotsClient.CreateOrUpdateSchedule(context.Background(), &CreateScreenshotScheduleRequest{
+			Name: "Nightly",
+			URL:  "https://example.com",
+		})
+
+		assert.EqualError(t, err, `stack0: more than one screenshot schedule is named "Nightly"`)
+	})
+}
+
 func TestClient_DeleteSchedule(t *testing.T) {
 	scheduleID := "sched-123"
 	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
@@ -568,6 +689,237 @@ func TestClient_ToggleSchedule(t *testing.T) {
 	assert.False(t, resp.IsActive)
 }
 
+func TestClient_CreateSchedule_WithAlertConfig(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+
+		alertConfig, ok := body["alertConfig"].(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, true, alertConfig["enabled"])
+		assert.Equal(t, float64(3), alertConfig["consecutiveFailures"])
+		assert.Equal(t, []interface{}{"ops@example.com"}, alertConfig["emails"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateScheduleResponse{ID: "sched-123"})
+	})
+	defer server.Close()
+
+	consecutiveFailures := 3
+	resp, err := screenshotsClient.CreateSchedule(context.Background(), &CreateScreenshotScheduleRequest{
+		Name: "My Schedule",
+		URL:  "https://example.com",
+		AlertConfig: &types.ScheduleAlertConfig{
+			Enabled:             true,
+			ConsecutiveFailures: &consecutiveFailures,
+			Emails:              []string{"ops@example.com"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "sched-123", resp.ID)
+}
+
+func TestClient_ListScheduleFailures(t *testing.T) {
+	since := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/webdata/schedules/failures", r.URL.Path)
+		assert.Equal(t, "screenshot", r.URL.Query().Get("type"))
+		assert.Equal(t, "sched-123", r.URL.Query().Get("scheduleId"))
+		assert.Equal(t, "2024-01-15T10:00:00Z", r.URL.Query().Get("since"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ScheduleFailuresResponse{
+			Items: []types.ScheduleFailure{
+				{ID: "fail-1", ScheduleID: "sched-123", ConsecutiveFailures: 3, AlertSent: true},
+			},
+		})
+	})
+	defer server.Close()
+
+	scheduleID := "sched-123"
+	resp, err := screenshotsClient.ListScheduleFailures(context.Background(), &ListScheduleFailuresRequest{
+		ScheduleID: &scheduleID,
+		Since:      &since,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, 3, resp.Items[0].ConsecutiveFailures)
+	assert.True(t, resp.Items[0].AlertSent)
+}
+
+func TestClient_CreateSchedule_RequireApproval(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, true, body["requireApproval"])
+		assert.Equal(t, float64(5), body["changeThreshold"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateScheduleResponse{ID: "sched-123"})
+	})
+	defer server.Close()
+
+	detect := true
+	threshold := 5
+	requireApproval := true
+	_, err := screenshotsClient.CreateSchedule(context.Background(), &CreateScreenshotScheduleRequest{
+		Name:            "Homepage",
+		URL:             "https://example.com",
+		DetectChanges:   &detect,
+		ChangeThreshold: &threshold,
+		RequireApproval: &requireApproval,
+	})
+
+	require.NoError(t, err)
+}
+
+func TestClient_ListScheduleRuns(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/webdata/schedules/runs", r.URL.Path)
+		assert.Equal(t, "screenshot", r.URL.Query().Get("type"))
+		assert.Equal(t, "pending", r.URL.Query().Get("approvalStatus"))
+		assert.Empty(t, r.URL.Query().Get("scheduleId"))
+
+		change := 12.5
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ScheduleRunsResponse{
+			Items: []ScheduleRun{
+				{ID: "run-2", ScheduleID: "sched-123", ChangePercent: &change, ApprovalStatus: ApprovalStatusPending},
+			},
+		})
+	})
+	defer server.Close()
+
+	pending := ApprovalStatusPending
+	resp, err := screenshotsClient.ListScheduleRuns(context.Background(), &ListScheduleRunsRequest{
+		ApprovalStatus: &pending,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, 12.5, *resp.Items[0].ChangePercent)
+}
+
+func TestClient_SetBaseline(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/webdata/schedules/sched-123/runs/run-1/baseline", r.URL.Path)
+		assert.Equal(t, "production", r.URL.Query().Get("environment"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ScheduleRun{ID: "run-1", IsBaseline: true})
+	})
+	defer server.Close()
+
+	env := types.EnvironmentProduction
+	resp, err := screenshotsClient.SetBaseline(context.Background(), &GetScheduleRunRequest{
+		ScheduleID:  "sched-123",
+		RunID:       "run-1",
+		Environment: &env,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, resp.IsBaseline)
+}
+
+func TestClient_ApproveRun(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/webdata/schedules/sched-123/runs/run-2/approve", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "Expected redesign", body["comment"])
+		assert.Equal(t, true, body["updateBaseline"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ScheduleRun{ID: "run-2", IsBaseline: true, ApprovalStatus: ApprovalStatusApproved})
+	})
+	defer server.Close()
+
+	comment := "Expected redesign"
+	updateBaseline := true
+	resp, err := screenshotsClient.ApproveRun(context.Background(), &ReviewScheduleRunRequest{
+		ScheduleID:     "sched-123",
+		RunID:          "run-2",
+		Comment:        &comment,
+		UpdateBaseline: &updateBaseline,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalStatusApproved, resp.ApprovalStatus)
+	assert.True(t, resp.IsBaseline)
+}
+
+func TestClient_RejectRun(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webdata/schedules/sched-123/runs/run-2/reject", r.URL.Path)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Empty(t, body)
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ScheduleRun{ID: "run-2", ApprovalStatus: ApprovalStatusRejected})
+	})
+	defer server.Close()
+
+	resp, err := screenshotsClient.RejectRun(context.Background(), &ReviewScheduleRunRequest{
+		ScheduleID: "sched-123",
+		RunID:      "run-2",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalStatusRejected, resp.ApprovalStatus)
+}
+
+func TestClient_List_WithTags(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webdata/screenshots", r.URL.Path)
+		assert.Equal(t, "team-growth,campaign-q3", r.URL.Query().Get("tags"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListScreenshotsResponse{
+			Items: []Screenshot{{ID: "item-1", Tags: []string{"team-growth"}}},
+		})
+	})
+	defer server.Close()
+
+	resp, err := screenshotsClient.List(context.Background(), &ListScreenshotsRequest{
+		Tags: []string{"team-growth", "campaign-q3"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Items, 1)
+	assert.Equal(t, []string{"team-growth"}, resp.Items[0].Tags)
+}
+
+func TestClient_CreateSchedule_WithTags(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"team-growth"}, body["tags"])
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(CreateScheduleResponse{ID: "sched-123"})
+	})
+	defer server.Close()
+
+	_, err := screenshotsClient.CreateSchedule(context.Background(), &CreateScreenshotScheduleRequest{
+		Name: "My Schedule",
+		URL:  "https://example.com",
+		Tags: []string{"team-growth"},
+	})
+
+	require.NoError(t, err)
+}
+
 func TestScreenshotStatus_Constants(t *testing.T) {
 	assert.Equal(t, ScreenshotStatus("pending"), ScreenshotStatusPending)
 	assert.Equal(t, ScreenshotStatus("processing"), ScreenshotStatusProcessing)
@@ -587,3 +939,48 @@ func TestDeviceType_Constants(t *testing.T) {
 	assert.Equal(t, DeviceType("tablet"), DeviceTypeTablet)
 	assert.Equal(t, DeviceType("mobile"), DeviceTypeMobile)
 }
+
+func TestClient_List_WithMetadata(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webdata/screenshots", r.URL.Path)
+		assert.Equal(t, "ord_123", r.URL.Query().Get("metadata[orderId]"))
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(ListScreenshotsResponse{})
+	})
+	defer server.Close()
+
+	_, err := screenshotsClient.List(context.Background(), &ListScreenshotsRequest{
+		Metadata: map[string]string{"orderId": "ord_123"},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestClient_MetadataIndex(t *testing.T) {
+	screenshotsClient, server := setupScreenshotsTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webdata/screenshots/metadata-index", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodPut:
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, []interface{}{"orderId", "customerId"}, body["keys"])
+		case http.MethodGet:
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(types.MetadataIndex{Keys: []string{"orderId", "customerId"}, MaxKeys: 10})
+	})
+	defer server.Close()
+
+	index, err := screenshotsClient.SetMetadataIndex(context.Background(), []string{"orderId", "customerId"})
+	require.NoError(t, err)
+	assert.Equal(t, 10, index.MaxKeys)
+
+	index, err = screenshotsClient.GetMetadataIndex(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orderId", "customerId"}, index.Keys)
+}
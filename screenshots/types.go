@@ -69,6 +69,7 @@ type Screenshot struct {
 	ImageHeight      *int                   `json:"imageHeight,omitempty"`
 	Error            *string                `json:"error,omitempty"`
 	ProcessingTimeMs *int64                 `json:"processingTimeMs,omitempty"`
+	Tags             []string               `json:"tags,omitempty"`
 	Metadata         map[string]interface{} `json:"metadata,omitempty"`
 	CreatedAt        time.Time              `json:"createdAt"`
 	CompletedAt      *time.Time             `json:"completedAt,omitempty"`
@@ -91,7 +92,7 @@ type Cookie struct {
 
 // CreateScreenshotRequest is the request for capturing a screenshot.
 type CreateScreenshotRequest struct {
-	URL                string                 `json:"url"`
+	URL                string                 `json:"url" validate:"required"`
 	Environment        *types.Environment     `json:"environment,omitempty"`
 	ProjectID          *string                `json:"projectId,omitempty"`
 	Format             *ScreenshotFormat      `json:"format,omitempty"`
@@ -126,6 +127,7 @@ type CreateScreenshotRequest struct {
 	CacheTTL           *int                   `json:"cacheTtl,omitempty"`
 	WebhookURL         *string                `json:"webhookUrl,omitempty"`
 	WebhookSecret      *string                `json:"webhookSecret,omitempty"`
+	Tags               []string               `json:"tags,omitempty"`
 	Metadata           map[string]interface{} `json:"metadata,omitempty"`
 }
 
@@ -137,7 +139,7 @@ type CreateScreenshotResponse struct {
 
 // GetScreenshotRequest is the request for getting a screenshot.
 type GetScreenshotRequest struct {
-	ID          string             `json:"id"`
+	ID          string             `json:"id" validate:"required"`
 	Environment *types.Environment `json:"environment,omitempty"`
 	ProjectID   *string            `json:"projectId,omitempty"`
 }
@@ -148,6 +150,8 @@ type ListScreenshotsRequest struct {
 	ProjectID   *string            `json:"projectId,omitempty"`
 	Status      *ScreenshotStatus  `json:"status,omitempty"`
 	URL         *string            `json:"url,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
+	Metadata    map[string]string  `json:"metadata,omitempty"`
 	Limit       *int               `json:"limit,omitempty"`
 	Cursor      *string            `json:"cursor,omitempty"`
 }
@@ -174,6 +178,7 @@ type BatchScreenshotJob struct {
 	SuccessfulURLs int                    `json:"successfulUrls"`
 	FailedURLs     int                    `json:"failedUrls"`
 	WebhookURL     *string                `json:"webhookUrl,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
 	Metadata       map[string]interface{} `json:"metadata,omitempty"`
 	CreatedAt      time.Time              `json:"createdAt"`
 	StartedAt      *time.Time             `json:"startedAt,omitempty"`
@@ -196,14 +201,15 @@ type BatchScreenshotConfig struct {
 
 // CreateBatchScreenshotsRequest is the request for creating a batch job.
 type CreateBatchScreenshotsRequest struct {
-	URLs          []string                `json:"urls"`
-	Environment   *types.Environment      `json:"environment,omitempty"`
-	ProjectID     *string                 `json:"projectId,omitempty"`
-	Name          *string                 `json:"name,omitempty"`
-	Config        *BatchScreenshotConfig  `json:"config,omitempty"`
-	WebhookURL    *string                 `json:"webhookUrl,omitempty"`
-	WebhookSecret *string                 `json:"webhookSecret,omitempty"`
-	Metadata      map[string]interface{}  `json:"metadata,omitempty"`
+	URLs          []string               `json:"urls" validate:"required"`
+	Environment   *types.Environment     `json:"environment,omitempty"`
+	ProjectID     *string                `json:"projectId,omitempty"`
+	Name          *string                `json:"name,omitempty"`
+	Config        *BatchScreenshotConfig `json:"config,omitempty"`
+	WebhookURL    *string                `json:"webhookUrl,omitempty"`
+	WebhookSecret *string                `json:"webhookSecret,omitempty"`
+	Tags          []string               `json:"tags,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // CreateBatchResponse is the response from creating a batch job.
@@ -214,7 +220,7 @@ type CreateBatchResponse struct {
 
 // GetBatchJobRequest is the request for getting a batch job.
 type GetBatchJobRequest struct {
-	ID          string             `json:"id"`
+	ID          string             `json:"id" validate:"required"`
 	Environment *types.Environment `json:"environment,omitempty"`
 	ProjectID   *string            `json:"projectId,omitempty"`
 }
@@ -224,6 +230,7 @@ type ListBatchJobsRequest struct {
 	Environment *types.Environment    `json:"environment,omitempty"`
 	ProjectID   *string               `json:"projectId,omitempty"`
 	Status      *types.BatchJobStatus `json:"status,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
 	Limit       *int                  `json:"limit,omitempty"`
 	Cursor      *string               `json:"cursor,omitempty"`
 }
@@ -236,58 +243,70 @@ type BatchJobsResponse struct {
 
 // ScreenshotSchedule represents a screenshot schedule.
 type ScreenshotSchedule struct {
-	ID              string                  `json:"id"`
-	OrganizationID  string                  `json:"organizationId"`
-	ProjectID       *string                 `json:"projectId,omitempty"`
-	Environment     types.Environment       `json:"environment"`
-	Name            string                  `json:"name"`
-	URL             string                  `json:"url"`
-	Type            string                  `json:"type"`
-	Frequency       types.ScheduleFrequency `json:"frequency"`
-	Config          map[string]interface{}  `json:"config"`
-	IsActive        bool                    `json:"isActive"`
-	DetectChanges   bool                    `json:"detectChanges"`
-	ChangeThreshold *int                    `json:"changeThreshold,omitempty"`
-	WebhookURL      *string                 `json:"webhookUrl,omitempty"`
-	TotalRuns       int                     `json:"totalRuns"`
-	SuccessfulRuns  int                     `json:"successfulRuns"`
-	FailedRuns      int                     `json:"failedRuns"`
-	LastRunAt       *time.Time              `json:"lastRunAt,omitempty"`
-	NextRunAt       *time.Time              `json:"nextRunAt,omitempty"`
-	Metadata        map[string]interface{}  `json:"metadata,omitempty"`
-	CreatedAt       time.Time               `json:"createdAt"`
-	UpdatedAt       time.Time               `json:"updatedAt"`
+	ID                  string                     `json:"id"`
+	OrganizationID      string                     `json:"organizationId"`
+	ProjectID           *string                    `json:"projectId,omitempty"`
+	Environment         types.Environment          `json:"environment"`
+	Name                string                     `json:"name"`
+	URL                 string                     `json:"url"`
+	Type                string                     `json:"type"`
+	Frequency           types.ScheduleFrequency    `json:"frequency"`
+	Config              map[string]interface{}     `json:"config"`
+	IsActive            bool                       `json:"isActive"`
+	DetectChanges       bool                       `json:"detectChanges"`
+	ChangeThreshold     *int                       `json:"changeThreshold,omitempty"`
+	RequireApproval     bool                       `json:"requireApproval"`
+	BaselineRunID       *string                    `json:"baselineRunId,omitempty"`
+	WebhookURL          *string                    `json:"webhookUrl,omitempty"`
+	TotalRuns           int                        `json:"totalRuns"`
+	SuccessfulRuns      int                        `json:"successfulRuns"`
+	FailedRuns          int                        `json:"failedRuns"`
+	ConsecutiveFailures int                        `json:"consecutiveFailures"`
+	AlertConfig         *types.ScheduleAlertConfig `json:"alertConfig,omitempty"`
+	LastRunAt           *time.Time                 `json:"lastRunAt,omitempty"`
+	LastFailureAt       *time.Time                 `json:"lastFailureAt,omitempty"`
+	NextRunAt           *time.Time                 `json:"nextRunAt,omitempty"`
+	Tags                []string                   `json:"tags,omitempty"`
+	Metadata            map[string]interface{}     `json:"metadata,omitempty"`
+	CreatedAt           time.Time                  `json:"createdAt"`
+	UpdatedAt           time.Time                  `json:"updatedAt"`
 }
 
 // CreateScreenshotScheduleRequest is the request for creating a schedule.
 type CreateScreenshotScheduleRequest struct {
-	Name            string                   `json:"name"`
-	URL             string                   `json:"url"`
-	Environment     *types.Environment       `json:"environment,omitempty"`
-	ProjectID       *string                  `json:"projectId,omitempty"`
-	Frequency       *types.ScheduleFrequency `json:"frequency,omitempty"`
-	Config          *BatchScreenshotConfig   `json:"config,omitempty"`
-	DetectChanges   *bool                    `json:"detectChanges,omitempty"`
-	ChangeThreshold *int                     `json:"changeThreshold,omitempty"`
-	WebhookURL      *string                  `json:"webhookUrl,omitempty"`
-	WebhookSecret   *string                  `json:"webhookSecret,omitempty"`
-	Metadata        map[string]interface{}   `json:"metadata,omitempty"`
+	Name            string                     `json:"name" validate:"required"`
+	URL             string                     `json:"url" validate:"required"`
+	Environment     *types.Environment         `json:"environment,omitempty"`
+	ProjectID       *string                    `json:"projectId,omitempty"`
+	Frequency       *types.ScheduleFrequency   `json:"frequency,omitempty"`
+	Config          *BatchScreenshotConfig     `json:"config,omitempty"`
+	DetectChanges   *bool                      `json:"detectChanges,omitempty"`
+	ChangeThreshold *int                       `json:"changeThreshold,omitempty"`
+	RequireApproval *bool                      `json:"requireApproval,omitempty"`
+	WebhookURL      *string                    `json:"webhookUrl,omitempty"`
+	WebhookSecret   *string                    `json:"webhookSecret,omitempty"`
+	AlertConfig     *types.ScheduleAlertConfig `json:"alertConfig,omitempty"`
+	Tags            []string                   `json:"tags,omitempty"`
+	Metadata        map[string]interface{}     `json:"metadata,omitempty"`
 }
 
 // UpdateScreenshotScheduleRequest is the request for updating a schedule.
 type UpdateScreenshotScheduleRequest struct {
-	ID              string                   `json:"id"`
-	Environment     *types.Environment       `json:"environment,omitempty"`
-	ProjectID       *string                  `json:"projectId,omitempty"`
-	Name            *string                  `json:"name,omitempty"`
-	Frequency       *types.ScheduleFrequency `json:"frequency,omitempty"`
-	Config          map[string]interface{}   `json:"config,omitempty"`
-	IsActive        *bool                    `json:"isActive,omitempty"`
-	DetectChanges   *bool                    `json:"detectChanges,omitempty"`
-	ChangeThreshold *int                     `json:"changeThreshold,omitempty"`
-	WebhookURL      *string                  `json:"webhookUrl,omitempty"`
-	WebhookSecret   *string                  `json:"webhookSecret,omitempty"`
-	Metadata        map[string]interface{}   `json:"metadata,omitempty"`
+	ID              string                     `json:"id" validate:"required"`
+	Environment     *types.Environment         `json:"environment,omitempty"`
+	ProjectID       *string                    `json:"projectId,omitempty"`
+	Name            *string                    `json:"name,omitempty"`
+	Frequency       *types.ScheduleFrequency   `json:"frequency,omitempty"`
+	Config          map[string]interface{}     `json:"config,omitempty"`
+	IsActive        *bool                      `json:"isActive,omitempty"`
+	DetectChanges   *bool                      `json:"detectChanges,omitempty"`
+	ChangeThreshold *int                       `json:"changeThreshold,omitempty"`
+	RequireApproval *bool                      `json:"requireApproval,omitempty"`
+	WebhookURL      *string                    `json:"webhookUrl,omitempty"`
+	WebhookSecret   *string                    `json:"webhookSecret,omitempty"`
+	AlertConfig     *types.ScheduleAlertConfig `json:"alertConfig,omitempty"`
+	Tags            []string                   `json:"tags,omitempty"`
+	Metadata        map[string]interface{}     `json:"metadata,omitempty"`
 }
 
 // CreateScheduleResponse is the response from creating a schedule.
@@ -295,9 +314,15 @@ type CreateScheduleResponse struct {
 	ID string `json:"id"`
 }
 
+// CreateOrUpdateScheduleResponse is the response from CreateOrUpdateSchedule.
+type CreateOrUpdateScheduleResponse struct {
+	ID      string `json:"id"`
+	Created bool   `json:"created"`
+}
+
 // GetScheduleRequest is the request for getting a schedule.
 type GetScheduleRequest struct {
-	ID          string             `json:"id"`
+	ID          string             `json:"id" validate:"required"`
 	Environment *types.Environment `json:"environment,omitempty"`
 	ProjectID   *string            `json:"projectId,omitempty"`
 }
@@ -307,6 +332,7 @@ type ListSchedulesRequest struct {
 	Environment *types.Environment `json:"environment,omitempty"`
 	ProjectID   *string            `json:"projectId,omitempty"`
 	IsActive    *bool              `json:"isActive,omitempty"`
+	Tags        []string           `json:"tags,omitempty"`
 	Limit       *int               `json:"limit,omitempty"`
 	Cursor      *string            `json:"cursor,omitempty"`
 }
@@ -326,3 +352,85 @@ type SuccessResponse struct {
 type ToggleResponse struct {
 	IsActive bool `json:"isActive"`
 }
+
+// ListScheduleFailuresRequest is the request for listing schedule failures.
+type ListScheduleFailuresRequest struct {
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+	ScheduleID  *string            `json:"scheduleId,omitempty"`
+	Since       *time.Time         `json:"since,omitempty"`
+	Limit       *int               `json:"limit,omitempty"`
+	Cursor      *string            `json:"cursor,omitempty"`
+}
+
+// ScheduleFailuresResponse is the response from listing schedule failures.
+type ScheduleFailuresResponse struct {
+	Items      []types.ScheduleFailure `json:"items"`
+	NextCursor *string                 `json:"nextCursor,omitempty"`
+}
+
+// ApprovalStatus is the review state of a schedule run. Runs whose change
+// from the baseline exceeds the schedule's ChangeThreshold are pending when
+// the schedule has RequireApproval set; other runs are not_required.
+type ApprovalStatus string
+
+const (
+	ApprovalStatusNotRequired ApprovalStatus = "not_required"
+	ApprovalStatusPending     ApprovalStatus = "pending"
+	ApprovalStatusApproved    ApprovalStatus = "approved"
+	ApprovalStatusRejected    ApprovalStatus = "rejected"
+)
+
+// ScheduleRun is a single run of a screenshot schedule. ChangePercent is the
+// difference from the schedule's baseline and is nil for the first run.
+type ScheduleRun struct {
+	ID             string         `json:"id"`
+	ScheduleID     string         `json:"scheduleId"`
+	ScreenshotID   string         `json:"screenshotId"`
+	ImageURL       *string        `json:"imageUrl,omitempty"`
+	DiffImageURL   *string        `json:"diffImageUrl,omitempty"`
+	ChangePercent  *float64       `json:"changePercent,omitempty"`
+	IsBaseline     bool           `json:"isBaseline"`
+	ApprovalStatus ApprovalStatus `json:"approvalStatus"`
+	ReviewedBy     *string        `json:"reviewedBy,omitempty"`
+	ReviewedAt     *time.Time     `json:"reviewedAt,omitempty"`
+	Comment        *string        `json:"comment,omitempty"`
+	CreatedAt      time.Time      `json:"createdAt"`
+}
+
+// ListScheduleRunsRequest is the request for listing schedule runs. Leave
+// ScheduleID unset to list runs across all schedules, e.g. every run pending
+// approval.
+type ListScheduleRunsRequest struct {
+	Environment    *types.Environment `json:"environment,omitempty"`
+	ProjectID      *string            `json:"projectId,omitempty"`
+	ScheduleID     *string            `json:"scheduleId,omitempty"`
+	ApprovalStatus *ApprovalStatus    `json:"approvalStatus,omitempty"`
+	Limit          *int               `json:"limit,omitempty"`
+	Cursor         *string            `json:"cursor,omitempty"`
+}
+
+// ScheduleRunsResponse is the response from listing schedule runs.
+type ScheduleRunsResponse struct {
+	Items      []ScheduleRun `json:"items"`
+	NextCursor *string       `json:"nextCursor,omitempty"`
+}
+
+// GetScheduleRunRequest identifies a run of a schedule.
+type GetScheduleRunRequest struct {
+	ScheduleID  string             `json:"scheduleId" validate:"required"`
+	RunID       string             `json:"runId" validate:"required"`
+	Environment *types.Environment `json:"environment,omitempty"`
+	ProjectID   *string            `json:"projectId,omitempty"`
+}
+
+// ReviewScheduleRunRequest is the request for approving or rejecting a run.
+// Set UpdateBaseline when approving to make the run the new baseline.
+type ReviewScheduleRunRequest struct {
+	ScheduleID     string             `json:"scheduleId" validate:"required"`
+	RunID          string             `json:"runId" validate:"required"`
+	Environment    *types.Environment `json:"environment,omitempty"`
+	ProjectID      *string            `json:"projectId,omitempty"`
+	Comment        *string            `json:"comment,omitempty"`
+	UpdateBaseline *bool              `json:"updateBaseline,omitempty"`
+}
@@ -0,0 +1,30 @@
+package screenshots
+
+import "github.com/stack0/sdk-go/types"
+
+func init() {
+	types.RegisterEnum(ScreenshotFormatPNG, ScreenshotFormatJPEG, ScreenshotFormatWebP, ScreenshotFormatPDF)
+	types.RegisterEnum(DeviceTypeDesktop, DeviceTypeTablet, DeviceTypeMobile)
+	types.RegisterEnum(
+		ResourceTypeImage,
+		ResourceTypeStylesheet,
+		ResourceTypeScript,
+		ResourceTypeFont,
+		ResourceTypeMedia,
+		ResourceTypeXHR,
+		ResourceTypeFetch,
+		ResourceTypeWebSocket,
+	)
+	types.RegisterEnum(
+		ScreenshotStatusPending,
+		ScreenshotStatusProcessing,
+		ScreenshotStatusCompleted,
+		ScreenshotStatusFailed,
+	)
+	types.RegisterEnum(
+		ApprovalStatusNotRequired,
+		ApprovalStatusPending,
+		ApprovalStatusApproved,
+		ApprovalStatusRejected,
+	)
+}
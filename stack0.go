@@ -19,11 +19,17 @@
 package stack0
 
 import (
+	"github.com/stack0/sdk-go/admin"
 	"github.com/stack0/sdk-go/cdn"
 	"github.com/stack0/sdk-go/client"
+	"github.com/stack0/sdk-go/compliance"
 	"github.com/stack0/sdk-go/extraction"
+	"github.com/stack0/sdk-go/integrations"
+	"github.com/stack0/sdk-go/jobs"
 	"github.com/stack0/sdk-go/mail"
+	"github.com/stack0/sdk-go/quota"
 	"github.com/stack0/sdk-go/screenshots"
+	"github.com/stack0/sdk-go/types"
 )
 
 const (
@@ -44,14 +50,35 @@ type Client struct {
 
 	// Extraction provides access to AI content extraction.
 	Extraction *extraction.Client
+
+	// Jobs provides a unified view of asynchronous jobs across all modules.
+	Jobs *jobs.Client
+
+	// Integrations provides access to Slack and Microsoft Teams notifications.
+	Integrations *integrations.Client
+
+	// Quota provides daily quota information and quota-aware batching.
+	Quota *quota.Client
+
+	// Admin manages organization members, invitations and roles.
+	Admin *admin.Client
+
+	// Compliance provides data-processing and subprocessor information.
+	Compliance *compliance.Client
+
+	http *client.HTTPClient
+	opts options
 }
 
 // Option is a functional option for configuring the Client.
 type Option func(*options)
 
 type options struct {
-	baseURL string
-	cdnURL  string
+	baseURL         string
+	cdnURL          string
+	cdnCustomDomain string
+	dedupeGets      bool
+	skipValidation  bool
 }
 
 // WithBaseURL sets a custom base URL for the API.
@@ -69,6 +96,32 @@ func WithCDNURL(url string) Option {
 	}
 }
 
+// WithCDNCustomDomain sets an active custom domain, e.g. "assets.example.com",
+// used for client-side transform URLs instead of the CDN URL.
+func WithCDNCustomDomain(domain string) Option {
+	return func(o *options) {
+		o.cdnCustomDomain = domain
+	}
+}
+
+// WithGetDeduplication collapses identical GET requests made concurrently,
+// e.g. many goroutines fetching the same template or asset, into a single
+// API call whose response is shared.
+func WithGetDeduplication() Option {
+	return func(o *options) {
+		o.dedupeGets = true
+	}
+}
+
+// WithoutRequestValidation turns off client-side request validation, so
+// requests are sent as given even if they are missing required fields or use
+// enum values the SDK does not know about.
+func WithoutRequestValidation() Option {
+	return func(o *options) {
+		o.skipValidation = true
+	}
+}
+
 // New creates a new Stack0 client with the given API key.
 func New(apiKey string, opts ...Option) *Client {
 	o := &options{
@@ -78,12 +131,49 @@ func New(apiKey string, opts ...Option) *Client {
 		opt(o)
 	}
 
-	httpClient := client.NewHTTPClient(apiKey, o.baseURL)
+	httpClient := client.New(apiKey, o.baseURL)
+	httpClient.SetDeduplicateGets(o.dedupeGets)
+	httpClient.SetValidateRequests(!o.skipValidation)
+
+	return newClient(httpClient, *o)
+}
+
+// ForEnvironment returns a client whose requests default to env, for the
+// requests that have an Environment. Requests that set their own Environment
+// are sent as given. The returned client
+// shares this client's connections and configuration, so a service working
+// with both sandbox and production can keep one client per environment
+// without duplicating either:
+//
+//	sandbox := client.ForEnvironment(types.EnvironmentSandbox)
+//	production := client.ForEnvironment(types.EnvironmentProduction)
+func (c *Client) ForEnvironment(env types.Environment) *Client {
+	return newClient(c.http.WithEnvironment(env), c.opts)
+}
+
+// Environment returns the environment set by ForEnvironment, or "" for a
+// client created by New.
+func (c *Client) Environment() types.Environment {
+	return c.http.Environment()
+}
+
+func newClient(httpClient *client.HTTPClient, o options) *Client {
+	cdnClient := cdn.NewClient(httpClient, o.cdnURL)
+	if o.cdnCustomDomain != "" {
+		cdnClient.SetCustomDomain(o.cdnCustomDomain)
+	}
 
 	return &Client{
-		Mail:        mail.NewClient(httpClient),
-		CDN:         cdn.NewClient(httpClient, o.cdnURL),
-		Screenshots: screenshots.NewClient(httpClient),
-		Extraction:  extraction.NewClient(httpClient),
+		Mail:         mail.New(httpClient),
+		CDN:          cdnClient,
+		Screenshots:  screenshots.NewClient(httpClient),
+		Extraction:   extraction.NewClient(httpClient),
+		Jobs:         jobs.NewClient(httpClient),
+		Integrations: integrations.NewClient(httpClient),
+		Quota:        quota.NewClient(httpClient),
+		Admin:        admin.NewClient(httpClient),
+		Compliance:   compliance.NewClient(httpClient),
+		http:         httpClient,
+		opts:         o,
 	}
 }
@@ -1,8 +1,16 @@
 package stack0
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/stack0/sdk-go/cdn"
+	"github.com/stack0/sdk-go/mail"
+	"github.com/stack0/sdk-go/types"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -15,6 +23,11 @@ func TestNew_DefaultConfiguration(t *testing.T) {
 	assert.NotNil(t, client.CDN)
 	assert.NotNil(t, client.Screenshots)
 	assert.NotNil(t, client.Extraction)
+	assert.NotNil(t, client.Jobs)
+	assert.NotNil(t, client.Integrations)
+	assert.NotNil(t, client.Quota)
+	assert.NotNil(t, client.Admin)
+	assert.NotNil(t, client.Compliance)
 }
 
 func TestNew_WithBaseURL(t *testing.T) {
@@ -68,6 +81,142 @@ func TestWithCDNURL(t *testing.T) {
 	assert.Equal(t, cdnURL, o.cdnURL)
 }
 
+func TestWithCDNCustomDomain(t *testing.T) {
+	o := &options{}
+
+	opt := WithCDNCustomDomain("assets.example.com")
+	opt(o)
+
+	assert.Equal(t, "assets.example.com", o.cdnCustomDomain)
+
+	client := New("test-api-key", WithCDNCustomDomain("assets.example.com"))
+	transformURL, err := client.CDN.GetTransformURL("images/hero.jpg", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://assets.example.com/images/hero.jpg", transformURL)
+}
+
+func TestWithGetDeduplication(t *testing.T) {
+	o := &options{}
+
+	opt := WithGetDeduplication()
+	opt(o)
+
+	assert.True(t, o.dedupeGets)
+}
+
 func TestDefaultBaseURL(t *testing.T) {
 	assert.Equal(t, "https://api.stack0.dev", DefaultBaseURL)
 }
+
+func TestWithoutRequestValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(mail.SendEmailResponse{ID: "email-1"})
+	}))
+	defer server.Close()
+
+	_, err := New("test-api-key", WithBaseURL(server.URL)).Mail.Send(context.Background(), &mail.SendEmailRequest{})
+	assert.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	resp, err := New("test-api-key", WithBaseURL(server.URL), WithoutRequestValidation()).Mail.Send(context.Background(), &mail.SendEmailRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "email-1", resp.ID)
+}
+
+func TestClient_ForEnvironment(t *testing.T) {
+	var environments []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		env, _ := body["environment"].(string)
+		environments = append(environments, env)
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "email-1"})
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithBaseURL(server.URL))
+	sandbox := client.ForEnvironment(types.EnvironmentSandbox)
+	production := client.ForEnvironment(types.EnvironmentProduction)
+
+	assert.Equal(t, types.Environment(""), client.Environment())
+	assert.Equal(t, types.EnvironmentSandbox, sandbox.Environment())
+
+	html := "<p>World</p>"
+	req := &mail.SendEmailRequest{
+		From:    "noreply@example.com",
+		To:      "user@example.com",
+		Subject: "Hello",
+		HTML:    &html,
+	}
+	for _, c := range []*Client{sandbox, production, client} {
+		_, err := c.Mail.Send(context.Background(), req)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"sandbox", "production", ""}, environments)
+}
+
+func TestClient_ForEnvironment_SendBatch(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/mail/send/batch", r.URL.Path)
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(mail.SendBatchEmailResponse{})
+	}))
+	defer server.Close()
+
+	sandbox := New("test-api-key", WithBaseURL(server.URL)).ForEnvironment(types.EnvironmentSandbox)
+	_, err := sandbox.Mail.SendBatch(context.Background(), &mail.SendBatchEmailRequest{
+		Emails: []mail.SendEmailRequest{
+			{From: "noreply@example.com", To: "user@example.com", Subject: "Hello"},
+		},
+		Simulate: &mail.SimulateOptions{},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "sandbox", body["environment"])
+	assert.NotNil(t, body["simulate"])
+}
+
+func TestClient_ForEnvironment_PregenerateTransforms(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cdn/transforms/pregenerate", r.URL.Path)
+		json.NewDecoder(r.Body).Decode(&body)
+		json.NewEncoder(w).Encode(cdn.PregenerationJob{ID: "job-1"})
+	}))
+	defer server.Close()
+
+	width := 640
+	sandbox := New("test-api-key", WithBaseURL(server.URL)).ForEnvironment(types.EnvironmentSandbox)
+	_, err := sandbox.CDN.PregenerateTransforms(context.Background(), &cdn.PregenerateTransformsRequest{
+		ProjectSlug: "my-project",
+		AssetIDs:    []string{"asset-1"},
+		Presets:     []cdn.TransformPreset{{Name: "thumb", Options: cdn.TransformOptions{Width: &width}}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "sandbox", body["environment"])
+}
+
+func TestClient_ForEnvironment_Query(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		json.NewEncoder(w).Encode(mail.ListTemplatesResponse{})
+	}))
+	defer server.Close()
+
+	client := New("test-api-key", WithBaseURL(server.URL))
+	sandbox := client.ForEnvironment(types.EnvironmentSandbox)
+	production := types.EnvironmentProduction
+
+	_, err := sandbox.Mail.Templates.List(context.Background(), nil)
+	require.NoError(t, err)
+	_, err = sandbox.Mail.Templates.List(context.Background(), &mail.ListTemplatesRequest{Environment: &production})
+	require.NoError(t, err)
+	_, err = client.Mail.Templates.List(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"environment=sandbox", "environment=production", ""}, queries)
+}
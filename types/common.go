@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // Environment represents the deployment environment.
 type Environment string
 
@@ -34,6 +36,13 @@ type SuccessResponse struct {
 	Success bool `json:"success"`
 }
 
+// MetadataIndex lists the metadata keys that list requests can filter on.
+// Only resources created after a key is added are indexed under it.
+type MetadataIndex struct {
+	Keys    []string `json:"keys"`
+	MaxKeys int      `json:"maxKeys"`
+}
+
 // PaginatedRequest contains common pagination parameters.
 type PaginatedRequest struct {
 	Limit  *int `url:"limit,omitempty"`
@@ -49,7 +58,7 @@ type PaginatedResponse struct {
 
 // GetBatchJobRequest is the request to get a batch job.
 type GetBatchJobRequest struct {
-	ID          string
+	ID          string `validate:"required"`
 	Environment *Environment
 	ProjectID   *string
 }
@@ -72,7 +81,7 @@ type CreateBatchResponse struct {
 
 // GetScheduleRequest is the request to get a schedule.
 type GetScheduleRequest struct {
-	ID          string
+	ID          string `validate:"required"`
 	Environment *Environment
 	ProjectID   *string
 }
@@ -90,3 +99,26 @@ type ListSchedulesRequest struct {
 type CreateScheduleResponse struct {
 	ID string `json:"id"`
 }
+
+// ScheduleAlertConfig configures failure alerting for a schedule.
+type ScheduleAlertConfig struct {
+	Enabled             bool     `json:"enabled"`
+	ConsecutiveFailures *int     `json:"consecutiveFailures,omitempty"`
+	WebhookURL          *string  `json:"webhookUrl,omitempty"`
+	WebhookSecret       *string  `json:"webhookSecret,omitempty"`
+	Emails              []string `json:"emails,omitempty"`
+}
+
+// ScheduleFailure represents a failed scheduled run.
+type ScheduleFailure struct {
+	ID                  string     `json:"id"`
+	ScheduleID          string     `json:"scheduleId"`
+	ScheduleName        string     `json:"scheduleName"`
+	Type                string     `json:"type"`
+	URL                 string     `json:"url"`
+	Error               *string    `json:"error,omitempty"`
+	ConsecutiveFailures int        `json:"consecutiveFailures"`
+	AlertSent           bool       `json:"alertSent"`
+	AlertSentAt         *time.Time `json:"alertSentAt,omitempty"`
+	OccurredAt          time.Time  `json:"occurredAt"`
+}
@@ -0,0 +1,18 @@
+package types
+
+func init() {
+	RegisterEnum(EnvironmentSandbox, EnvironmentProduction)
+	RegisterEnum(
+		BatchJobStatusPending,
+		BatchJobStatusProcessing,
+		BatchJobStatusCompleted,
+		BatchJobStatusFailed,
+		BatchJobStatusCancelled,
+	)
+	RegisterEnum(
+		ScheduleFrequencyHourly,
+		ScheduleFrequencyDaily,
+		ScheduleFrequencyWeekly,
+		ScheduleFrequencyMonthly,
+	)
+}
@@ -1,7 +1,10 @@
 // Package types provides common type definitions for the Stack0 SDK.
 package types
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // ErrorResponse represents an error response from the API.
 type ErrorResponse struct {
@@ -9,12 +12,18 @@ type ErrorResponse struct {
 	Code    string `json:"code,omitempty"`
 }
 
-// APIError represents an error returned by the Stack0 API.
+// ErrCodeMetadataKeyNotIndexed is the APIError code returned when a list
+// request filters on a metadata key that is not in the MetadataIndex.
+const ErrCodeMetadataKeyNotIndexed = "METADATA_KEY_NOT_INDEXED"
+
+// APIError represents an error returned by the Stack0 API. RetryAfter is the
+// delay requested by the API's Retry-After header, or zero if none was sent.
 type APIError struct {
 	StatusCode int
 	Code       string
 	Message    string
 	Response   ErrorResponse
+	RetryAfter time.Duration
 }
 
 // Error implements the error interface.
@@ -25,6 +34,12 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("stack0: %s (status: %d)", e.Message, e.StatusCode)
 }
 
+// Transient reports whether the request may succeed if retried: the API was
+// rate limiting (429) or failed with a server error (5xx).
+func (e *APIError) Transient() bool {
+	return e.StatusCode == 429 || e.StatusCode >= 500
+}
+
 // TimeoutError represents a timeout error during polling operations.
 type TimeoutError struct {
 	Message string
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// JSONField is a struct field as encoding/json sees it.
+type JSONField struct {
+	reflect.StructField
+	// Owner is the struct type declaring the field, which is an embedded
+	// struct for promoted fields.
+	Owner reflect.Type
+	// JSONName is the name of the field in JSON.
+	JSONName string
+	// OmitEmpty reports whether the field is tagged omitempty.
+	OmitEmpty bool
+}
+
+// JSONFields returns the fields of struct type t under their JSON names,
+// following encoding/json's rules for tags and embedded structs. Fields
+// tagged "-" and unexported fields are skipped. When fields share a name, the
+// least nested one wins, and among equally nested fields a single tagged one;
+// a name still left with more than one field is dropped, as encoding/json
+// leaves it out of the encoding.
+func JSONFields(t reflect.Type) []JSONField {
+	type queued struct {
+		typ   reflect.Type
+		index []int
+	}
+	type candidate struct {
+		JSONField
+		index  []int
+		tagged bool
+	}
+
+	var fields []candidate
+	var current []queued
+	next := []queued{{typ: t}}
+	var count, nextCount map[reflect.Type]int
+	visited := make(map[reflect.Type]bool)
+
+	// Walk the embedded structs breadth first, one nesting level at a time.
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, make(map[reflect.Type]int)
+
+		for _, q := range current {
+			if visited[q.typ] {
+				continue
+			}
+			visited[q.typ] = true
+
+			for i := 0; i < q.typ.NumField(); i++ {
+				f := q.typ.Field(i)
+				if f.Anonymous {
+					ft := f.Type
+					if ft.Kind() == reflect.Pointer {
+						ft = ft.Elem()
+					}
+					if !f.IsExported() && ft.Kind() != reflect.Struct {
+						continue
+					}
+				} else if !f.IsExported() {
+					continue
+				}
+				tag := f.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, opts, _ := strings.Cut(tag, ",")
+				index := append(q.index[:len(q.index):len(q.index)], i)
+
+				ft := f.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+				if name != "" || !f.Anonymous || ft.Kind() != reflect.Struct {
+					tagged := name != ""
+					if name == "" {
+						name = f.Name
+					}
+					field := candidate{
+						JSONField: JSONField{
+							StructField: f,
+							Owner:       q.typ,
+							JSONName:    name,
+							OmitEmpty:   strings.Contains(","+opts+",", ",omitempty,"),
+						},
+						index:  index,
+						tagged: tagged,
+					}
+					fields = append(fields, field)
+					// A struct embedded more than once at this level
+					// contributes each of its fields twice, so that they
+					// cancel out below.
+					if count[q.typ] > 1 {
+						fields = append(fields, field)
+					}
+					continue
+				}
+
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, queued{typ: ft, index: index})
+				}
+			}
+		}
+	}
+
+	// Order the fields of each name by depth, tagged fields first, and keep
+	// the dominant one if there is one.
+	sort.Slice(fields, func(i, j int) bool {
+		a, b := fields[i], fields[j]
+		if a.JSONName != b.JSONName {
+			return a.JSONName < b.JSONName
+		}
+		if len(a.index) != len(b.index) {
+			return len(a.index) < len(b.index)
+		}
+		if a.tagged != b.tagged {
+			return a.tagged
+		}
+		return lessIndex(a.index, b.index)
+	})
+	dominant := fields[:0]
+	for i := 0; i < len(fields); {
+		n := 1
+		for i+n < len(fields) && fields[i+n].JSONName == fields[i].JSONName {
+			n++
+		}
+		if n == 1 || len(fields[i].index) < len(fields[i+1].index) || fields[i].tagged != fields[i+1].tagged {
+			dominant = append(dominant, fields[i])
+		}
+		i += n
+	}
+
+	// Return the fields in declaration order.
+	sort.Slice(dominant, func(i, j int) bool {
+		return lessIndex(dominant[i].index, dominant[j].index)
+	})
+	result := make([]JSONField, len(dominant))
+	for i, f := range dominant {
+		result[i] = f.JSONField
+	}
+	return result
+}
+
+// lessIndex orders field index sequences by declaration order.
+func lessIndex(a, b []int) bool {
+	for k := 0; k < len(a) && k < len(b); k++ {
+		if a[k] != b[k] {
+			return a[k] < b[k]
+		}
+	}
+	return len(a) < len(b)
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonFieldsBase struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type jsonFieldsItem struct {
+	*jsonFieldsBase
+	Name     string  `json:"title"`
+	Price    float64 `json:"price,omitempty"`
+	Note     *string
+	Internal string `json:"-"`
+	hidden   string
+}
+
+func TestJSONFields(t *testing.T) {
+	fields := JSONFields(reflect.TypeOf(jsonFieldsItem{}))
+
+	var names []string
+	for _, f := range fields {
+		names = append(names, f.JSONName)
+	}
+	assert.Equal(t, []string{"id", "name", "title", "price", "Note"}, names)
+
+	assert.Equal(t, reflect.TypeOf(jsonFieldsBase{}), fields[0].Owner)
+	assert.Equal(t, "Name", fields[2].Name)
+	assert.True(t, fields[3].OmitEmpty)
+	assert.False(t, fields[4].OmitEmpty)
+}
+
+type jsonFieldsAudit struct {
+	Level int `json:"Level"`
+	Owner string
+}
+
+type jsonFieldsTrace struct {
+	Level string
+	Owner string
+}
+
+type jsonFieldsShadowed struct {
+	jsonFieldsAudit
+	jsonFieldsTrace
+	*jsonFieldsBase
+	ID string `json:"id"`
+}
+
+func TestJSONFields_Dominance(t *testing.T) {
+	fields := JSONFields(reflect.TypeOf(jsonFieldsShadowed{}))
+
+	byName := make(map[string]JSONField)
+	var names []string
+	for _, f := range fields {
+		byName[f.JSONName] = f
+		names = append(names, f.JSONName)
+	}
+	// Owner is untagged in both embedded structs at the same depth, so
+	// encoding/json drops it; of the two "Level" fields, the tagged one wins.
+	assert.Equal(t, []string{"Level", "name", "id"}, names)
+
+	// The shallower ID shadows jsonFieldsBase.ID.
+	assert.Equal(t, reflect.TypeOf(jsonFieldsShadowed{}), byName["id"].Owner)
+	assert.Equal(t, reflect.TypeOf(""), byName["id"].Type)
+	assert.Equal(t, reflect.TypeOf(0), byName["Level"].Type)
+
+	encoded, err := json.Marshal(jsonFieldsShadowed{
+		jsonFieldsAudit: jsonFieldsAudit{Level: 2, Owner: "a"},
+		jsonFieldsTrace: jsonFieldsTrace{Level: "debug", Owner: "b"},
+		jsonFieldsBase:  &jsonFieldsBase{ID: "inner", Name: "n"},
+		ID:              "outer",
+	})
+	require.NoError(t, err)
+	var keys map[string]interface{}
+	require.NoError(t, json.Unmarshal(encoded, &keys))
+	assert.Len(t, keys, len(fields))
+	for _, name := range names {
+		assert.Contains(t, keys, name)
+	}
+	assert.Equal(t, "outer", keys["id"])
+	assert.Equal(t, float64(2), keys["Level"])
+}
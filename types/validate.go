@@ -0,0 +1,290 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidRequest is wrapped by every ValidationError, so callers can check
+// for client-side validation failures with errors.Is.
+var ErrInvalidRequest = errors.New("stack0: invalid request")
+
+// Validator is implemented by request types with rules that struct tags
+// can't express, such as fields that are mutually exclusive. Before
+// dispatching a request, the SDK calls its Validate method, or CheckFields for
+// request types without one, unless validation has been disabled on the
+// client.
+type Validator interface {
+	Validate() error
+}
+
+// Validate checks a request the way the SDK does before sending it: with its
+// Validate method if it implements Validator, and otherwise with CheckFields
+// if it is a struct or a pointer to one. Other values, such as maps, are
+// valid.
+func Validate(req interface{}) error {
+	if v, ok := req.(Validator); ok {
+		return v.Validate()
+	}
+	rv := reflect.ValueOf(req)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	return CheckFields(req)
+}
+
+// FieldError describes a problem with one request field, named as it is in
+// the API, such as "templateId" or "emails[2].to".
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError is returned for a request that fails client-side
+// validation. It lists every problem found, not just the first.
+type ValidationError struct {
+	Request string
+	Fields  []FieldError
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		problems[i] = f.Field + ": " + f.Message
+	}
+	return fmt.Sprintf("stack0: invalid %s: %s", e.Request, strings.Join(problems, "; "))
+}
+
+// Unwrap returns ErrInvalidRequest.
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidRequest
+}
+
+var enums sync.Map // reflect.Type -> []string
+
+// RegisterEnum records the values an enum type accepts. CheckFields rejects
+// any other value in a field of that type, including pointers to and slices of
+// it. Packages register their enums from init.
+func RegisterEnum[T ~string](values ...T) {
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = string(v)
+	}
+	enums.Store(reflect.TypeOf((*T)(nil)).Elem(), names)
+}
+
+// FieldState names a field and whether it is set, for MutuallyExclusive,
+// ConflictsWith and RequireOne.
+type FieldState struct {
+	Name string
+	Set  bool
+}
+
+// Field returns a FieldState.
+func Field(name string, set bool) FieldState {
+	return FieldState{Name: name, Set: set}
+}
+
+// MutuallyExclusive reports a problem if more than one of fields is set.
+func MutuallyExclusive(fields ...FieldState) []FieldError {
+	var set []string
+	for _, f := range fields {
+		if f.Set {
+			set = append(set, f.Name)
+		}
+	}
+	if len(set) < 2 {
+		return nil
+	}
+	return []FieldError{{Field: set[0], Message: "cannot be set with " + joinNames(set[1:], "or")}}
+}
+
+// ConflictsWith reports a problem if field is set along with any of others.
+func ConflictsWith(field FieldState, others ...FieldState) []FieldError {
+	if !field.Set {
+		return nil
+	}
+	var set []string
+	for _, f := range others {
+		if f.Set {
+			set = append(set, f.Name)
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	return []FieldError{{Field: field.Name, Message: "cannot be set with " + joinNames(set, "or")}}
+}
+
+// RequireOne reports a problem if none of fields is set.
+func RequireOne(fields ...FieldState) []FieldError {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		if f.Set {
+			return nil
+		}
+		names[i] = f.Name
+	}
+	return []FieldError{{Field: joinNames(names, "or"), Message: "one is required"}}
+}
+
+func joinNames(names []string, conjunction string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " " + conjunction + " " + names[len(names)-1]
+}
+
+// CheckFields validates a pointer to a request struct against its validate
+// struct tags and registered enums, then adds the problems found by rules,
+// such as those from MutuallyExclusive. It returns a *ValidationError listing
+// them all, or nil. A nil pointer is valid.
+//
+// Tags are comma-separated:
+//
+//	required  the field must be set: non-empty for strings, slices and maps,
+//	          non-nil for pointers and interfaces
+//	dive      nested requests, or slices of them, are validated with
+//	          Validate
+func CheckFields(v interface{}, rules ...[]FieldError) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	var problems []FieldError
+	checkStruct(rv, "", &problems)
+	for _, rule := range rules {
+		problems = append(problems, rule...)
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Request: rv.Type().Name(), Fields: problems}
+}
+
+func checkStruct(rv reflect.Value, prefix string, problems *[]FieldError) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fv := rv.Field(i)
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			checkStruct(fv, prefix, problems)
+			continue
+		}
+
+		name := prefix + fieldName(sf)
+		var required, dive bool
+		for _, opt := range strings.Split(sf.Tag.Get("validate"), ",") {
+			switch opt {
+			case "required":
+				required = true
+			case "dive":
+				dive = true
+			}
+		}
+
+		if !isSet(fv) {
+			if required {
+				*problems = append(*problems, FieldError{Field: name, Message: "required"})
+			}
+			continue
+		}
+		checkEnum(fv, name, problems)
+		if dive {
+			checkNested(fv, name, problems)
+		}
+	}
+}
+
+// fieldName returns the name of a field in the API: its JSON name, or its
+// query parameter name, falling back to the Go name for fields that only form
+// part of the path.
+func fieldName(sf reflect.StructField) string {
+	for _, key := range []string{"json", "url"} {
+		if name, _, _ := strings.Cut(sf.Tag.Get(key), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return sf.Name
+}
+
+func isSet(fv reflect.Value) bool {
+	switch fv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return !fv.IsNil()
+	case reflect.String, reflect.Slice, reflect.Map:
+		return fv.Len() > 0
+	default:
+		return true
+	}
+}
+
+func checkEnum(fv reflect.Value, name string, problems *[]FieldError) {
+	if fv.Kind() == reflect.Ptr {
+		fv = fv.Elem()
+	}
+	if fv.Kind() == reflect.Slice {
+		for i := 0; i < fv.Len(); i++ {
+			checkEnum(fv.Index(i), fmt.Sprintf("%s[%d]", name, i), problems)
+		}
+		return
+	}
+	if fv.Kind() != reflect.String {
+		return
+	}
+	values, ok := enums.Load(fv.Type())
+	if !ok {
+		return
+	}
+	allowed := values.([]string)
+	for _, v := range allowed {
+		if fv.String() == v {
+			return
+		}
+	}
+	*problems = append(*problems, FieldError{
+		Field:   name,
+		Message: fmt.Sprintf("%q is not one of %s", fv.String(), strings.Join(allowed, ", ")),
+	})
+}
+
+func checkNested(fv reflect.Value, name string, problems *[]FieldError) {
+	if fv.Kind() == reflect.Slice {
+		for i := 0; i < fv.Len(); i++ {
+			checkNested(fv.Index(i), fmt.Sprintf("%s[%d]", name, i), problems)
+		}
+		return
+	}
+	if fv.Kind() == reflect.Struct && fv.CanAddr() {
+		fv = fv.Addr()
+	}
+	if fv.Kind() == reflect.Ptr && fv.IsNil() {
+		return
+	}
+	err := Validate(fv.Interface())
+	if err == nil {
+		return
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		*problems = append(*problems, FieldError{Field: name, Message: err.Error()})
+		return
+	}
+	for _, f := range verr.Fields {
+		*problems = append(*problems, FieldError{Field: name + "." + f.Field, Message: f.Message})
+	}
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testColor string
+
+func init() {
+	RegisterEnum(testColor("red"), testColor("green"))
+}
+
+type testItem struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type testRequest struct {
+	ID      string
+	Title   string      `json:"title" validate:"required"`
+	Color   *testColor  `json:"color,omitempty"`
+	Colors  []testColor `url:"colors"`
+	Items   []testItem  `json:"items" validate:"dive"`
+	Primary *testItem   `json:"primary,omitempty" validate:"dive"`
+}
+
+func TestCheckFields(t *testing.T) {
+	red, blue := testColor("red"), testColor("blue")
+
+	t.Run("valid", func(t *testing.T) {
+		err := CheckFields(&testRequest{Title: "t", Color: &red, Items: []testItem{{Name: "a"}}})
+		assert.NoError(t, err)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.NoError(t, CheckFields((*testRequest)(nil)))
+	})
+
+	t.Run("every problem", func(t *testing.T) {
+		err := CheckFields(&testRequest{
+			Color:   &blue,
+			Colors:  []testColor{"green", "pink"},
+			Items:   []testItem{{Name: "a"}, {}},
+			Primary: &testItem{},
+		})
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.True(t, errors.Is(err, ErrInvalidRequest))
+		assert.Equal(t, "testRequest", verr.Request)
+		assert.Equal(t, []FieldError{
+			{Field: "title", Message: "required"},
+			{Field: "color", Message: `"blue" is not one of red, green`},
+			{Field: "colors[1]", Message: `"pink" is not one of red, green`},
+			{Field: "items[1].name", Message: "required"},
+			{Field: "primary.name", Message: "required"},
+		}, verr.Fields)
+		assert.Equal(t, `stack0: invalid testRequest: title: required; color: "blue" is not one of red, green; `+
+			`colors[1]: "pink" is not one of red, green; items[1].name: required; primary.name: required`, err.Error())
+	})
+
+	t.Run("rules", func(t *testing.T) {
+		err := CheckFields(&testRequest{Title: "t"},
+			MutuallyExclusive(Field("a", true), Field("b", false), Field("c", true)),
+			ConflictsWith(Field("html", true), Field("templateId", true), Field("templateSlug", true)),
+			RequireOne(Field("x", false), Field("y", false), Field("z", false)),
+		)
+
+		var verr *ValidationError
+		require.True(t, errors.As(err, &verr))
+		assert.Equal(t, []FieldError{
+			{Field: "a", Message: "cannot be set with c"},
+			{Field: "html", Message: "cannot be set with templateId or templateSlug"},
+			{Field: "x, y or z", Message: "one is required"},
+		}, verr.Fields)
+	})
+
+	t.Run("rules satisfied", func(t *testing.T) {
+		err := CheckFields(&testRequest{Title: "t"},
+			MutuallyExclusive(Field("a", true), Field("b", false)),
+			ConflictsWith(Field("html", false), Field("templateId", true)),
+			RequireOne(Field("x", false), Field("y", true)),
+		)
+		assert.NoError(t, err)
+	})
+}
+
+type testRuledRequest struct {
+	A *string `json:"a,omitempty"`
+	B *string `json:"b,omitempty"`
+}
+
+func (r *testRuledRequest) Validate() error {
+	return CheckFields(r, MutuallyExclusive(Field("a", r.A != nil), Field("b", r.B != nil)))
+}
+
+func TestValidate(t *testing.T) {
+	s := "x"
+
+	err := Validate(&testRuledRequest{A: &s, B: &s})
+	assert.ErrorContains(t, err, "a: cannot be set with b")
+
+	err = Validate(&testRequest{})
+	assert.ErrorContains(t, err, "title: required", "types without a Validate method fall back to CheckFields")
+	assert.ErrorContains(t, Validate(testRequest{}), "title: required")
+
+	assert.NoError(t, Validate((*testRequest)(nil)))
+	assert.NoError(t, Validate(map[string]interface{}{}))
+	assert.NoError(t, Validate(nil))
+}
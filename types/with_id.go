@@ -0,0 +1,13 @@
+package types
+
+// WithID returns a copy of req, or a new request if req is nil, with set
+// applied. Scoped handles such as cdn.AssetScope and mail.SequenceScope use it
+// to fill in their resource ID without modifying the caller's request.
+func WithID[T any](req *T, set func(*T)) *T {
+	var r T
+	if req != nil {
+		r = *req
+	}
+	set(&r)
+	return &r
+}
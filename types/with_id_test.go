@@ -0,0 +1,23 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type withIDRequest struct {
+	ID   string
+	Name string
+}
+
+func TestWithID(t *testing.T) {
+	req := &withIDRequest{ID: "old", Name: "n"}
+	got := WithID(req, func(r *withIDRequest) { r.ID = "new" })
+
+	assert.Equal(t, &withIDRequest{ID: "new", Name: "n"}, got)
+	assert.Equal(t, "old", req.ID)
+
+	got = WithID(nil, func(r *withIDRequest) { r.ID = "new" })
+	assert.Equal(t, &withIDRequest{ID: "new"}, got)
+}